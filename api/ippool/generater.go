@@ -18,20 +18,19 @@ package ippool
 import (
 	"fmt"
 	"net"
-	"sync/atomic"
-)
-
-const (
-	mask24 = uint32(0xFF << 24)
-	mask16 = uint32(0xFF << 16)
-	mask8  = uint32(0xFF << 8)
-	mask0  = uint32(0xFF)
+	"net/netip"
+	"sync"
 )
 
+// IPGenerater walks the addresses of an IPv4 or IPv6 subnet in order and
+// wraps around at the end. Only a cursor is kept so even a /64 is never
+// enumerated up-front
 type IPGenerater struct {
-	netCIDR      *net.IPNet
-	uintMaskBits uint32
-	counter      uint32
+	netCIDR *net.IPNet
+	first   netip.Addr
+	last    netip.Addr
+	next    netip.Addr
+	mutex   sync.Mutex
 }
 
 func InitIPGenerater(cidr string) (*IPGenerater, error) {
@@ -40,44 +39,68 @@ func InitIPGenerater(cidr string) (*IPGenerater, error) {
 		return nil, fmt.Errorf("invalid CIDR format")
 	}
 
-	maskbit, _ := ipn.Mask.Size()
-	uintMaskBits := uint32(0xFFFFFFFF >> maskbit)
+	pfx, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR format")
+	}
+	pfx = pfx.Masked()
+
+	first := pfx.Addr()
+	hostBits := first.BitLen() - pfx.Bits()
+	b := first.AsSlice()
+	for n := len(b) - 1; hostBits > 0; n-- {
+		if hostBits >= 8 {
+			b[n] = 0xFF
+		} else {
+			b[n] |= byte(0xFF >> (8 - hostBits))
+		}
+		hostBits -= 8
+	}
+	last, _ := netip.AddrFromSlice(b)
 
 	return &IPGenerater{
-		netCIDR:      ipn,
-		uintMaskBits: uintMaskBits,
+		netCIDR: ipn,
+		first:   first,
+		last:    last,
+		next:    first,
 	}, nil
 }
 
+// NextIP returns the next address of the subnet, starting over from the
+// network address once the last one has been handed out
 func (i *IPGenerater) NextIP() net.IP {
-	counter := i.counter
-	for !atomic.CompareAndSwapUint32(&i.counter, counter, counter+1) {
-		counter = i.counter
-	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
 
-	counterBit := counter & i.uintMaskBits
-
-	return net.IP{
-		byte(uint32(i.netCIDR.IP[0]) | (counterBit&mask24)>>24),
-		byte(uint32(i.netCIDR.IP[1]) | (counterBit&mask16)>>16),
-		byte(uint32(i.netCIDR.IP[2]) | (counterBit&mask8)>>8),
-		byte(uint32(i.netCIDR.IP[3]) | (counterBit&mask0)>>0),
+	ip := i.next
+	if ip == i.last {
+		i.next = i.first
+	} else {
+		i.next = ip.Next()
 	}
+
+	return net.IP(ip.AsSlice())
 }
 
+// GetBroadcastIP returns the last address of the subnet
 func (i *IPGenerater) GetBroadcastIP() net.IP {
-	return net.IP{
-		byte(uint32(i.netCIDR.IP[0]) | (i.uintMaskBits)>>24),
-		byte(uint32(i.netCIDR.IP[1]) | (i.uintMaskBits)>>16),
-		byte(uint32(i.netCIDR.IP[2]) | (i.uintMaskBits)>>8),
-		byte(uint32(i.netCIDR.IP[3]) | (i.uintMaskBits)>>0),
-	}
+	return net.IP(i.last.AsSlice())
 }
 
 func (i *IPGenerater) GetNetwork() net.IP {
 	return i.netCIDR.IP
 }
 
+// GetCIDR returns the subnet in CIDR notation
+func (i *IPGenerater) GetCIDR() string {
+	return i.netCIDR.String()
+}
+
+// IsIPv6 returns true if the subnet is an IPv6 one
+func (i *IPGenerater) IsIPv6() bool {
+	return i.first.Is6()
+}
+
 func (i *IPGenerater) CheckIPAddressInSubnet(ipStr string) bool {
 	ip := net.ParseIP(ipStr)
 	return i.netCIDR.Contains(ip)
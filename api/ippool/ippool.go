@@ -17,88 +17,317 @@
 package ippool
 
 import (
+	"errors"
 	"net"
+	"sort"
 	"sync"
 )
 
 type IPPool struct {
 	IPv4Generator *IPGenerater
 	IPv4Pool      *IPSet
+	IPv6Generator *IPGenerater
+	IPv6Pool      *IPSet
+	reserved      *IPSet
+	owners        map[string]string
 	mutex         sync.Mutex
 }
 
 // Initailize IP Pool
 func NewIPPool(netCIDR string) (*IPPool, error) {
-	genIPv4, err := InitIPGenerater(netCIDR)
+	ip, _, err := net.ParseCIDR(netCIDR)
 	if err != nil {
-		return nil, err
+		return nil, errors.New("invalid CIDR format")
 	}
+	if ip.To4() == nil {
+		return NewDualStackIPPool("", netCIDR)
+	}
+	return NewDualStackIPPool(netCIDR, "")
+}
 
-	poolIPv4 := NewSet()
-	poolIPv4.Add(genIPv4.GetNetwork().String())
-	poolIPv4.Add(genIPv4.GetBroadcastIP().String())
+// NewDualStackIPPool initializes an IP Pool with an IPv4 and/or an IPv6 subnet.
+// Either of them can be empty but not both
+func NewDualStackIPPool(v4CIDR, v6CIDR string) (*IPPool, error) {
+	if v4CIDR == "" && v6CIDR == "" {
+		return nil, errors.New("no subnet in pool")
+	}
 
-	return &IPPool{
-		IPv4Generator: genIPv4,
-		IPv4Pool:      poolIPv4,
-		mutex:         sync.Mutex{},
-	}, nil
-}
+	pool := &IPPool{
+		reserved: NewSet(),
+		owners:   make(map[string]string),
+		mutex:    sync.Mutex{},
+	}
 
-// AssignNewIPv4 generate new IP and add key(IP) in IP Pool.
-// If IP is already in pool, try to generate next IP.
-// Returns nil If all IPs in the subnet are already in the pool.
-func (i *IPPool) AssignNewIPv4() net.IP {
-	startNewIP := i.IPv4Generator.NextIP()
+	if v4CIDR != "" {
+		genIPv4, err := InitIPGenerater(v4CIDR)
+		if err != nil {
+			return nil, err
+		}
+		if genIPv4.IsIPv6() {
+			return nil, errors.New("subnet not IPv4")
+		}
+		poolIPv4 := NewSet()
+		poolIPv4.Add(genIPv4.GetNetwork().String())
+		poolIPv4.Add(genIPv4.GetBroadcastIP().String())
+		pool.IPv4Generator = genIPv4
+		pool.IPv4Pool = poolIPv4
+	}
 
-	i.mutex.Lock()
-	defer i.mutex.Unlock()
+	if v6CIDR != "" {
+		genIPv6, err := InitIPGenerater(v6CIDR)
+		if err != nil {
+			return nil, err
+		}
+		if !genIPv6.IsIPv6() {
+			return nil, errors.New("subnet not IPv6")
+		}
+		// Subnet-router anycast address is never handed out
+		poolIPv6 := NewSet()
+		poolIPv6.Add(genIPv6.GetNetwork().String())
+		pool.IPv6Generator = genIPv6
+		pool.IPv6Pool = poolIPv6
+	}
 
-	id := startNewIP.String()
+	return pool, nil
+}
 
-	if ok := i.IPv4Pool.Contains(id); !ok {
-		i.IPv4Pool.Add(id)
-		return startNewIP
+// family returns generator and set for the address family of ip
+func (i *IPPool) family(ip net.IP) (*IPGenerater, *IPSet) {
+	if ip == nil {
+		return nil, nil
+	}
+	if ip.To4() != nil {
+		if i.IPv4Generator != nil && i.IPv4Generator.CheckIPAddressInSubnet(ip.String()) {
+			return i.IPv4Generator, i.IPv4Pool
+		}
+		return nil, nil
+	}
+	if i.IPv6Generator != nil && i.IPv6Generator.CheckIPAddressInSubnet(ip.String()) {
+		return i.IPv6Generator, i.IPv6Pool
+	}
+	return nil, nil
+}
+
+// assignNew generates a new IP which is neither allocated nor reserved.
+// Caller needs to hold the mutex
+func (i *IPPool) assignNew(gen *IPGenerater, set *IPSet) net.IP {
+	if gen == nil {
+		return nil
 	}
 
+	startNewIP := gen.NextIP()
+	newIP := startNewIP
 	for {
-		newIP := i.IPv4Generator.NextIP()
 		id := newIP.String()
-		if ok := i.IPv4Pool.Contains(id); !ok {
-			i.IPv4Pool.Add(id)
+		if !set.Contains(id) && !i.reserved.Contains(id) {
+			set.Add(id)
 			return newIP
 		}
 
+		newIP = gen.NextIP()
 		if startNewIP.Equal(newIP) {
 			return nil
 		}
 	}
 }
 
+// AssignNewIPv4 generate new IP and add key(IP) in IP Pool.
+// If IP is already in pool, try to generate next IP.
+// Returns nil If all IPs in the subnet are already in the pool.
+func (i *IPPool) AssignNewIPv4() net.IP {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	return i.assignNew(i.IPv4Generator, i.IPv4Pool)
+}
+
+// AssignNewIPv6 generate new IPv6 and add key(IP) in IP Pool.
+// Returns nil If there is no IPv6 subnet or all IPs are already in the pool.
+func (i *IPPool) AssignNewIPv6() net.IP {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	return i.assignNew(i.IPv6Generator, i.IPv6Pool)
+}
+
 // RetrieveIPv4 remove key(IP) in IP Pool
 func (i *IPPool) RetrieveIPv4(retrieveIP string) {
+	i.RetrieveIP(retrieveIP)
+}
+
+// RetrieveIPv6 remove key(IP) in IP Pool
+func (i *IPPool) RetrieveIPv6(retrieveIP string) {
+	i.RetrieveIP(retrieveIP)
+}
+
+// RetrieveIP remove key(IP) of either family in IP Pool
+func (i *IPPool) RetrieveIP(retrieveIP string) {
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
 
-	if ok := i.IPv4Pool.Contains(retrieveIP); ok {
-		i.IPv4Pool.Remove(retrieveIP)
+	i.retrieve(net.ParseIP(retrieveIP))
+}
+
+func (i *IPPool) retrieve(ip net.IP) {
+	gen, set := i.family(ip)
+	if gen == nil {
+		return
+	}
+	id := ip.String()
+	// Network and broadcast addresses always stay in the pool
+	if ip.Equal(gen.GetNetwork()) || (!gen.IsIPv6() && ip.Equal(gen.GetBroadcastIP())) {
+		return
 	}
+	if ok := set.Contains(id); ok {
+		set.Remove(id)
+	}
+	delete(i.owners, id)
 }
 
 func (i *IPPool) UpdateAllocateddIPv4(allocatedIP string) {
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
 
-	if ok := i.IPv4Pool.Contains(allocatedIP); !ok {
-		i.IPv4Pool.Add(allocatedIP)
+	if _, set := i.family(net.ParseIP(allocatedIP)); set != nil {
+		set.Add(net.ParseIP(allocatedIP).String())
 	}
 }
 
 func (i *IPPool) CheckSubnetAndUpdateIPPool(ip string) bool {
-	if i.IPv4Generator.CheckIPAddressInSubnet(ip) {
+	if gen, _ := i.family(net.ParseIP(ip)); gen != nil {
 		i.UpdateAllocateddIPv4(ip)
 		return true
 	}
 
 	return false
 }
+
+// ReserveIP keeps an IP of the pool from being handed out by AssignNew*.
+// It can still be assigned by asking for it with AssignIP
+func (i *IPPool) ReserveIP(reserveIP string) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	ip := net.ParseIP(reserveIP)
+	if gen, _ := i.family(ip); gen == nil {
+		return errors.New("ip not in pool subnet")
+	}
+	i.reserved.Add(ip.String())
+	return nil
+}
+
+// AssignIP assigns a specific IP to owner. Assigning an IP to its current
+// owner again is not an error
+func (i *IPPool) AssignIP(owner, assignIP string) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	ip := net.ParseIP(assignIP)
+	gen, set := i.family(ip)
+	if gen == nil {
+		return errors.New("ip not in pool subnet")
+	}
+	id := ip.String()
+	if set.Contains(id) {
+		if cur, ok := i.owners[id]; ok && cur == owner {
+			return nil
+		}
+		return errors.New("ip already allocated")
+	}
+	set.Add(id)
+	i.owners[id] = owner
+	return nil
+}
+
+// AssignNewIPOwner assigns a new IP of the given family to owner. If owner
+// already has an IP of the family, that IP is returned
+func (i *IPPool) AssignNewIPOwner(owner string, ipv6 bool) net.IP {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for id, cur := range i.owners {
+		ip := net.ParseIP(id)
+		if cur == owner && (ip.To4() == nil) == ipv6 {
+			return ip
+		}
+	}
+
+	var ip net.IP
+	if ipv6 {
+		ip = i.assignNew(i.IPv6Generator, i.IPv6Pool)
+	} else {
+		ip = i.assignNew(i.IPv4Generator, i.IPv4Pool)
+	}
+	if ip != nil {
+		i.owners[ip.String()] = owner
+	}
+	return ip
+}
+
+// RetrieveOwner removes all IPs of owner from IP Pool and returns them
+func (i *IPPool) RetrieveOwner(owner string) []string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	var ips []string
+	for id, cur := range i.owners {
+		if cur == owner {
+			ips = append(ips, id)
+		}
+	}
+	for _, id := range ips {
+		i.retrieve(net.ParseIP(id))
+	}
+	sort.Strings(ips)
+	return ips
+}
+
+// GetOwner returns the owner of an IP if it is assigned to one
+func (i *IPPool) GetOwner(ip string) (string, bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	nIP := net.ParseIP(ip)
+	if nIP == nil {
+		return "", false
+	}
+	owner, ok := i.owners[nIP.String()]
+	return owner, ok
+}
+
+// GetOwners returns the IPs held by each owner
+func (i *IPPool) GetOwners() map[string][]string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	owners := make(map[string][]string)
+	for id, owner := range i.owners {
+		owners[owner] = append(owners[owner], id)
+	}
+	for _, ips := range owners {
+		sort.Strings(ips)
+	}
+	return owners
+}
+
+// GetReserved returns reserved IPs of the pool
+func (i *IPPool) GetReserved() []string {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	ips := i.reserved.GetAll()
+	sort.Strings(ips)
+	return ips
+}
+
+// GetCIDRs returns subnets of the pool
+func (i *IPPool) GetCIDRs() []string {
+	var cidrs []string
+	if i.IPv4Generator != nil {
+		cidrs = append(cidrs, i.IPv4Generator.GetCIDR())
+	}
+	if i.IPv6Generator != nil {
+		cidrs = append(cidrs, i.IPv6Generator.GetCIDR())
+	}
+	return cidrs
+}
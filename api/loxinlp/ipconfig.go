@@ -0,0 +1,494 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinlp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	opt "github.com/loxilb-io/loxilb/options"
+	tk "github.com/loxilb-io/loxilib"
+	nlp "github.com/vishvananda/netlink"
+)
+
+// Saved per-interface ip config is written by "loxicmd save" in
+// <ConfigPath>/ipconfig/<ifname>/<kind>/ with one file per entry. A file is
+// named after the entry with '/' replaced by '_' and may hold extra fields of
+// the entry on its first line :
+//
+//	ipv4addr, ipv6addr   - "<ip>_<mask>"
+//	vlan                 - "<vid>" with "tagged" or "untagged", ifname is a member of vlan<vid>
+//	vxlan                - "<vni>", vxlan<vni> is created with ifname as endpoint
+//	vxlanpeer            - "<peer-ip>", only for vxlan<vni> interfaces
+//	ipv4route, ipv6route - "<dst>_<mask>" with "[<gateway>] [<proto>]"
+//
+// An interface directory holding anything else is rejected as a whole. Routes
+// are applied only once the interface is operationally up. Anything which
+// fails is retried every IPCfgRetryIntv till it succeeds or the interface
+// goes away.
+const (
+	IPCfgRetryIntv = 5 * time.Second
+)
+
+// Apply status of saved ip config of an interface
+const (
+	IPCfgApplied  = "applied"
+	IPCfgPending  = "pending"
+	IPCfgLinkDown = "link-down"
+	IPCfgFailed   = "failed"
+)
+
+// IPConfigStatus - apply status of saved ip config of an interface
+type IPConfigStatus struct {
+	Dev       string
+	Status    string
+	LinkUp    bool
+	Applied   int
+	Pending   int
+	Attempts  int
+	LastError string
+}
+
+type ipCfgVlan struct {
+	vid    int
+	tagged bool
+}
+
+type ipCfgRoute struct {
+	dst   string
+	gw    string
+	proto string
+}
+
+type ipIntfCfg struct {
+	addrs  []string
+	vlans  []ipCfgVlan
+	vxlans []int
+	peers  []string
+	routes []ipCfgRoute
+}
+
+var ipCfgLastRetry time.Time
+
+func (c *ipIntfCfg) baseCount() int {
+	return len(c.addrs) + len(c.vlans) + len(c.vxlans) + len(c.peers)
+}
+
+func (c *ipIntfCfg) count() int {
+	return c.baseCount() + len(c.routes)
+}
+
+// ipCfgKinds - kinds of entries of saved ip config of an interface
+var ipCfgKinds = map[string]struct{}{
+	"ipv4addr": {}, "ipv6addr": {}, "vlan": {}, "vxlan": {}, "vxlanpeer": {}, "ipv4route": {}, "ipv6route": {},
+}
+
+// ipCfgEntries - read the entries of a kind of saved ip config as a list of
+// fields per entry. The first field is the entry name and the rest are read
+// from the entry file. A missing kind directory is the same as an empty one
+func ipCfgEntries(dir string) ([][]string, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries [][]string
+	for _, file := range files {
+		if !file.Type().IsRegular() {
+			return nil, fmt.Errorf("%s: not an entry file", file.Name())
+		}
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		line, _, _ := strings.Cut(string(data), "\n")
+		entry := []string{strings.ReplaceAll(file.Name(), "_", "/")}
+		entries = append(entries, append(entry, strings.Fields(line)...))
+	}
+	return entries, nil
+}
+
+// ipCfgRead - parse saved ip config of an interface. Returns nil config if
+// nothing is saved for the interface
+func ipCfgRead(dpath, name string) (*ipIntfCfg, error) {
+	idir := filepath.Join(dpath, name)
+	if fi, err := os.Stat(idir); err != nil || !fi.IsDir() {
+		return nil, nil
+	}
+
+	files, err := os.ReadDir(idir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if _, found := ipCfgKinds[file.Name()]; !found || !file.IsDir() {
+			return nil, fmt.Errorf("%s/%s: unknown config", name, file.Name())
+		}
+	}
+
+	c := new(ipIntfCfg)
+	bad := func(kind string, l []string) error {
+		return fmt.Errorf("%s/%s/%s: malformed entry", name, kind, strings.ReplaceAll(l[0], "/", "_"))
+	}
+
+	for _, kind := range []string{"ipv4addr", "ipv6addr"} {
+		lines, err := ipCfgEntries(filepath.Join(idir, kind))
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lines {
+			if _, _, err := net.ParseCIDR(l[0]); err != nil || len(l) != 1 {
+				return nil, bad(kind, l)
+			}
+			c.addrs = append(c.addrs, l[0])
+		}
+	}
+
+	lines, err := ipCfgEntries(filepath.Join(idir, "vlan"))
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		vid, err := strconv.Atoi(l[0])
+		if err != nil || vid <= 0 || vid >= 4095 || len(l) > 2 {
+			return nil, bad("vlan", l)
+		}
+		tagged := false
+		if len(l) == 2 {
+			switch l[1] {
+			case "tagged":
+				tagged = true
+			case "untagged":
+			default:
+				return nil, bad("vlan", l)
+			}
+		}
+		c.vlans = append(c.vlans, ipCfgVlan{vid: vid, tagged: tagged})
+	}
+
+	lines, err = ipCfgEntries(filepath.Join(idir, "vxlan"))
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		vni, err := strconv.Atoi(l[0])
+		if err != nil || vni <= 0 || len(l) != 1 {
+			return nil, bad("vxlan", l)
+		}
+		c.vxlans = append(c.vxlans, vni)
+	}
+
+	lines, err = ipCfgEntries(filepath.Join(idir, "vxlanpeer"))
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) != 0 && !strings.HasPrefix(name, "vxlan") {
+		return nil, fmt.Errorf("%s/vxlanpeer: not a vxlan interface", name)
+	}
+	for _, l := range lines {
+		if net.ParseIP(l[0]) == nil || len(l) != 1 {
+			return nil, bad("vxlanpeer", l)
+		}
+		c.peers = append(c.peers, l[0])
+	}
+
+	for _, kind := range []string{"ipv4route", "ipv6route"} {
+		lines, err := ipCfgEntries(filepath.Join(idir, kind))
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lines {
+			if _, _, err := net.ParseCIDR(l[0]); err != nil || len(l) > 3 {
+				return nil, bad(kind, l)
+			}
+			r := ipCfgRoute{dst: l[0], proto: "static"}
+			if len(l) > 1 {
+				if net.ParseIP(l[1]) == nil {
+					return nil, bad(kind, l)
+				}
+				r.gw = l[1]
+			}
+			if len(l) > 2 {
+				r.proto = l[2]
+			}
+			c.routes = append(c.routes, r)
+		}
+	}
+
+	return c, nil
+}
+
+// ipCfgAddrExists - check if address is already present on an interface
+func ipCfgAddrExists(address, ifName string) bool {
+	link, err := nlp.LinkByName(ifName)
+	if err != nil {
+		return false
+	}
+	addr, err := nlp.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	addrs, err := nlp.AddrList(link, nlp.FAMILY_ALL)
+	if err != nil {
+		return false
+	}
+	for _, a := range addrs {
+		if a.IPNet.String() == addr.IPNet.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// ipCfgVlanMemberExists - check if interface is already a member of a vlan
+func ipCfgVlanMemberExists(vid int, ifName string, tagged bool) bool {
+	br, err := nlp.LinkByName(fmt.Sprintf("vlan%d", vid))
+	if err != nil {
+		return false
+	}
+	if tagged {
+		ifName = fmt.Sprintf("%s.%d", ifName, vid)
+	}
+	link, err := nlp.LinkByName(ifName)
+	if err != nil {
+		return false
+	}
+	return link.Attrs().MasterIndex == br.Attrs().Index
+}
+
+// ipCfgRouteExists - check if route to a destination is already present
+func ipCfgRouteExists(dst string) bool {
+	_, ipNet, err := net.ParseCIDR(dst)
+	if err != nil {
+		return false
+	}
+	routes, err := nlp.RouteListFiltered(nlp.FAMILY_ALL, &nlp.Route{Dst: ipNet}, nlp.RT_FILTER_DST)
+	return err == nil && len(routes) != 0
+}
+
+// ipCfgApplyBase - apply non-route entries of saved ip config. Entries
+// which are applied are removed from the config
+func ipCfgApplyBase(name string, c *ipIntfCfg) (int, error) {
+	var err error
+	applied := 0
+
+	// Addresses go first as vxlan endpoint needs a local address
+	var addrs []string
+	for _, a := range c.addrs {
+		if AddAddrNoHook(a, name) != 0 && !ipCfgAddrExists(a, name) {
+			err = fmt.Errorf("address %s add failed", a)
+			addrs = append(addrs, a)
+			continue
+		}
+		applied++
+	}
+	c.addrs = addrs
+
+	var vlans []ipCfgVlan
+	for _, v := range c.vlans {
+		if AddVLANNoHook(v.vid) != 0 ||
+			(AddVLANMemberNoHook(v.vid, name, v.tagged) != 0 && !ipCfgVlanMemberExists(v.vid, name, v.tagged)) {
+			err = fmt.Errorf("vlan%d member add failed", v.vid)
+			vlans = append(vlans, v)
+			continue
+		}
+		applied++
+	}
+	c.vlans = vlans
+
+	var vxlans []int
+	for _, vni := range c.vxlans {
+		if ret := AddVxLANBridgeNoHook(vni, name); ret != 0 && ret != 409 {
+			err = fmt.Errorf("vxlan%d add failed", vni)
+			vxlans = append(vxlans, vni)
+			continue
+		}
+		applied++
+	}
+	c.vxlans = vxlans
+
+	var peers []string
+	vni, _ := strconv.Atoi(strings.TrimPrefix(name, "vxlan"))
+	for _, p := range c.peers {
+		if AddVxLANPeerNoHook(vni, p) != 0 {
+			err = fmt.Errorf("vxlan peer %s add failed", p)
+			peers = append(peers, p)
+			continue
+		}
+		applied++
+	}
+	c.peers = peers
+
+	return applied, err
+}
+
+// ipCfgApplyRoutes - apply route entries of saved ip config. Routes which
+// are applied are removed from the config
+func ipCfgApplyRoutes(c *ipIntfCfg) (int, error) {
+	var err error
+	var routes []ipCfgRoute
+	applied := 0
+
+	for _, r := range c.routes {
+		if AddRouteNoHook(r.dst, r.gw, r.proto) != 0 && !ipCfgRouteExists(r.dst) {
+			err = fmt.Errorf("route %s add failed", r.dst)
+			routes = append(routes, r)
+			continue
+		}
+		applied++
+	}
+	c.routes = routes
+
+	return applied, err
+}
+
+// ipCfgApply - apply whatever is still pending from saved ip config of an
+// interface. Caller needs to hold nNl.IMtx
+func ipCfgApply(intf *Intf, dpath string) {
+	if !intf.loaded {
+		cfg, err := ipCfgRead(dpath, intf.dev)
+		if err != nil {
+			// Nothing is applied and it is read again on the next link event
+			intf.lastErr = err.Error()
+			tk.LogIt(tk.LogError, "[NLP] Config for %s invalid : %s\n", intf.dev, err)
+			return
+		}
+		intf.loaded = true
+		intf.lastErr = ""
+		if cfg == nil {
+			intf.configApplied = true
+			return
+		}
+		intf.cfg = cfg
+	}
+
+	if intf.cfg == nil || intf.cfg.count() == 0 {
+		return
+	}
+	if intf.configApplied && !intf.state {
+		if !intf.needRouteApply {
+			tk.LogIt(tk.LogDebug, "[NLP] Route Config for %s will be tried\n", intf.dev)
+		}
+		intf.needRouteApply = true
+		return
+	}
+
+	intf.attempts++
+	intf.lastErr = ""
+	tk.LogIt(tk.LogDebug, "[NLP] Applying Config for %s \n", intf.dev)
+
+	if intf.cfg.baseCount() != 0 {
+		n, err := ipCfgApplyBase(intf.dev, intf.cfg)
+		intf.applied += n
+		if err != nil {
+			intf.lastErr = err.Error()
+		}
+	}
+	intf.configApplied = intf.cfg.baseCount() == 0
+
+	if intf.state && len(intf.cfg.routes) != 0 {
+		tk.LogIt(tk.LogDebug, "[NLP] Applying Route Config for %s \n", intf.dev)
+		n, err := ipCfgApplyRoutes(intf.cfg)
+		intf.applied += n
+		if err != nil {
+			intf.lastErr = err.Error()
+		}
+	}
+	intf.needRouteApply = len(intf.cfg.routes) != 0
+
+	if intf.lastErr != "" {
+		tk.LogIt(tk.LogError, "[NLP] Applied Config for %s - FAILED : %s\n", intf.dev, intf.lastErr)
+	} else if intf.cfg.count() == 0 {
+		tk.LogIt(tk.LogDebug, "[NLP] Applied Config for %s \n", intf.dev)
+	}
+}
+
+// ipCfgRetry - periodically retry saved ip config which could not be
+// applied yet
+func ipCfgRetry() {
+	if time.Since(ipCfgLastRetry) < IPCfgRetryIntv {
+		return
+	}
+	ipCfgLastRetry = time.Now()
+
+	dpath := opt.Opts.ConfigPath + "/ipconfig/"
+
+	nNl.IMtx.Lock()
+	defer nNl.IMtx.Unlock()
+
+	for name, intf := range nNl.IMap {
+		if intf.cfg == nil || intf.cfg.count() == 0 {
+			continue
+		}
+		if intf.configApplied && !intf.state {
+			continue
+		}
+		ipCfgApply(&intf, dpath)
+		nNl.IMap[name] = intf
+	}
+}
+
+func (intf *Intf) cfgStatus() string {
+	if intf.cfg == nil {
+		return IPCfgFailed
+	}
+	if intf.cfg.count() == 0 {
+		return IPCfgApplied
+	}
+	if intf.configApplied && !intf.state {
+		return IPCfgLinkDown
+	}
+	return IPCfgPending
+}
+
+// GetIPConfigStatusNoHook - Get apply status of saved ip config of all
+// interfaces which have any
+func GetIPConfigStatusNoHook() []IPConfigStatus {
+	var ret []IPConfigStatus
+
+	if nNl == nil {
+		return ret
+	}
+
+	nNl.IMtx.Lock()
+	defer nNl.IMtx.Unlock()
+
+	for _, intf := range nNl.IMap {
+		if intf.cfg == nil && intf.lastErr == "" {
+			continue
+		}
+		st := IPConfigStatus{Dev: intf.dev, Status: intf.cfgStatus(), LinkUp: intf.state,
+			Applied: intf.applied, Attempts: intf.attempts, LastError: intf.lastErr}
+		if intf.cfg != nil {
+			st.Pending = intf.cfg.count()
+		}
+		ret = append(ret, st)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Dev < ret[j].Dev })
+
+	return ret
+}
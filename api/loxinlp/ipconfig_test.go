@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinlp
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	opt "github.com/loxilb-io/loxilb/options"
+)
+
+type ipCfgTestHook struct {
+	cmn.NetHookInterface
+	addrFail bool
+	addrs    []string
+}
+
+func (h *ipCfgTestHook) NetAddrAdd(am *cmn.IPAddrMod) (int, error) {
+	if h.addrFail {
+		return -1, errors.New("addr add error")
+	}
+	h.addrs = append(h.addrs, am.IP)
+	return 0, nil
+}
+
+func ipCfgTestWrite(t *testing.T, dir string, entries map[string]string) {
+	for name, data := range entries {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatalf("failed to create %s\n", filepath.Dir(file))
+		}
+		if err := os.WriteFile(file, []byte(data), 0644); err != nil {
+			t.Fatalf("failed to write %s\n", file)
+		}
+	}
+}
+
+func TestIPCfgRead(t *testing.T) {
+	dpath := t.TempDir()
+
+	ipCfgTestWrite(t, dpath, map[string]string{
+		"llbtst0/ipv4addr/10.1.1.1_24":    "",
+		"llbtst0/ipv6addr/2001:db8::1_64": "",
+		"llbtst0/vlan/100":                "tagged\n",
+		"llbtst0/vxlan/50":                "",
+		"llbtst0/ipv4route/10.2.0.0_16":   "10.1.1.254 static\n",
+		"llbtst0/ipv6route/2001:db9::_64": "",
+		"vxlan50/vxlanpeer/10.1.1.2":      "",
+		"llbtst1/ipv4addr":                "10.1.1.1/24\n",
+		"llbtst2/ipv4addr/10.1.1.1":       "",
+		"llbtst3/ipaddr/10.1.1.1_24":      "",
+		"llbtst4/vxlanpeer/10.1.1.2":      "",
+	})
+
+	c, err := ipCfgRead(dpath, "llbtst0")
+	if err != nil || c == nil {
+		t.Fatalf("failed to read ip config of llbtst0 (%v)\n", err)
+	}
+	if len(c.addrs) != 2 || c.addrs[0] != "10.1.1.1/24" || c.addrs[1] != "2001:db8::1/64" {
+		t.Errorf("addresses of llbtst0 wrong %v\n", c.addrs)
+	}
+	if len(c.vlans) != 1 || c.vlans[0] != (ipCfgVlan{vid: 100, tagged: true}) {
+		t.Errorf("vlans of llbtst0 wrong %v\n", c.vlans)
+	}
+	if len(c.vxlans) != 1 || c.vxlans[0] != 50 {
+		t.Errorf("vxlans of llbtst0 wrong %v\n", c.vxlans)
+	}
+	if len(c.routes) != 2 || c.routes[0] != (ipCfgRoute{dst: "10.2.0.0/16", gw: "10.1.1.254", proto: "static"}) ||
+		c.routes[1] != (ipCfgRoute{dst: "2001:db9::/64", proto: "static"}) {
+		t.Errorf("routes of llbtst0 wrong %v\n", c.routes)
+	}
+
+	c, err = ipCfgRead(dpath, "vxlan50")
+	if err != nil || c == nil || len(c.peers) != 1 || c.peers[0] != "10.1.1.2" {
+		t.Errorf("failed to read ip config of vxlan50 (%v)\n", err)
+	}
+
+	if c, err = ipCfgRead(dpath, "llbtst9"); c != nil || err != nil {
+		t.Errorf("read ip config of llbtst9 which has none\n")
+	}
+
+	for _, name := range []string{"llbtst1", "llbtst2", "llbtst3", "llbtst4"} {
+		if _, err := ipCfgRead(dpath, name); err == nil {
+			t.Errorf("read malformed ip config of %s\n", name)
+		}
+	}
+}
+
+func TestIPCfgRetry(t *testing.T) {
+	oHooks, oNl, oPath := hooks, nNl, opt.Opts.ConfigPath
+	defer func() {
+		hooks, nNl, opt.Opts.ConfigPath = oHooks, oNl, oPath
+		ipCfgLastRetry = time.Time{}
+	}()
+
+	opt.Opts.ConfigPath = t.TempDir()
+	ipCfgTestWrite(t, opt.Opts.ConfigPath+"/ipconfig", map[string]string{
+		"llbtst0/ipv4addr/10.1.1.1_24":  "",
+		"llbtst0/ipv4route/10.2.0.0_16": "10.1.1.254\n",
+		"llbtst1/ipaddr/10.1.1.1_24":    "",
+	})
+
+	hook := &ipCfgTestHook{addrFail: true}
+	hooks = hook
+	nNl = &NlH{IMap: make(map[string]Intf)}
+
+	applyConfigMap("llbtst0", false, true)
+	applyConfigMap("llbtst1", false, true)
+
+	st := GetIPConfigStatusNoHook()
+	if len(st) != 2 || st[0].Dev != "llbtst0" || st[0].Status != IPCfgPending || st[0].Pending != 2 ||
+		st[0].Attempts != 1 || st[0].LastError == "" {
+		t.Fatalf("ip config status of llbtst0 wrong %v\n", st)
+	}
+	if st[1].Dev != "llbtst1" || st[1].Status != IPCfgFailed || st[1].Applied != 0 {
+		t.Errorf("ip config status of llbtst1 wrong %v\n", st[1])
+	}
+
+	// Nothing is retried before IPCfgRetryIntv
+	hook.addrFail = false
+	ipCfgRetry()
+	ipCfgRetry()
+	if st = GetIPConfigStatusNoHook(); st[0].Attempts != 2 {
+		t.Errorf("ip config of llbtst0 retried %d times\n", st[0].Attempts-1)
+	}
+
+	// Routes wait for the link to come up
+	if st[0].Status != IPCfgLinkDown || st[0].Applied != 1 || st[0].Pending != 1 || st[0].LastError != "" {
+		t.Errorf("ip config status of llbtst0 wrong %v\n", st[0])
+	}
+	if len(hook.addrs) != 1 || hook.addrs[0] != "10.1.1.1/24" {
+		t.Errorf("address of llbtst0 not applied %v\n", hook.addrs)
+	}
+	ipCfgLastRetry = time.Time{}
+	ipCfgRetry()
+	if st = GetIPConfigStatusNoHook(); st[0].Attempts != 2 {
+		t.Errorf("ip config of llbtst0 retried with link down\n")
+	}
+	if st[1].Attempts != 0 {
+		t.Errorf("malformed ip config of llbtst1 retried\n")
+	}
+}
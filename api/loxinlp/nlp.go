@@ -16,16 +16,15 @@
 package loxinlp
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"os"
-	"os/exec"
 	"regexp"
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -76,6 +75,11 @@ type Intf struct {
 	state          bool
 	configApplied  bool
 	needRouteApply bool
+	loaded         bool
+	cfg            *ipIntfCfg
+	applied        int
+	attempts       int
+	lastErr        string
 }
 
 type NlH struct {
@@ -84,6 +88,7 @@ type NlH struct {
 	NeighUpdateCh
 	RouteUpdateCh
 	IMap      map[string]Intf
+	IMtx      sync.Mutex
 	BlackList string
 	BLRgx     *regexp.Regexp
 }
@@ -105,210 +110,29 @@ func iSBlackListedIntf(name string, masterIdx int) bool {
 	return filter
 }
 
-func applyAllConfig(name string) bool {
-
-	command := "loxicmd apply --per-intf " + name + " -c " + opt.Opts.ConfigPath + "/ipconfig/"
-	cmd := exec.Command("bash", "-c", command)
-	output, err := cmd.Output()
-	if err != nil {
-		fmt.Println(err)
-		return false
-	}
-	fmt.Printf("%v\n", string(output))
-	return true
-}
-
-func applyLoadBalancerConfig() bool {
-	var resp struct {
-		Attr []cmn.LbRuleMod `json:"lbAttr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/lbconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, lb := range resp.Attr {
-		hooks.NetLbRuleAdd(&lb)
-	}
-	return true
-}
-
-func applySessionConfig() bool {
-	var resp struct {
-		Attr []cmn.SessionMod `json:"sessionAttr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/sessionconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, session := range resp.Attr {
-		hooks.NetSessionAdd(&session)
-	}
-	return true
-}
-
-func applyUlClConfig() bool {
-	var resp struct {
-		Attr []cmn.SessionUlClMod `json:"ulclAttr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/sessionulclconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, ulcl := range resp.Attr {
-		hooks.NetSessionUlClAdd(&ulcl)
-	}
-	return true
-}
-
-func applyFWConfig() bool {
-	var resp struct {
-		Attr []cmn.FwRuleMod `json:"fwAttr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/FWconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, fw := range resp.Attr {
-		hooks.NetFwRuleAdd(&fw)
-	}
-	return true
-}
-
-func applyEPConfig() bool {
-	var resp struct {
-		Attr []cmn.EndPointMod `json:"Attr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/EPconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, ep := range resp.Attr {
-		hooks.NetEpHostAdd(&ep)
-	}
-	return true
-}
-
-func ApplyBFDConfig() bool {
-	var resp struct {
-		Attr []cmn.BFDMod `json:"Attr"`
-	}
-	dpath := opt.Opts.ConfigPath + "/BFDconfig.txt"
-	byteBuf, err := os.ReadFile(dpath)
-	if err != nil {
-		fmt.Println(err.Error())
-		return false
-	}
-
-	// Unmashal to Json
-	if err := json.Unmarshal(byteBuf, &resp); err != nil {
-		fmt.Printf("Error: Failed to unmarshal File: (%s)\n", err.Error())
-		return false
-	}
-	for _, bfd := range resp.Attr {
-		hooks.NetBFDAdd(&bfd)
-	}
-	return true
-}
-
-func applyRoutes(name string) {
-	tk.LogIt(tk.LogDebug, "[NLP] Applying Route Config for %s \n", name)
-	command := "loxicmd apply --per-intf " + name + " -r -c " + opt.Opts.ConfigPath + "/ipconfig/"
-	cmd := exec.Command("bash", "-c", command)
-	output, err := cmd.Output()
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-	fmt.Printf("%v\n", string(output))
-}
-
 func applyConfigMap(name string, state bool, add bool) {
-	var configApplied bool
-	var needRouteApply bool
 	dpath := opt.Opts.ConfigPath + "/ipconfig/"
 
 	if _, err := os.Stat(dpath); errors.Is(err, os.ErrNotExist) {
 		return
 	}
-	if add {
-		if _, ok := nNl.IMap[name]; ok {
-			configApplied = nNl.IMap[name].configApplied
-			if !nNl.IMap[name].configApplied {
-				tk.LogIt(tk.LogDebug, "[NLP] Applying Config for %s \n", name)
-				if applyAllConfig(name) {
-					configApplied = true
-					tk.LogIt(tk.LogDebug, "[NLP] Applied Config for %s \n", name)
-				} else {
-					configApplied = false
-					tk.LogIt(tk.LogError, "[NLP] Applied Config for %s - FAILED\n", name)
-				}
-				nNl.IMap[name] = Intf{dev: name, state: state, configApplied: configApplied, needRouteApply: false}
-			} else if nNl.IMap[name].state != state {
-				needRouteApply = nNl.IMap[name].needRouteApply
-				if state && nNl.IMap[name].needRouteApply {
-					applyRoutes(name)
-					needRouteApply = false
-				} else if !state {
-					needRouteApply = true
-					tk.LogIt(tk.LogDebug, "[NLP] Route Config for %s will be tried\n", name)
-				}
-				nNl.IMap[name] = Intf{dev: name, state: state, configApplied: configApplied, needRouteApply: needRouteApply}
-			}
-			tk.LogIt(tk.LogDebug, "[NLP] ConfigMap for %s : %v \n", name, nNl.IMap[name])
-		} else {
-			tk.LogIt(tk.LogDebug, "[NLP] Applying Config for %s \n", name)
-			if applyAllConfig(name) {
-				configApplied = true
-				tk.LogIt(tk.LogDebug, "[NLP] Applied Config for %s \n", name)
-			} else {
-				configApplied = false
-				tk.LogIt(tk.LogError, "[NLP] Applied Config for %s - FAILED\n", name)
-			}
-			nNl.IMap[name] = Intf{dev: name, state: state, configApplied: configApplied}
-		}
-	} else {
+
+	nNl.IMtx.Lock()
+	defer nNl.IMtx.Unlock()
+
+	if !add {
 		delete(nNl.IMap, name)
+		return
 	}
+
+	intf, ok := nNl.IMap[name]
+	if !ok {
+		intf = Intf{dev: name}
+	}
+	intf.state = state
+	ipCfgApply(&intf, dpath)
+	nNl.IMap[name] = intf
+	tk.LogIt(tk.LogDebug, "[NLP] ConfigMap for %s : %v \n", name, intf)
 }
 
 func AddFDBNoHook(macAddress, ifName string) int {
@@ -1471,6 +1295,7 @@ func NLWorker(nNl *NlH, bgpPeerMode bool, ch chan bool, wch chan bool) {
 			AUWorker(nNl.FromAUCh, nNl.FromAUDone)
 			NUWorker(nNl.FromNUCh, nNl.FromNUDone)
 			RUWorker(nNl.FromRUCh, nNl.FromRUDone)
+			ipCfgRetry()
 			time.Sleep(500 * time.Millisecond)
 		}
 	}
@@ -1617,52 +1442,9 @@ var nNl *NlH
 func LbSessionGet(done bool) int {
 
 	if done {
-
-		if _, err := os.Stat(opt.Opts.ConfigPath + "/EPconfig.txt"); errors.Is(err, os.ErrNotExist) {
-			if err != nil {
-				tk.LogIt(tk.LogInfo, "[NLP] No EndPoint config file : %s \n", err.Error())
-			}
-		} else {
-			applyEPConfig()
-		}
-		tk.LogIt(tk.LogInfo, "[NLP] EndPoint done\n")
-
-		if _, err := os.Stat(opt.Opts.ConfigPath + "/lbconfig.txt"); errors.Is(err, os.ErrNotExist) {
-			if err != nil {
-				tk.LogIt(tk.LogInfo, "[NLP] No load balancer config file : %s \n", err.Error())
-			}
-		} else {
-			applyLoadBalancerConfig()
-		}
-
-		tk.LogIt(tk.LogInfo, "[NLP] LoadBalancer done\n")
-		if _, err := os.Stat(opt.Opts.ConfigPath + "/sessionconfig.txt"); errors.Is(err, os.ErrNotExist) {
-			if err != nil {
-				tk.LogIt(tk.LogInfo, "[NLP] No Session config file : %s \n", err.Error())
-			}
-		} else {
-			applySessionConfig()
-		}
-
-		tk.LogIt(tk.LogInfo, "[NLP] Session done\n")
-		if _, err := os.Stat(opt.Opts.ConfigPath + "/sessionulclconfig.txt"); errors.Is(err, os.ErrNotExist) {
-			if err != nil {
-				tk.LogIt(tk.LogInfo, "[NLP] No UlCl config file : %s \n", err.Error())
-			}
-		} else {
-			applyUlClConfig()
+		if _, err := hooks.NetConfigRestore(); err != nil {
+			tk.LogIt(tk.LogError, "[NLP] Config restore failed : %s\n", err.Error())
 		}
-
-		tk.LogIt(tk.LogInfo, "[NLP] Session UlCl done\n")
-		if _, err := os.Stat(opt.Opts.ConfigPath + "/FWconfig.txt"); errors.Is(err, os.ErrNotExist) {
-			if err != nil {
-				tk.LogIt(tk.LogInfo, "[NLP] No Firewall config file : %s \n", err.Error())
-			}
-		} else {
-			applyFWConfig()
-		}
-		tk.LogIt(tk.LogInfo, "[NLP] Firewall done\n")
-
 		tk.LogIt(tk.LogInfo, "[NLP] LbSessionGet done\n")
 	}
 
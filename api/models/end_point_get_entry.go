@@ -17,6 +17,9 @@ import (
 // swagger:model EndPointGetEntry
 type EndPointGetEntry struct {
 
+	// Number of consecutive successful probes to mark end-point active
+	ActiveReTries int64 `json:"activeReTries,omitempty"`
+
 	// Average delay seen for endpoint
 	AvgDelay string `json:"avgDelay,omitempty"`
 
@@ -38,18 +41,42 @@ type EndPointGetEntry struct {
 	// Endpoint Identifier
 	Name string `json:"name,omitempty"`
 
+	// Command to run for exec probes. End-point IP and port are passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables
+	ProbeCmd string `json:"probeCmd,omitempty"`
+
 	// How frequently to probe in seconds
 	ProbeDuration int64 `json:"probeDuration,omitempty"`
 
+	// Extra headers as Name:Value for http/https probes
+	ProbeHeaders []string `json:"probeHeaders"`
+
+	// Host header for http/https probes
+	ProbeHost string `json:"probeHost,omitempty"`
+
+	// Max random delay in seconds added to each probe interval
+	ProbeJitter int64 `json:"probeJitter,omitempty"`
+
+	// Method for http/https probes
+	ProbeMethod string `json:"probeMethod,omitempty"`
+
 	// The l4port to probe on
 	ProbePort int64 `json:"probePort,omitempty"`
 
-	// URI for http/https probes
+	// URI for http/https probes or service name for grpc probes
 	ProbeReq string `json:"probeReq,omitempty"`
 
 	// Response for http/https probes
 	ProbeResp string `json:"probeResp,omitempty"`
 
+	// Accepted status codes for http/https probes
+	ProbeRespCodes []int64 `json:"probeRespCodes"`
+
+	// Regex to match response body for http/https probes
+	ProbeRespRegex string `json:"probeRespRegex,omitempty"`
+
+	// Timeout of each probe in seconds, 2 by default and at most 30. It must be less than probeDuration
+	ProbeTimeout int64 `json:"probeTimeout,omitempty"`
+
 	// Type of probe used
 	ProbeType string `json:"probeType,omitempty"`
 }
@@ -29,6 +29,9 @@ type FirewallOptionEntry struct {
 	// Drop any matching rule
 	Drop bool `json:"drop,omitempty"`
 
+	// Time in RFC3339 format at which the rule expires
+	Expiry string `json:"expiry,omitempty"`
+
 	// Set a fwmark for any matching rule
 	FwMark int64 `json:"fwMark,omitempty"`
 
@@ -41,6 +44,18 @@ type FirewallOptionEntry struct {
 	// Redirect any matching rule
 	RedirectPortName string `json:"redirectPortName,omitempty"`
 
+	// Remaining lifetime of the rule in seconds
+	Remaining int64 `json:"remaining,omitempty"`
+
+	// Recurring active window as "min hour dom mon dow window" e.g "0 9 * * 1-5 8h"
+	Schedule string `json:"schedule,omitempty"`
+
+	// State of a scheduled rule - active or inactive
+	ScheduleState string `json:"scheduleState,omitempty"`
+
+	// Lifetime of the rule in seconds
+	TTL int64 `json:"ttl,omitempty"`
+
 	// Modify to given IP in CIDR notation
 	ToIP string `json:"toIP,omitempty"`
 
@@ -17,7 +17,7 @@ import (
 // swagger:model FirewallRuleEntry
 type FirewallRuleEntry struct {
 
-	// Destination IP in CIDR notation
+	// Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)
 	DestinationIP string `json:"destinationIP,omitempty"`
 
 	// Maximum  destination port range
@@ -41,7 +41,7 @@ type FirewallRuleEntry struct {
 	// the protocol
 	Protocol int64 `json:"protocol,omitempty"`
 
-	// Source IP in CIDR notation
+	// Source IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)
 	SourceIP string `json:"sourceIP,omitempty"`
 }
 
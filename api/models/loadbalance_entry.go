@@ -223,9 +223,15 @@ func (m *LoadbalanceEntry) UnmarshalBinary(b []byte) error {
 // swagger:model LoadbalanceEntryEndpointsItems0
 type LoadbalanceEntryEndpointsItems0 struct {
 
+	// Remaining active connections of a draining endpoint
+	ActiveConns int64 `json:"activeConns,omitempty"`
+
 	// traffic counters of the endpoint
 	Counter string `json:"counter,omitempty"`
 
+	// Weight currently in effect for the endpoint
+	CurrWeight int64 `json:"currWeight,omitempty"`
+
 	// IP address for external access
 	EndpointIP string `json:"endpointIP,omitempty"`
 
@@ -315,6 +321,9 @@ type LoadbalanceEntryServiceArguments struct {
 	// block-number if any of this LB entry
 	Block uint16 `json:"block,omitempty"`
 
+	// max time for which removed end-points are drained (in seconds), 0 disables draining
+	DrainTimeout uint32 `json:"drainTimeout,omitempty"`
+
 	// IP address for externel access
 	ExternalIP string `json:"externalIP,omitempty"`
 
@@ -327,6 +336,12 @@ type LoadbalanceEntryServiceArguments struct {
 	// externally managed rule or not
 	Managed bool `json:"managed,omitempty"`
 
+	// max concurrent connections to the service, 0 means no limit (checked periodically with eBPF datapath)
+	MaxConns uint32 `json:"maxConns,omitempty"`
+
+	// max concurrent connections to an end-point, end-points at limit are skipped, 0 means no limit (checked periodically with eBPF datapath)
+	MaxConnsPerEndpoint uint32 `json:"maxConnsPerEndpoint,omitempty"`
+
 	// value for NAT mode (0-DNAT, 1-oneArm, 2-fullNAT)
 	Mode int32 `json:"mode,omitempty"`
 
@@ -336,16 +351,58 @@ type LoadbalanceEntryServiceArguments struct {
 	// service name
 	Name string `json:"name,omitempty"`
 
+	// max new connections per second to the service, 0 means no limit (experimental, userspace datapath only)
+	NewConnRate uint32 `json:"newConnRate,omitempty"`
+
+	// base ejection time of an outlier end-point (in seconds)
+	OdEjectTime uint32 `json:"odEjectTime,omitempty"`
+
+	// failed connection ratio (in percent) to eject an end-point as outlier, 0 disables outlier detection
+	OdFailRatio uint8 `json:"odFailRatio,omitempty"`
+
+	// max percent of end-points ejected as outliers at a time
+	OdMaxEjectPct uint8 `json:"odMaxEjectPct,omitempty"`
+
 	// end-point specific op (0-create, 1-attachEP, 2-detachEP)
 	Oper int32 `json:"oper,omitempty"`
 
 	// port number for the access
 	Port int64 `json:"port,omitempty"`
 
+	// prefix mask of the service port range, alternative to portMax (at most 4096 ports, 256 with eBPF datapath)
+	PortMask int64 `json:"portMask,omitempty"`
+
+	// last port of the service port range starting at port (at most 4096 ports, 256 with eBPF datapath)
+	PortMax int64 `json:"portMax,omitempty"`
+
+	// value for consecutive successful probes to mark end-point active
+	ProbeActRetries int32 `json:"probeActRetries,omitempty"`
+
+	// extra headers as Name:Value for http/https probe
+	ProbeHeaders []string `json:"probeHeaders"`
+
+	// host header for http/https probe
+	ProbeHost string `json:"probeHost,omitempty"`
+
+	// value for probe interval (in seconds), probeTimeout is used if not set
+	ProbeInterval uint32 `json:"probeInterval,omitempty"`
+
+	// value for max random delay added to each probe interval (in seconds)
+	ProbeJitter uint32 `json:"probeJitter,omitempty"`
+
+	// method for http/https probe
+	ProbeMethod string `json:"probeMethod,omitempty"`
+
+	// accepted status codes for http/https probe
+	ProbeRespCodes []int64 `json:"probeRespCodes"`
+
+	// regex to match response body for http/https probe
+	ProbeRespRegex string `json:"probeRespRegex,omitempty"`
+
 	// value for probe retries
 	ProbeRetries int32 `json:"probeRetries,omitempty"`
 
-	// value for probe timer (in seconds)
+	// value for timeout of each probe (in seconds), 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set, 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set
 	ProbeTimeout uint32 `json:"probeTimeout,omitempty"`
 
 	// probe port if probetype is tcp/udp/sctp
@@ -363,14 +420,32 @@ type LoadbalanceEntryServiceArguments struct {
 	// value for access protocol
 	Protocol string `json:"protocol,omitempty"`
 
+	// number of new connections rejected due to connection limits
+	RejectedConns uint64 `json:"rejectedConns,omitempty"`
+
 	// value for Security mode (0-Plain, 1-HTTPs)
 	Security int32 `json:"security,omitempty"`
 
-	// value for load balance algorithim
+	// value for load balance algorithim (maglev is approximated with 16 hash slots by eBPF datapath)
 	Sel int64 `json:"sel,omitempty"`
 
+	// slow-start window of a newly active end-point (in seconds), 0 disables slow-start, only valid with weighted (prio or maglev) selection
+	SlowStart uint32 `json:"slowStart,omitempty"`
+
+	// weight ramp curve during slow-start (linear, quadratic or sqrt)
+	SlowStartCurve string `json:"slowStartCurve,omitempty"`
+
+	// weight (in percent) of an end-point as slow-start begins
+	SlowStartFloor uint8 `json:"slowStartFloor,omitempty"`
+
 	// snat rule
 	Snat bool `json:"snat,omitempty"`
+
+	// counter (packets:bytes) of traffic dropped due to source ranges
+	SourceRangeDrops string `json:"sourceRangeDrops,omitempty"`
+
+	// allowed source prefixes in CIDR notation, all sources are allowed if empty
+	SourceRanges []string `json:"sourceRanges"`
 }
 
 // Validate validates this loadbalance entry service arguments
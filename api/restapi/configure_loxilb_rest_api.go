@@ -57,6 +57,7 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.JSONProducer = runtime.JSONProducer()
+	api.RegisterProducer("text/event-stream", runtime.TextProducer())
 
 	// Load balancer add and delete and get
 	api.PostConfigLoadbalancerHandler = operations.PostConfigLoadbalancerHandlerFunc(handler.ConfigPostLoadbalancer)
@@ -64,7 +65,10 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.DeleteConfigLoadbalancerExternalipaddressIPAddressPortPortProtocolProtoHandler = operations.DeleteConfigLoadbalancerExternalipaddressIPAddressPortPortProtocolProtoHandlerFunc(handler.ConfigDeleteLoadbalancerWithoutPath)
 	api.GetConfigLoadbalancerAllHandler = operations.GetConfigLoadbalancerAllHandlerFunc(handler.ConfigGetLoadbalancer)
 	api.DeleteConfigLoadbalancerAllHandler = operations.DeleteConfigLoadbalancerAllHandlerFunc(handler.ConfigDeleteAllLoadbalancer)
+	api.PutConfigLoadbalancerAllHandler = operations.PutConfigLoadbalancerAllHandlerFunc(handler.ConfigPutAllLoadbalancer)
 	api.DeleteConfigLoadbalancerNameLbNameHandler = operations.DeleteConfigLoadbalancerNameLbNameHandlerFunc(handler.ConfigDeleteLoadbalancerByName)
+	api.PostConfigTransactionHandler = operations.PostConfigTransactionHandlerFunc(handler.ConfigPostTransaction)
+	api.GetConfigTransactionHandler = operations.GetConfigTransactionHandlerFunc(handler.ConfigGetTransaction)
 
 	// Conntrack get
 	api.GetConfigConntrackAllHandler = operations.GetConfigConntrackAllHandlerFunc(handler.ConfigGetConntrack)
@@ -100,10 +104,24 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.DeleteConfigMirrorIdentIdentHandler = operations.DeleteConfigMirrorIdentIdentHandlerFunc(handler.ConfigDeleteMirror)
 	api.GetConfigMirrorAllHandler = operations.GetConfigMirrorAllHandlerFunc(handler.ConfigGetMirror)
 
+	// IP Pool and VIP allocation
+	api.PostConfigIppoolHandler = operations.PostConfigIppoolHandlerFunc(handler.ConfigPostIPPool)
+	api.DeleteConfigIppoolNameNameHandler = operations.DeleteConfigIppoolNameNameHandlerFunc(handler.ConfigDeleteIPPool)
+	api.GetConfigIppoolAllHandler = operations.GetConfigIppoolAllHandlerFunc(handler.ConfigGetIPPool)
+	api.PostConfigIppoolNameNameVipHandler = operations.PostConfigIppoolNameNameVipHandlerFunc(handler.ConfigPostIPPoolVIP)
+	api.DeleteConfigIppoolNameNameVipServiceServiceHandler = operations.DeleteConfigIppoolNameNameVipServiceServiceHandlerFunc(handler.ConfigDeleteIPPoolVIP)
+
+	// IP Set
+	api.PostConfigIpsetHandler = operations.PostConfigIpsetHandlerFunc(handler.ConfigPostIPSet)
+	api.PutConfigIpsetNameNameHandler = operations.PutConfigIpsetNameNameHandlerFunc(handler.ConfigPutIPSet)
+	api.DeleteConfigIpsetNameNameHandler = operations.DeleteConfigIpsetNameNameHandlerFunc(handler.ConfigDeleteIPSet)
+	api.GetConfigIpsetAllHandler = operations.GetConfigIpsetAllHandlerFunc(handler.ConfigGetIPSet)
+
 	// Status
 	api.GetStatusProcessHandler = operations.GetStatusProcessHandlerFunc(handler.ConfigGetProcess)
 	api.GetStatusDeviceHandler = operations.GetStatusDeviceHandlerFunc(handler.ConfigGetDevice)
 	api.GetStatusFilesystemHandler = operations.GetStatusFilesystemHandlerFunc(handler.ConfigGetFileSystem)
+	api.GetStatusIpconfigHandler = operations.GetStatusIpconfigHandlerFunc(handler.ConfigGetIPConfigStatus)
 
 	// VLAN
 	api.GetConfigVlanAllHandler = operations.GetConfigVlanAllHandlerFunc(handler.ConfigGetVLAN)
@@ -146,11 +164,18 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.GetConfigFirewallAllHandler = operations.GetConfigFirewallAllHandlerFunc(handler.ConfigGetFW)
 	api.PostConfigFirewallHandler = operations.PostConfigFirewallHandlerFunc(handler.ConfigPostFW)
 	api.DeleteConfigFirewallHandler = operations.DeleteConfigFirewallHandlerFunc(handler.ConfigDeleteFW)
+	api.GetConfigFirewallEventsHandler = operations.GetConfigFirewallEventsHandlerFunc(handler.ConfigGetFWEvents)
+	api.GetConfigFirewallLogHandler = operations.GetConfigFirewallLogHandlerFunc(handler.ConfigGetFWLog)
+	api.PostConfigFirewallLogHandler = operations.PostConfigFirewallLogHandlerFunc(handler.ConfigPostFWLog)
+	api.GetConfigFirewallLogStreamHandler = operations.GetConfigFirewallLogStreamHandlerFunc(handler.ConfigGetFWLogStream)
+	api.GetConfigFirewallPolicyHandler = operations.GetConfigFirewallPolicyHandlerFunc(handler.ConfigGetFWPolicy)
+	api.PostConfigFirewallPolicyHandler = operations.PostConfigFirewallPolicyHandlerFunc(handler.ConfigPostFWPolicy)
 
 	// EndPoint
 	api.GetConfigEndpointAllHandler = operations.GetConfigEndpointAllHandlerFunc(handler.ConfigGetEndPoint)
 	api.PostConfigEndpointHandler = operations.PostConfigEndpointHandlerFunc(handler.ConfigPostEndPoint)
 	api.DeleteConfigEndpointEpipaddressIPAddressHandler = operations.DeleteConfigEndpointEpipaddressIPAddressHandlerFunc(handler.ConfigDeleteEndPoint)
+	api.GetConfigEndpointEventsHandler = operations.GetConfigEndpointEventsHandlerFunc(handler.ConfigGetEndPointEvents)
 
 	// Params
 	api.PostConfigParamsHandler = operations.PostConfigParamsHandlerFunc(handler.ConfigPostParams)
@@ -1314,6 +1314,48 @@ func init() {
         }
       }
     },
+    "/config/endpoint/events": {
+      "get": {
+        "description": "Stream end-point health state changes and load-balancer end-point set changes as server-sent events.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "summary": "Stream end-point health events",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EndPointEvent"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/config/fdb": {
       "post": {
         "description": "Assign FDB in the device",
@@ -1562,7 +1604,7 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Destination IP in CIDR notation",
+            "description": "Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
             "name": "destinationIP",
             "in": "query"
           },
@@ -1698,18 +1740,96 @@ func init() {
         }
       }
     },
-    "/config/ipv4address": {
+    "/config/firewall/events": {
+      "get": {
+        "description": "Stream expiry of timed firewall rules and schedule changes of scheduled firewall rules as server-sent events.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "summary": "Stream firewall rule events",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallEvent"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/firewall/log": {
+      "get": {
+        "description": "Get the sampling, rate limit and outputs of logging of hits on firewall rules marked record.",
+        "summary": "Get firewall hit logging config",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallLogConfig"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
       "post": {
-        "description": "Assign IPv4 addresses in the device",
-        "summary": "Assign IPv4 addresses in the device",
+        "description": "Set the sampling, rate limit and outputs of logging of hits on firewall rules marked record..",
+        "summary": "Set firewall hit logging config",
         "parameters": [
           {
-            "description": "Attributes for IPv4 address",
+            "description": "Attributes of firewall hit logging",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/IPv4AddressEntry"
+              "$ref": "#/definitions/FirewallLogConfig"
             }
           }
         ],
@@ -1742,7 +1862,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1762,20 +1882,62 @@ func init() {
         }
       }
     },
-    "/config/ipv4address/all": {
+    "/config/firewall/log/stream": {
       "get": {
-        "description": "Get IPv4 addresses in the device(interface)",
-        "summary": "Get IPv4 addresses in the device(interface)",
+        "description": "Stream records of hits on firewall rules marked record as server-sent events..",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "summary": "Stream firewall hit log records",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallLogRecord"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/firewall/policy": {
+      "get": {
+        "description": "Get the policy of each zone for packets which match no firewall rule.",
+        "summary": "Get firewall default policies",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "ipAttr": {
+                "fwPolicyAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/IPv4AddressGetEntry"
+                    "$ref": "#/definitions/FirewallPolicyEntry"
                   }
                 }
               }
@@ -1800,33 +1962,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/ipv4address/{ip_address}/{mask}/dev/{if_name}": {
-      "delete": {
-        "description": "Delete IPv4 addresses in the device",
-        "summary": "Delete IPv4 addresses in the device",
+      },
+      "post": {
+        "description": "Set the policy of a zone for packets which match no firewall rule.",
+        "summary": "Set firewall default policy of a zone",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes IPv4 mask in the device",
-            "name": "mask",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes of the target device",
-            "name": "if_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes of firewall default policy",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FirewallPolicyEntry"
+            }
           }
         ],
         "responses": {
@@ -1858,7 +2006,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1878,27 +2026,24 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer": {
+    "/config/ippool": {
       "post": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+        "description": "Create an ip pool with an IPv4 and/or an IPv6 subnet from which VIPs are allocated.",
+        "summary": "Create an ip pool for VIP allocation",
         "parameters": [
           {
-            "description": "Attributes for load balance service",
+            "description": "Attributes of the ip pool",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/LoadbalanceEntry"
+              "$ref": "#/definitions/IPPoolEntry"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/PostSuccess"
-            }
+          "204": {
+            "description": "OK"
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -1925,7 +2070,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1945,20 +2090,20 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/all": {
+    "/config/ippool/all": {
       "get": {
-        "description": "Get all of the load balancer services with conntrack infomation.",
-        "summary": "Get all of the load balancer services",
+        "description": "Get all ip pools along with their VIP allocations.",
+        "summary": "Get all ip pools",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "lbAttr": {
+                "ippoolAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/LoadbalanceEntry"
+                    "$ref": "#/definitions/IPPoolGetEntry"
                   }
                 }
               }
@@ -1983,10 +2128,21 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/config/ippool/name/{name}": {
       "delete": {
-        "description": "Delete all load balancer services.",
-        "summary": "Delete all Load balancer services",
+        "description": "Delete an ip pool. A pool with VIP allocations can not be deleted.",
+        "summary": "Delete an ip pool",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the ip pool",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "204": {
             "description": "OK"
@@ -2016,7 +2172,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2036,48 +2192,34 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
+    "/config/ippool/name/{name}/vip": {
+      "post": {
+        "description": "Allocate VIPs for a service from an ip pool. Specific VIPs can be asked for, otherwise new VIPs of the asked family are allocated. A service which already holds VIPs gets the same ones back.",
+        "summary": "Allocate VIPs for a service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
+            "description": "Name of the ip pool",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
+            "description": "Attributes of the VIP allocation",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VIPEntry"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/VIPEntry"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -2104,7 +2246,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2124,50 +2266,24 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
+    "/config/ippool/name/{name}/vip/service/{service}": {
       "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
+        "description": "Release all VIPs held by a service back to the ip pool.",
+        "summary": "Release VIPs of a service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "hosturl",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
+            "description": "Name of the ip pool",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
+            "description": "Name of the service",
+            "name": "service",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
           }
         ],
         "responses": {
@@ -2199,7 +2315,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2219,17 +2335,19 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/name/{lb_name}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with name.",
-        "summary": "Delete an existing Load balancer service",
+    "/config/ipset": {
+      "post": {
+        "description": "Create a named ip set of CIDRs which can be used in place of a source or destination CIDR in firewall rules. Only IPv4 members are used by eBPF datapath.",
+        "summary": "Create an ip set",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes for load balance service name",
-            "name": "lb_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes of the ip set",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPSetEntry"
+            }
           }
         ],
         "responses": {
@@ -2261,7 +2379,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2281,18 +2399,65 @@ func init() {
         }
       }
     },
-    "/config/mirror": {
-      "post": {
-        "description": "Create a new Mirror config.",
-        "summary": "Create a new Mirror config",
+    "/config/ipset/all": {
+      "get": {
+        "description": "Get all ip sets along with the number of firewall rules using them.",
+        "summary": "Get all ip sets",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipsetAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPSetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/ipset/name/{name}": {
+      "put": {
+        "description": "Replace all members of an ip set at once. Firewall rules using the set are updated in the datapath without being re-created.",
+        "summary": "Replace members of an ip set",
         "parameters": [
           {
-            "description": "Attributes for Mirror",
+            "type": "string",
+            "description": "Name of the ip set",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Attributes of the ip set",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MirrorEntry"
+              "$ref": "#/definitions/IPSetEntry"
             }
           }
         ],
@@ -2325,47 +2490,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/mirror/all": {
-      "get": {
-        "description": "Get",
-        "summary": "Get",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "mirrAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/MirrorGetEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2383,17 +2508,15 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/mirror/ident/{ident}": {
+      },
       "delete": {
-        "description": "Delete a new Create a Mirror service.",
-        "summary": "Delete a Mirror service",
+        "description": "Delete an ip set. A set used by firewall rules can not be deleted.",
+        "summary": "Delete an ip set",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes of Mirror Ident.",
-            "name": "ident",
+            "description": "Name of the ip set",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -2427,7 +2550,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2447,10 +2570,10 @@ func init() {
         }
       }
     },
-    "/config/neighbor": {
+    "/config/ipv4address": {
       "post": {
-        "description": "Assign IPv4 neighbor in the device",
-        "summary": "Assign IPv4 neighbor in the device",
+        "description": "Assign IPv4 addresses in the device",
+        "summary": "Assign IPv4 addresses in the device",
         "parameters": [
           {
             "description": "Attributes for IPv4 address",
@@ -2458,7 +2581,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/NeighborEntry"
+              "$ref": "#/definitions/IPv4AddressEntry"
             }
           }
         ],
@@ -2511,20 +2634,20 @@ func init() {
         }
       }
     },
-    "/config/neighbor/all": {
+    "/config/ipv4address/all": {
       "get": {
-        "description": "Get IPv4 neighbor in the device(interface)",
-        "summary": "Get IPv4 neighbor in the device(interface)",
+        "description": "Get IPv4 addresses in the device(interface)",
+        "summary": "Get IPv4 addresses in the device(interface)",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "neighborAttr": {
+                "ipAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/NeighborEntry"
+                    "$ref": "#/definitions/IPv4AddressGetEntry"
                   }
                 }
               }
@@ -2551,10 +2674,10 @@ func init() {
         }
       }
     },
-    "/config/neighbor/{ip_address}/dev/{if_name}": {
+    "/config/ipv4address/{ip_address}/{mask}/dev/{if_name}": {
       "delete": {
-        "description": "Delete IPv4 neighbor in the device",
-        "summary": "Delete IPv4 neighbor in the device",
+        "description": "Delete IPv4 addresses in the device",
+        "summary": "Delete IPv4 addresses in the device",
         "parameters": [
           {
             "type": "string",
@@ -2563,6 +2686,13 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Attributes IPv4 mask in the device",
+            "name": "mask",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "description": "Attributes of the target device",
@@ -2620,20 +2750,28 @@ func init() {
         }
       }
     },
-    "/config/params": {
-      "get": {
-        "description": "Get Operational params of LoxiLB",
-        "summary": "Get Operational params of LoxiLB",
+    "/config/loadbalancer": {
+      "post": {
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
+        "parameters": [
+          {
+            "description": "Attributes for load balance service",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceEntry"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/OperParams"
+              "$ref": "#/definitions/PostSuccess"
             }
           },
-          "204": {
-            "description": "OK"
-          },
           "400": {
             "description": "Malformed arguments for API call",
             "schema": {
@@ -2677,29 +2815,25 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Set Operational parameters of LoxiLB",
-        "summary": "Set Operational parameters of LoxiLB",
-        "parameters": [
-          {
-            "description": "Attributes for setting state",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/OperParams"
-            }
-          }
-        ],
+      }
+    },
+    "/config/loadbalancer/all": {
+      "get": {
+        "description": "Get all of the load balancer services with conntrack infomation.",
+        "summary": "Get all of the load balancer services",
         "responses": {
-          "204": {
-            "description": "OK"
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "lbAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/LoadbalanceEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -2708,24 +2842,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -2739,26 +2855,33 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/policy": {
-      "post": {
-        "description": "Create a new Policy QoS config.",
-        "summary": "Create a new Policy QoS config",
+      },
+      "put": {
+        "description": "Make the load balancer services match the given set. Missing services are added, changed services are updated and services not in the set are deleted. Unchanged services and their connections are left untouched.",
+        "summary": "Set the complete set of load balancer services",
         "parameters": [
           {
-            "description": "Attributes for Policy",
+            "description": "Complete set of load balancer services",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/PolicyEntry"
+              "$ref": "#/definitions/LoadbalanceEntryList"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the changes which would be done without applying them",
+            "name": "dryRun",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -2785,7 +2908,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2803,25 +2926,18 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/policy/all": {
-      "get": {
-        "description": "Get",
-        "summary": "Get",
+      },
+      "delete": {
+        "description": "Delete all load balancer services.",
+        "summary": "Delete all Load balancer services",
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "polAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/PolicyEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -2830,6 +2946,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -2845,17 +2979,43 @@ func init() {
         }
       }
     },
-    "/config/policy/ident/{ident}": {
+    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
       "delete": {
-        "description": "Delete a new Create a Policy QoS service.",
-        "summary": "Delete a Policy QoS service",
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes of Policy Ident.",
-            "name": "ident",
+            "description": "Attributes for load balance service",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "proto",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2907,23 +3067,60 @@ func init() {
         }
       }
     },
-    "/config/port/all": {
-      "get": {
-        "description": "Get all of the port interfaces.",
-        "summary": "Get all of the port interfaces",
+    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "hosturl",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "proto",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "portAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/PortEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -2932,6 +3129,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -2947,19 +3162,17 @@ func init() {
         }
       }
     },
-    "/config/route": {
-      "post": {
-        "description": "Create a new route config .",
-        "summary": "Create a new route config",
+    "/config/loadbalancer/name/{lb_name}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with name.",
+        "summary": "Delete an existing Load balancer service",
         "parameters": [
           {
-            "description": "Attributes for load balance service",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RouteEntry"
-            }
+            "type": "string",
+            "description": "Attributes for load balance service name",
+            "name": "lb_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -3011,24 +3224,24 @@ func init() {
         }
       }
     },
-    "/config/route/all": {
-      "get": {
-        "description": "Get all route table",
-        "summary": "Get all route table",
-        "responses": {
-          "200": {
-            "description": "OK",
+    "/config/mirror": {
+      "post": {
+        "description": "Create a new Mirror config.",
+        "summary": "Create a new Mirror config",
+        "parameters": [
+          {
+            "description": "Attributes for Mirror",
+            "name": "attr",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "routeAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/RouteGetEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/MirrorEntry"
             }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -3075,22 +3288,55 @@ func init() {
         }
       }
     },
-    "/config/route/destinationIPNet/{ip_address}/{mask}": {
-      "delete": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+    "/config/mirror/all": {
+      "get": {
+        "description": "Get",
+        "summary": "Get",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "mirrAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/MirrorGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/mirror/ident/{ident}": {
+      "delete": {
+        "description": "Delete a new Create a Mirror service.",
+        "summary": "Delete a Mirror service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes for destinaion route address",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Attributes for destination route",
-            "name": "mask",
+            "description": "Attributes of Mirror Ident.",
+            "name": "ident",
             "in": "path",
             "required": true
           }
@@ -3144,18 +3390,18 @@ func init() {
         }
       }
     },
-    "/config/session": {
+    "/config/neighbor": {
       "post": {
-        "description": "Create a new session config for 5G.",
-        "summary": "Create a new session config",
+        "description": "Assign IPv4 neighbor in the device",
+        "summary": "Assign IPv4 neighbor in the device",
         "parameters": [
           {
-            "description": "Attributes for 5G service session",
+            "description": "Attributes for IPv4 address",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SessionEntry"
+              "$ref": "#/definitions/NeighborEntry"
             }
           }
         ],
@@ -3208,20 +3454,20 @@ func init() {
         }
       }
     },
-    "/config/session/all": {
+    "/config/neighbor/all": {
       "get": {
-        "description": "Get all of the port interfaces.",
-        "summary": "Get all of the port interfaces",
+        "description": "Get IPv4 neighbor in the device(interface)",
+        "summary": "Get IPv4 neighbor in the device(interface)",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "sessionAttr": {
+                "neighborAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/SessionEntry"
+                    "$ref": "#/definitions/NeighborEntry"
                   }
                 }
               }
@@ -3248,15 +3494,22 @@ func init() {
         }
       }
     },
-    "/config/session/ident/{ident}": {
+    "/config/neighbor/{ip_address}/dev/{if_name}": {
       "delete": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+        "description": "Delete IPv4 neighbor in the device",
+        "summary": "Delete IPv4 neighbor in the device",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes 5G session Ident.",
-            "name": "ident",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes of the target device",
+            "name": "if_name",
             "in": "path",
             "required": true
           }
@@ -3310,22 +3563,17 @@ func init() {
         }
       }
     },
-    "/config/sessionulcl": {
-      "post": {
-        "description": "Create a new session config for 5G.",
-        "summary": "Create a new session config",
-        "parameters": [
-          {
-            "description": "Attributes for 5G service session",
-            "name": "attr",
-            "in": "body",
-            "required": true,
+    "/config/params": {
+      "get": {
+        "description": "Get Operational params of LoxiLB",
+        "summary": "Get Operational params of LoxiLB",
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/SessionUlClEntry"
+              "$ref": "#/definitions/OperParams"
             }
-          }
-        ],
-        "responses": {
+          },
           "204": {
             "description": "OK"
           },
@@ -3372,66 +3620,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/sessionulcl/all": {
-      "get": {
-        "description": "Get",
-        "summary": "Get",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "ulclAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/SessionUlClEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/sessionulcl/ident/{ident}/ulclAddress/{ip_address}": {
-      "delete": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+      },
+      "post": {
+        "description": "Set Operational parameters of LoxiLB",
+        "summary": "Set Operational parameters of LoxiLB",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes 5G session Ident.",
-            "name": "ident",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for session ulcl address",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
+            "description": "Attributes for setting state",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OperParams"
+            }
           }
         ],
         "responses": {
@@ -3483,18 +3684,18 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan": {
+    "/config/policy": {
       "post": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Add a one of vxlan configuration",
+        "description": "Create a new Policy QoS config.",
+        "summary": "Create a new Policy QoS config",
         "parameters": [
           {
-            "description": "attributes for vxlan member interface",
+            "description": "Attributes for Policy",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VxlanBridgeEntry"
+              "$ref": "#/definitions/PolicyEntry"
             }
           }
         ],
@@ -3502,14 +3703,32 @@ func init() {
           "204": {
             "description": "OK"
           },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "409": {
-            "description": "Resource Conflict. VxLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -3529,20 +3748,20 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan/all": {
+    "/config/policy/all": {
       "get": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Get a list of vxlan configurations",
+        "description": "Get",
+        "summary": "Get",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "vxlanAttr": {
+                "polAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/VxlanEntry"
+                    "$ref": "#/definitions/PolicyEntry"
                   }
                 }
               }
@@ -3569,16 +3788,15 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan/{vxlanID}": {
+    "/config/policy/ident/{ident}": {
       "delete": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Delete a one of vxlan configuration",
+        "description": "Delete a new Create a Policy QoS service.",
+        "summary": "Delete a Policy QoS service",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
+            "type": "string",
+            "description": "Attributes of Policy Ident.",
+            "name": "ident",
             "in": "path",
             "required": true
           }
@@ -3587,59 +3805,32 @@ func init() {
           "204": {
             "description": "OK"
           },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "503": {
-            "description": "Maintanence mode",
+          "404": {
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/config/tunnel/vxlan/{vxlanID}/peer": {
-      "post": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Add a one of vxlan remote(peer) ip address configuration",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "attributes for vxlan Peer interface",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/VxlanPeerEntry"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/VxlanPeerEntry"
-            }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -3659,32 +3850,23 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan/{vxlanID}/peer/{PeerIP}": {
-      "delete": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Remove a one of vxlan remote(peer) ip address configuration",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "attributes for vxlan Peer IP address",
-            "name": "PeerIP",
-            "in": "path",
-            "required": true
-          }
-        ],
+    "/config/port/all": {
+      "get": {
+        "description": "Get all of the port interfaces.",
+        "summary": "Get all of the port interfaces",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/VxlanEntry"
+              "type": "object",
+              "properties": {
+                "portAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/PortEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -3708,18 +3890,18 @@ func init() {
         }
       }
     },
-    "/config/vlan": {
+    "/config/route": {
       "post": {
-        "description": "Create vlan interface in the device",
-        "summary": "Create vlan interface in the device",
+        "description": "Create a new route config .",
+        "summary": "Create a new route config",
         "parameters": [
           {
-            "description": "Attributes for Vlan Interface",
+            "description": "Attributes for load balance service",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VlanBridgeEntry"
+              "$ref": "#/definitions/RouteEntry"
             }
           }
         ],
@@ -3772,63 +3954,25 @@ func init() {
         }
       }
     },
-    "/config/vlan/all": {
+    "/config/route/all": {
       "get": {
-        "description": "Get vlan in the device",
-        "summary": "Get vlan in the device",
+        "description": "Get all route table",
+        "summary": "Get all route table",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "vlanAttr": {
+                "routeAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/VlanGetEntry"
+                    "$ref": "#/definitions/RouteGetEntry"
                   }
                 }
               }
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/vlan/{vlan_id}": {
-      "delete": {
-        "description": "Delete vlan in the device",
-        "summary": "Delete vlan in the device",
-        "parameters": [
-          {
-            "type": "integer",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "vlan_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "OK"
-          },
           "400": {
             "description": "Malformed arguments for API call",
             "schema": {
@@ -3874,27 +4018,24 @@ func init() {
         }
       }
     },
-    "/config/vlan/{vlan_id}/member": {
-      "post": {
-        "description": "Add a member to interface Vlan{vlan_id}. If the vlan interface does not exist on LoxiLB it returns a '404' error. If such a member is already present on this Vlan interface the API returns '409' sub-code 0. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'. If attr with tagging mode is provided it will be honored in config, if not, the default tagging mode will be set to 'untagged'. Vlan members may be tagged or untagged, but, the Vlan member port may be untagged in only one Vlan interface, deviations from this will cause the API to return '409' sub-code 0.",
-        "summary": "Add a physical port to a vlan interface",
+    "/config/route/destinationIPNet/{ip_address}/{mask}": {
+      "delete": {
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "12 bit vlan_id",
-            "name": "vlan_id",
+            "type": "string",
+            "description": "Attributes for destinaion route address",
+            "name": "ip_address",
             "in": "path",
             "required": true
           },
           {
-            "description": "Attributes for Vlan Interface",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/VlanMemberEntry"
-            }
+            "type": "integer",
+            "description": "Attributes for destination route",
+            "name": "mask",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -3920,13 +4061,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Vlan interface is not defined",
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN member already exists on this VLAN interface OR Vlan member is being added to 2nd Vlan inteface as an untagged member.",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -3946,32 +4087,19 @@ func init() {
         }
       }
     },
-    "/config/vlan/{vlan_id}/member/{if_name}/tagged/{tagged}": {
-      "delete": {
-        "description": "Remove a vlan member from a vlan interface which is defined by vlan_id. If the Vlan interface does not exist on LoxiLB OR a vlan member 'if_name' is not present on the interface the API will return '404'. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'.",
-        "summary": "Remove a vlan member from a vlan interface",
+    "/config/session": {
+      "post": {
+        "description": "Create a new session config for 5G.",
+        "summary": "Create a new session config",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "12 bit vlan_id",
-            "name": "vlan_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Physical port name",
-            "name": "if_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "Tagged status",
-            "name": "tagged",
-            "in": "path",
-            "required": true
+            "description": "Attributes for 5G service session",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SessionEntry"
+            }
           }
         ],
         "responses": {
@@ -3990,53 +4118,20 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Vlan interface is not defined/Vlan member is not found on this Vlan interface",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "503": {
-            "description": "Maintanence mode",
+          "404": {
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics": {
-      "get": {
-        "summary": "Scrape metrics from the cache",
-        "responses": {
-          "200": {
-            "description": "Metrics in prometheus text format",
-            "schema": {
-              "type": "string"
-            }
-          }
-        }
-      }
-    },
-    "/status/device": {
-      "get": {
-        "description": "Get a basic info (linux command \"uptime, hostnamectl\") in the device or system.",
-        "summary": "Get a basic info in the device",
-        "responses": {
-          "200": {
-            "description": "Device unique informations",
-            "schema": {
-              "$ref": "#/definitions/DeviceInfoEntry"
-            }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -4056,20 +4151,20 @@ func init() {
         }
       }
     },
-    "/status/filesystem": {
+    "/config/session/all": {
       "get": {
-        "description": "Get a File system infomation (linux command \"df\") in the device or system.",
-        "summary": "Get a File System info in the device",
+        "description": "Get all of the port interfaces.",
+        "summary": "Get all of the port interfaces",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "filesystemAttr": {
+                "sessionAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/FileSystemInfoEntry"
+                    "$ref": "#/definitions/SessionEntry"
                   }
                 }
               }
@@ -4096,20 +4191,146 @@ func init() {
         }
       }
     },
-    "/status/process": {
-      "get": {
-        "description": "Get a process based on high usage CPU(linux command \"top\") in the device or system.",
-        "summary": "Get a process based on CPU usage info in the device",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
+    "/config/session/ident/{ident}": {
+      "delete": {
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes 5G session Ident.",
+            "name": "ident",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/sessionulcl": {
+      "post": {
+        "description": "Create a new session config for 5G.",
+        "summary": "Create a new session config",
+        "parameters": [
+          {
+            "description": "Attributes for 5G service session",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SessionUlClEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/sessionulcl/all": {
+      "get": {
+        "description": "Get",
+        "summary": "Get",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
               "type": "object",
               "properties": {
-                "processAttr": {
+                "ulclAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/ProcessInfoEntry"
+                    "$ref": "#/definitions/SessionUlClEntry"
                   }
                 }
               }
@@ -4135,1627 +4356,4049 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "BGPApplyPolicyToNeighborMod": {
-      "type": "object",
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "policyType": {
-          "type": "string"
-        },
-        "routeAction": {
-          "type": "string"
-        }
-      }
     },
-    "BGPGlobalConfig": {
-      "type": "object",
-      "properties": {
-        "SetNextHopSelf": {
-          "description": "Adds policy to set next hop as self, if enabled",
-          "type": "boolean"
-        },
-        "listenPort": {
-          "description": "Listen port (default 179)",
-          "type": "integer"
-        },
-        "localAs": {
-          "description": "Local AS number",
-          "type": "integer"
-        },
-        "routerId": {
-          "description": "BGP Router ID",
-          "type": "string"
+    "/config/sessionulcl/ident/{ident}/ulclAddress/{ip_address}": {
+      "delete": {
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes 5G session Ident.",
+            "name": "ident",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for session ulcl address",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "BGPNeigh": {
-      "type": "object",
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "remoteAs": {
-          "description": "Remote AS number",
-          "type": "integer"
-        },
-        "remotePort": {
-          "description": "Remote Connect Port (default 179)",
-          "type": "integer"
-        },
-        "setMultiHop": {
-          "description": "Enable multi-hop peering (if needed)",
-          "type": "boolean"
-        }
-      }
-    },
-    "BGPNeighGetEntry": {
-      "type": "object",
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "remoteAs": {
-          "description": "Remote AS number",
-          "type": "integer"
-        },
-        "state": {
-          "description": "Current state",
-          "type": "string"
-        },
-        "updowntime": {
-          "description": "Current uptime",
-          "type": "string"
+    "/config/transaction": {
+      "get": {
+        "description": "Get the current config generation to be used for compare-and-set of transactions.",
+        "summary": "Get the current config generation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
-      }
-    },
-    "BGPPolicyDefinedSetGetEntry": {
-      "type": "object",
-      "properties": {
-        "list": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "description": "Validate and apply a set of load balancer rules, firewall rules and end-points as one transaction. Nothing is changed if any of them fails.",
+        "summary": "Commit a bulk configuration transaction",
+        "parameters": [
+          {
+            "description": "Attributes of the transaction",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ConfigTransaction"
+            }
           }
-        },
-        "name": {
-          "description": "BGP Defined set Entries",
-          "type": "string"
-        },
-        "prefixList": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyPrefix"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinedSetsMod": {
-      "type": "object",
-      "properties": {
-        "List": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/config/tunnel/vxlan": {
+      "post": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Add a one of vxlan configuration",
+        "parameters": [
+          {
+            "description": "attributes for vxlan member interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VxlanBridgeEntry"
+            }
           }
-        },
-        "name": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "prefixList": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyPrefix"
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VxLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinitionsMod": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "statements": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyDefinitionsStatement"
+    "/config/tunnel/vxlan/all": {
+      "get": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Get a list of vxlan configurations",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "vxlanAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/VxlanEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinitionsStatement": {
-      "type": "object",
-      "properties": {
-        "actions": {
-          "type": "object",
-          "properties": {
-            "bgpActions": {
-              "type": "object",
-              "properties": {
-                "setAsPathPrepend": {
-                  "type": "object",
-                  "properties": {
-                    "as": {
-                      "type": "string"
-                    },
-                    "repeatN": {
-                      "type": "integer"
-                    }
-                  }
-                },
-                "setCommunity": {
-                  "type": "object",
-                  "properties": {
-                    "options": {
-                      "type": "string"
-                    },
-                    "setCommunityMethod": {
-                      "type": "array",
-                      "items": {
-                        "type": "string"
-                      }
-                    }
-                  }
-                },
-                "setExtCommunity": {
-                  "type": "object",
-                  "properties": {
-                    "options": {
-                      "type": "string"
-                    },
-                    "setCommunityMethod": {
-                      "type": "array",
-                      "items": {
-                        "type": "string"
-                      }
-                    }
-                  }
-                },
-                "setLargeCommunity": {
-                  "type": "object",
-                  "properties": {
-                    "options": {
-                      "type": "string"
-                    },
-                    "setCommunityMethod": {
-                      "type": "array",
-                      "items": {
-                        "type": "string"
-                      }
-                    }
-                  }
-                },
-                "setLocalPerf": {
-                  "type": "integer"
-                },
-                "setMed": {
-                  "type": "string"
-                },
-                "setNextHop": {
-                  "type": "string"
-                }
-              }
-            },
-            "routeDisposition": {
-              "type": "string"
-            }
+    "/config/tunnel/vxlan/{vxlanID}": {
+      "delete": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Delete a one of vxlan configuration",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
+            "in": "path",
+            "required": true
           }
-        },
-        "conditions": {
-          "type": "object",
-          "properties": {
-            "bgpConditions": {
-              "type": "object",
-              "properties": {
-                "afiSafiIn": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "asPathLength": {
-                  "type": "object",
-                  "properties": {
-                    "operator": {
-                      "type": "string"
-                    },
-                    "value": {
-                      "type": "integer"
-                    }
-                  }
-                },
-                "matchAsPathSet": {
-                  "type": "object",
-                  "properties": {
-                    "asPathSet": {
-                      "type": "string"
-                    },
-                    "matchSetOptions": {
-                      "type": "string"
-                    }
-                  }
-                },
-                "matchCommunitySet": {
-                  "type": "object",
-                  "properties": {
-                    "communitySet": {
-                      "type": "string"
-                    },
-                    "matchSetOptions": {
-                      "type": "string"
-                    }
-                  }
-                },
-                "matchExtCommunitySet": {
-                  "type": "object",
-                  "properties": {
-                    "communitySet": {
-                      "type": "string"
-                    },
-                    "matchSetOptions": {
-                      "type": "string"
-                    }
-                  }
-                },
-                "matchLargeCommunitySet": {
-                  "type": "object",
-                  "properties": {
-                    "communitySet": {
-                      "type": "string"
-                    },
-                    "matchSetOptions": {
-                      "type": "string"
-                    }
-                  }
-                },
-                "nextHopInList": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "routeType": {
-                  "type": "string"
-                },
-                "rpki": {
-                  "type": "string"
-                }
-              }
-            },
-            "matchNeighborSet": {
-              "type": "object",
-              "properties": {
-                "matchSetOption": {
-                  "type": "string"
-                },
-                "neighborSet": {
-                  "type": "string"
-                }
-              }
-            },
-            "matchPrefixSet": {
-              "type": "object",
-              "properties": {
-                "matchSetOption": {
-                  "type": "string"
-                },
-                "prefixSet": {
-                  "type": "string"
-                }
-              }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        },
-        "name": {
-          "type": "string"
         }
       }
     },
-    "BGPPolicyPrefix": {
-      "type": "object",
-      "properties": {
-        "ipPrefix": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "masklengthRange": {
-          "description": "Remote AS number",
-          "type": "string"
+    "/config/tunnel/vxlan/{vxlanID}/peer": {
+      "post": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Add a one of vxlan remote(peer) ip address configuration",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "attributes for vxlan Peer interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VxlanPeerEntry"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/VxlanPeerEntry"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "BfdEntry": {
-      "type": "object",
-      "properties": {
-        "instance": {
-          "description": "Instance name running BFD session",
-          "type": "string"
-        },
-        "interval": {
-          "description": "Tx interval between BFD packets(in microseconds)",
-          "type": "integer",
-          "format": "uint64"
-        },
-        "remoteIp": {
-          "description": "Remote IP",
-          "type": "string"
-        },
-        "retryCount": {
-          "description": "Retry Count to detect failure",
-          "type": "integer",
-          "format": "uint8"
-        },
-        "sourceIp": {
-          "description": "Remote IP",
-          "type": "string"
-        }
-      }
-    },
-    "BfdGetEntry": {
-      "type": "object",
-      "properties": {
-        "instance": {
-          "description": "Instance name",
-          "type": "string"
-        },
-        "interval": {
-          "description": "Tx interval between BFD packets(in microseconds)",
-          "type": "integer",
-          "format": "uint64"
-        },
-        "port": {
-          "description": "port number to be used for BFD session",
-          "type": "integer",
-          "format": "uint16"
-        },
-        "remoteIp": {
-          "description": "Remote IP",
-          "type": "string"
-        },
-        "retryCount": {
-          "description": "Retry Count to detect failure",
-          "type": "integer",
-          "format": "uint8"
-        },
-        "sourceIP": {
-          "description": "Source IP to be used for BFD session",
-          "type": "string"
-        },
-        "state": {
-          "description": "Current state for BFD session",
-          "type": "string"
+    "/config/tunnel/vxlan/{vxlanID}/peer/{PeerIP}": {
+      "delete": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Remove a one of vxlan remote(peer) ip address configuration",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "attributes for vxlan Peer IP address",
+            "name": "PeerIP",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/VxlanEntry"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "CIStatusEntry": {
-      "type": "object",
-      "properties": {
-        "instance": {
-          "description": "Instance name",
-          "type": "string"
-        },
-        "state": {
-          "description": "Current Cluster Instance State",
-          "type": "string"
-        },
-        "vip": {
-          "description": "Instance Virtual IP address",
-          "type": "string"
+    "/config/vlan": {
+      "post": {
+        "description": "Create vlan interface in the device",
+        "summary": "Create vlan interface in the device",
+        "parameters": [
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VlanBridgeEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "CIStatusGetEntry": {
-      "type": "object",
-      "required": [
-        "sync"
-      ],
-      "properties": {
-        "instance": {
-          "description": "Instance name",
-          "type": "string"
-        },
-        "state": {
-          "description": "Current Cluster Instance State",
-          "type": "string"
-        },
-        "sync": {
-          "description": "Sync - sync state",
-          "type": "integer"
-        },
-        "vip": {
-          "description": "Instance Virtual IP address",
-          "type": "string"
+    "/config/vlan/all": {
+      "get": {
+        "description": "Get vlan in the device",
+        "summary": "Get vlan in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "vlanAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/VlanGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "ConntrackEntry": {
-      "type": "object",
-      "properties": {
-        "bytes": {
-          "description": "Packet bytes of the conntrack",
-          "type": "integer"
-        },
-        "conntrackAct": {
-          "description": "value for Conntrack Act",
-          "type": "string"
-        },
-        "conntrackState": {
-          "description": "value for Conntrack state",
-          "type": "string"
-        },
-        "destinationIP": {
-          "description": "IP address for externel access",
-          "type": "string"
-        },
-        "destinationPort": {
-          "description": "port number for the access",
-          "type": "integer"
-        },
-        "packets": {
-          "description": "Packet counts of the conntrack",
-          "type": "integer"
-        },
-        "protocol": {
-          "description": "value for access protocol",
-          "type": "string"
-        },
-        "servName": {
-          "description": "Connection's Service Name",
-          "type": "string"
-        },
-        "sourceIP": {
-          "description": "IP address for externel access",
-          "type": "string"
-        },
-        "sourcePort": {
-          "description": "port number for the access",
-          "type": "integer"
-        }
-      }
-    },
-    "DeviceInfoEntry": {
-      "type": "object",
-      "properties": {
-        "OS": {
-          "description": "Operation System of the device",
-          "type": "string"
-        },
-        "architecture": {
-          "description": "CPU architecture of the device",
-          "type": "string"
-        },
-        "bootID": {
-          "description": "Boot ID in the linux",
-          "type": "string"
-        },
-        "hostName": {
-          "description": "Device host name",
-          "type": "string"
-        },
-        "kernel": {
-          "description": "Kernel version of the device",
-          "type": "string"
-        },
-        "machineID": {
-          "description": "Device machine ID",
-          "type": "string"
-        },
-        "uptime": {
-          "description": "system uptime",
-          "type": "string"
+    "/config/vlan/{vlan_id}": {
+      "delete": {
+        "description": "Delete vlan in the device",
+        "summary": "Delete vlan in the device",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "vlan_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "EndPoint": {
-      "type": "object",
-      "properties": {
-        "hostName": {
-          "description": "Host name in CIDR",
-          "type": "string"
-        },
-        "inactiveReTries": {
-          "description": "Number of inactive retries",
-          "type": "integer"
-        },
-        "name": {
-          "description": "Endpoint Identifier",
-          "type": "string"
-        },
-        "probeDuration": {
-          "description": "How frequently to probe in seconds",
-          "type": "integer"
-        },
-        "probePort": {
-          "description": "The l4port to probe on",
-          "type": "integer"
-        },
-        "probeReq": {
-          "description": "URI for http/https probes",
-          "type": "string"
-        },
-        "probeResp": {
-          "description": "Response for http/https probes",
-          "type": "string"
-        },
-        "probeType": {
-          "description": "Type of probe used",
-          "type": "string"
+    "/config/vlan/{vlan_id}/member": {
+      "post": {
+        "description": "Add a member to interface Vlan{vlan_id}. If the vlan interface does not exist on LoxiLB it returns a '404' error. If such a member is already present on this Vlan interface the API returns '409' sub-code 0. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'. If attr with tagging mode is provided it will be honored in config, if not, the default tagging mode will be set to 'untagged'. Vlan members may be tagged or untagged, but, the Vlan member port may be untagged in only one Vlan interface, deviations from this will cause the API to return '409' sub-code 0.",
+        "summary": "Add a physical port to a vlan interface",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "12 bit vlan_id",
+            "name": "vlan_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VlanMemberEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Vlan interface is not defined",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN member already exists on this VLAN interface OR Vlan member is being added to 2nd Vlan inteface as an untagged member.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "EndPointGetEntry": {
-      "type": "object",
-      "properties": {
-        "avgDelay": {
-          "description": "Average delay seen for endpoint",
-          "type": "string"
-        },
-        "currState": {
-          "description": "Current state of this endpoint",
-          "type": "string"
-        },
-        "hostName": {
-          "description": "Host name",
-          "type": "string"
-        },
-        "inactiveReTries": {
-          "description": "Number of inactive retries",
-          "type": "integer"
-        },
-        "maxDelay": {
-          "description": "Maximum delay seen for endpoint",
-          "type": "string"
-        },
-        "minDelay": {
-          "description": "Minimum delay seen for endpoint",
-          "type": "string"
-        },
-        "name": {
-          "description": "Endpoint Identifier",
-          "type": "string"
-        },
-        "probeDuration": {
-          "description": "How frequently to probe in seconds",
-          "type": "integer"
-        },
-        "probePort": {
-          "description": "The l4port to probe on",
-          "type": "integer"
-        },
-        "probeReq": {
-          "description": "URI for http/https probes",
-          "type": "string"
-        },
-        "probeResp": {
-          "description": "Response for http/https probes",
-          "type": "string"
-        },
-        "probeType": {
-          "description": "Type of probe used",
-          "type": "string"
+    "/config/vlan/{vlan_id}/member/{if_name}/tagged/{tagged}": {
+      "delete": {
+        "description": "Remove a vlan member from a vlan interface which is defined by vlan_id. If the Vlan interface does not exist on LoxiLB OR a vlan member 'if_name' is not present on the interface the API will return '404'. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'.",
+        "summary": "Remove a vlan member from a vlan interface",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "12 bit vlan_id",
+            "name": "vlan_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Physical port name",
+            "name": "if_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "Tagged status",
+            "name": "tagged",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Vlan interface is not defined/Vlan member is not found on this Vlan interface",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Error": {
+    "/metrics": {
+      "get": {
+        "summary": "Scrape metrics from the cache",
+        "responses": {
+          "200": {
+            "description": "Metrics in prometheus text format",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/status/device": {
+      "get": {
+        "description": "Get a basic info (linux command \"uptime, hostnamectl\") in the device or system.",
+        "summary": "Get a basic info in the device",
+        "responses": {
+          "200": {
+            "description": "Device unique informations",
+            "schema": {
+              "$ref": "#/definitions/DeviceInfoEntry"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status/filesystem": {
+      "get": {
+        "description": "Get a File system infomation (linux command \"df\") in the device or system.",
+        "summary": "Get a File System info in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "filesystemAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/FileSystemInfoEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status/ipconfig": {
+      "get": {
+        "description": "Get apply status of the saved ip config (addresses, routes, vlan and vxlan) of each interface.",
+        "summary": "Get apply status of saved per-interface ip config",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipconfigAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPConfigStatusEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status/process": {
+      "get": {
+        "description": "Get a process based on high usage CPU(linux command \"top\") in the device or system.",
+        "summary": "Get a process based on CPU usage info in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "processAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ProcessInfoEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "BGPApplyPolicyToNeighborMod": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "details": {
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
           "type": "string"
         },
-        "fields": {
+        "policies": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "message": {
+        "policyType": {
           "type": "string"
         },
-        "sub-code": {
-          "type": "integer",
-          "format": "int32"
+        "routeAction": {
+          "type": "string"
         }
       }
     },
-    "FDBEntry": {
+    "BGPGlobalConfig": {
       "type": "object",
       "properties": {
-        "dev": {
-          "description": "Name of the interface device to which you want to modify FDB",
-          "type": "string"
+        "SetNextHopSelf": {
+          "description": "Adds policy to set next hop as self, if enabled",
+          "type": "boolean"
         },
-        "macAddress": {
-          "description": "MAC address to FDB",
-          "type": "string"
-        }
-      }
-    },
-    "FileSystemInfoEntry": {
-      "type": "object",
-      "properties": {
-        "avail": {
-          "description": "size of remain the disk",
-          "type": "string"
-        },
-        "fileSystem": {
-          "description": "File system name mounted on this device",
-          "type": "string"
-        },
-        "mountedOn": {
-          "description": "path of the mounted on",
-          "type": "string"
-        },
-        "size": {
-          "description": "Boot ID in the linux",
-          "type": "string"
-        },
-        "type": {
-          "description": "File type (ex. nfs, ext4..)",
-          "type": "string"
+        "listenPort": {
+          "description": "Listen port (default 179)",
+          "type": "integer"
         },
-        "usePercent": {
-          "description": "usage per total size",
-          "type": "string"
+        "localAs": {
+          "description": "Local AS number",
+          "type": "integer"
         },
-        "used": {
-          "description": "size of used the disk",
+        "routerId": {
+          "description": "BGP Router ID",
           "type": "string"
         }
       }
     },
-    "FirewallEntry": {
-      "type": "object",
-      "properties": {
-        "opts": {
-          "$ref": "#/definitions/FirewallOptionEntry"
-        },
-        "ruleArguments": {
-          "$ref": "#/definitions/FirewallRuleEntry"
-        }
-      }
-    },
-    "FirewallOptionEntry": {
+    "BGPNeigh": {
       "type": "object",
       "properties": {
-        "allow": {
-          "description": "Allow any matching rule",
-          "type": "boolean"
-        },
-        "counter": {
-          "description": "traffic counters",
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
           "type": "string"
         },
-        "doSnat": {
-          "description": "Do SNAT on matching rule",
-          "type": "boolean"
-        },
-        "drop": {
-          "description": "Drop any matching rule",
-          "type": "boolean"
-        },
-        "fwMark": {
-          "description": "Set a fwmark for any matching rule",
+        "remoteAs": {
+          "description": "Remote AS number",
           "type": "integer"
         },
-        "record": {
-          "description": "Record or dump for matching rule",
-          "type": "boolean"
-        },
-        "redirect": {
-          "description": "Redirect any matching rule",
-          "type": "boolean"
-        },
-        "redirectPortName": {
-          "description": "Redirect any matching rule",
-          "type": "string"
-        },
-        "toIP": {
-          "description": "Modify to given IP in CIDR notation",
-          "type": "string"
-        },
-        "toPort": {
-          "description": "Modify to given Port (Zero if port is not to be modified)",
+        "remotePort": {
+          "description": "Remote Connect Port (default 179)",
           "type": "integer"
         },
-        "trap": {
-          "description": "Trap anything matching rule",
+        "setMultiHop": {
+          "description": "Enable multi-hop peering (if needed)",
           "type": "boolean"
         }
       }
     },
-    "FirewallRuleEntry": {
+    "BGPNeighGetEntry": {
       "type": "object",
       "properties": {
-        "destinationIP": {
-          "description": "Destination IP in CIDR notation",
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
           "type": "string"
         },
-        "maxDestinationPort": {
-          "description": "Maximum  destination port range",
-          "type": "integer"
-        },
-        "maxSourcePort": {
-          "description": "Maximum  source port range",
-          "type": "integer"
-        },
-        "minDestinationPort": {
-          "description": "Minimum destination port range",
-          "type": "integer"
-        },
-        "minSourcePort": {
-          "description": "Minimum source port range",
+        "remoteAs": {
+          "description": "Remote AS number",
           "type": "integer"
         },
-        "portName": {
-          "description": "the incoming port",
+        "state": {
+          "description": "Current state",
           "type": "string"
         },
-        "preference": {
-          "description": "User preference for ordering",
-          "type": "integer"
-        },
-        "protocol": {
-          "description": "the protocol",
-          "type": "integer"
-        },
-        "sourceIP": {
-          "description": "Source IP in CIDR notation",
+        "updowntime": {
+          "description": "Current uptime",
           "type": "string"
         }
       }
     },
-    "IPv4AddressEntry": {
+    "BGPPolicyDefinedSetGetEntry": {
       "type": "object",
       "properties": {
-        "dev": {
-          "description": "Name of the interface device to which you want to modify the IP address",
-          "type": "string"
+        "list": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ipAddress": {
-          "description": "IP address to modify.",
+        "name": {
+          "description": "BGP Defined set Entries",
           "type": "string"
+        },
+        "prefixList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BGPPolicyPrefix"
+          }
         }
       }
     },
-    "IPv4AddressGetEntry": {
+    "BGPPolicyDefinedSetsMod": {
       "type": "object",
-      "required": [
-        "sync"
-      ],
       "properties": {
-        "dev": {
-          "description": "Name of the interface device to which you want to modify the IP address",
-          "type": "string"
-        },
-        "ipAddress": {
+        "List": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "sync": {
-          "description": "Sync - sync state",
-          "type": "integer"
+        "name": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "prefixList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BGPPolicyPrefix"
+          }
         }
       }
     },
-    "LoadbalanceEntry": {
+    "BGPPolicyDefinitionsMod": {
       "type": "object",
       "properties": {
-        "endpoints": {
-          "description": "values of End point servers",
-          "type": "array",
-          "items": {
-            "properties": {
-              "counter": {
-                "description": "traffic counters of the endpoint",
-                "type": "string"
-              },
-              "endpointIP": {
-                "description": "IP address for external access",
-                "type": "string"
-              },
-              "state": {
-                "description": "state of the endpoint",
-                "type": "string"
-              },
-              "targetPort": {
-                "description": "port number for access service",
-                "type": "integer"
-              },
-              "weight": {
-                "description": "Weight for the load balancing",
-                "type": "integer"
-              }
-            }
-          }
+        "name": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
         },
-        "secondaryIPs": {
-          "description": "values of Secondary IPs",
+        "statements": {
           "type": "array",
           "items": {
-            "properties": {
-              "secondaryIP": {
-                "description": "IP address for secondary access",
-                "type": "string"
-              }
-            }
+            "$ref": "#/definitions/BGPPolicyDefinitionsStatement"
           }
-        },
-        "serviceArguments": {
+        }
+      }
+    },
+    "BGPPolicyDefinitionsStatement": {
+      "type": "object",
+      "properties": {
+        "actions": {
           "type": "object",
           "properties": {
-            "bgp": {
-              "description": "value for BGP enable or not",
-              "type": "boolean"
-            },
-            "block": {
-              "description": "block-number if any of this LB entry",
-              "type": "integer",
-              "format": "uint16"
-            },
-            "externalIP": {
-              "description": "IP address for externel access",
-              "type": "string"
-            },
-            "host": {
-              "description": "Ingress specific host URL path",
-              "type": "string"
-            },
-            "inactiveTimeOut": {
-              "description": "value for inactivity timeout (in seconds)",
-              "type": "integer",
-              "format": "int32"
-            },
-            "managed": {
-              "description": "externally managed rule or not",
-              "type": "boolean"
-            },
-            "mode": {
-              "description": "value for NAT mode (0-DNAT, 1-oneArm, 2-fullNAT)",
-              "type": "integer",
-              "format": "int32"
-            },
-            "monitor": {
-              "description": "value for monitoring enabled or not",
-              "type": "boolean"
-            },
-            "name": {
-              "description": "service name",
-              "type": "string"
-            },
-            "oper": {
-              "description": "end-point specific op (0-create, 1-attachEP, 2-detachEP)",
-              "type": "integer",
-              "format": "int32"
-            },
-            "port": {
-              "description": "port number for the access",
-              "type": "integer"
-            },
-            "probeRetries": {
-              "description": "value for probe retries",
-              "type": "integer",
-              "format": "int32"
-            },
-            "probeTimeout": {
-              "description": "value for probe timer (in seconds)",
-              "type": "integer",
-              "format": "uint32"
-            },
-            "probeport": {
-              "description": "probe port if probetype is tcp/udp/sctp",
-              "type": "integer",
-              "format": "uint16"
-            },
-            "probereq": {
-              "description": "probe request string",
-              "type": "string"
-            },
-            "proberesp": {
-              "description": "probe response string",
-              "type": "string"
-            },
-            "probetype": {
-              "description": "probe type for any end-point of this entry",
-              "type": "string"
+            "bgpActions": {
+              "type": "object",
+              "properties": {
+                "setAsPathPrepend": {
+                  "type": "object",
+                  "properties": {
+                    "as": {
+                      "type": "string"
+                    },
+                    "repeatN": {
+                      "type": "integer"
+                    }
+                  }
+                },
+                "setCommunity": {
+                  "type": "object",
+                  "properties": {
+                    "options": {
+                      "type": "string"
+                    },
+                    "setCommunityMethod": {
+                      "type": "array",
+                      "items": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "setExtCommunity": {
+                  "type": "object",
+                  "properties": {
+                    "options": {
+                      "type": "string"
+                    },
+                    "setCommunityMethod": {
+                      "type": "array",
+                      "items": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "setLargeCommunity": {
+                  "type": "object",
+                  "properties": {
+                    "options": {
+                      "type": "string"
+                    },
+                    "setCommunityMethod": {
+                      "type": "array",
+                      "items": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "setLocalPerf": {
+                  "type": "integer"
+                },
+                "setMed": {
+                  "type": "string"
+                },
+                "setNextHop": {
+                  "type": "string"
+                }
+              }
             },
-            "protocol": {
-              "description": "value for access protocol",
+            "routeDisposition": {
               "type": "string"
+            }
+          }
+        },
+        "conditions": {
+          "type": "object",
+          "properties": {
+            "bgpConditions": {
+              "type": "object",
+              "properties": {
+                "afiSafiIn": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "asPathLength": {
+                  "type": "object",
+                  "properties": {
+                    "operator": {
+                      "type": "string"
+                    },
+                    "value": {
+                      "type": "integer"
+                    }
+                  }
+                },
+                "matchAsPathSet": {
+                  "type": "object",
+                  "properties": {
+                    "asPathSet": {
+                      "type": "string"
+                    },
+                    "matchSetOptions": {
+                      "type": "string"
+                    }
+                  }
+                },
+                "matchCommunitySet": {
+                  "type": "object",
+                  "properties": {
+                    "communitySet": {
+                      "type": "string"
+                    },
+                    "matchSetOptions": {
+                      "type": "string"
+                    }
+                  }
+                },
+                "matchExtCommunitySet": {
+                  "type": "object",
+                  "properties": {
+                    "communitySet": {
+                      "type": "string"
+                    },
+                    "matchSetOptions": {
+                      "type": "string"
+                    }
+                  }
+                },
+                "matchLargeCommunitySet": {
+                  "type": "object",
+                  "properties": {
+                    "communitySet": {
+                      "type": "string"
+                    },
+                    "matchSetOptions": {
+                      "type": "string"
+                    }
+                  }
+                },
+                "nextHopInList": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "routeType": {
+                  "type": "string"
+                },
+                "rpki": {
+                  "type": "string"
+                }
+              }
             },
-            "security": {
-              "description": "value for Security mode (0-Plain, 1-HTTPs)",
-              "type": "integer",
-              "format": "int32"
-            },
-            "sel": {
-              "description": "value for load balance algorithim",
-              "type": "integer"
+            "matchNeighborSet": {
+              "type": "object",
+              "properties": {
+                "matchSetOption": {
+                  "type": "string"
+                },
+                "neighborSet": {
+                  "type": "string"
+                }
+              }
             },
-            "snat": {
-              "description": "snat rule",
-              "type": "boolean"
+            "matchPrefixSet": {
+              "type": "object",
+              "properties": {
+                "matchSetOption": {
+                  "type": "string"
+                },
+                "prefixSet": {
+                  "type": "string"
+                }
+              }
             }
           }
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "MirrorEntry": {
+    "BGPPolicyPrefix": {
       "type": "object",
       "properties": {
-        "mirrorIdent": {
-          "description": "Mirror name",
+        "ipPrefix": {
+          "description": "BGP Neighbor IP address",
           "type": "string"
         },
-        "mirrorInfo": {
-          "type": "object",
-          "properties": {
-            "port": {
-              "description": "Port where mirrored traffic needs to be sent",
-              "type": "string"
-            },
-            "remoteIP": {
-              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "string"
-            },
-            "sourceIP": {
-              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "string"
-            },
-            "tunnelID": {
-              "description": "mirror tunnel-id. For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "integer"
-            },
-            "type": {
-              "description": "One of MirrTypeSpan, MirrTypeRspan or MirrTypeErspan",
-              "type": "integer"
-            },
-            "vlan": {
-              "description": "For RSPAN we may need to send tagged mirror traffic",
-              "type": "integer"
-            }
-          }
-        },
-        "targetObject": {
-          "type": "object",
-          "properties": {
-            "attachment": {
-              "description": "Target Attachment",
-              "type": "integer"
-            },
-            "mirrObjName": {
-              "description": "Target Names",
-              "type": "string"
-            }
-          }
+        "masklengthRange": {
+          "description": "Remote AS number",
+          "type": "string"
         }
       }
     },
-    "MirrorGetEntry": {
+    "BfdEntry": {
       "type": "object",
-      "required": [
-        "sync"
-      ],
       "properties": {
-        "mirrorIdent": {
-          "description": "Mirror name",
+        "instance": {
+          "description": "Instance name running BFD session",
           "type": "string"
         },
-        "mirrorInfo": {
-          "type": "object",
-          "properties": {
-            "port": {
-              "description": "Port where mirrored traffic needs to be sent",
-              "type": "string"
-            },
-            "remoteIP": {
-              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "string"
-            },
-            "sourceIP": {
-              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "string"
-            },
-            "tunnelID": {
-              "description": "mirror tunnel-id. For ERSPAN we may need to send tunnelled mirror traffic",
-              "type": "integer"
-            },
-            "type": {
-              "description": "One of MirrTypeSpan, MirrTypeRspan or MirrTypeErspan",
-              "type": "integer"
-            },
-            "vlan": {
-              "description": "For RSPAN we may need to send tagged mirror traffic",
-              "type": "integer"
-            }
-          }
+        "interval": {
+          "description": "Tx interval between BFD packets(in microseconds)",
+          "type": "integer",
+          "format": "uint64"
         },
-        "sync": {
-          "description": "Sync - sync state",
-          "type": "integer"
+        "remoteIp": {
+          "description": "Remote IP",
+          "type": "string"
         },
-        "targetObject": {
-          "type": "object",
-          "properties": {
-            "attachment": {
-              "description": "Target Attachment",
-              "type": "integer"
-            },
-            "mirrObjName": {
-              "description": "Target Names",
-              "type": "string"
-            }
-          }
+        "retryCount": {
+          "description": "Retry Count to detect failure",
+          "type": "integer",
+          "format": "uint8"
+        },
+        "sourceIp": {
+          "description": "Remote IP",
+          "type": "string"
         }
       }
     },
-    "NeighborEntry": {
+    "BfdGetEntry": {
       "type": "object",
       "properties": {
-        "dev": {
-          "description": "Name of the interface device to which you want to add neighbor",
+        "instance": {
+          "description": "Instance name",
           "type": "string"
         },
-        "ipAddress": {
-          "description": "IP address to neighbor",
+        "interval": {
+          "description": "Tx interval between BFD packets(in microseconds)",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "port": {
+          "description": "port number to be used for BFD session",
+          "type": "integer",
+          "format": "uint16"
+        },
+        "remoteIp": {
+          "description": "Remote IP",
           "type": "string"
         },
-        "macAddress": {
-          "description": "MAC address to neighbor",
+        "retryCount": {
+          "description": "Retry Count to detect failure",
+          "type": "integer",
+          "format": "uint8"
+        },
+        "sourceIP": {
+          "description": "Source IP to be used for BFD session",
+          "type": "string"
+        },
+        "state": {
+          "description": "Current state for BFD session",
           "type": "string"
         }
       }
     },
-    "OperParams": {
+    "CIStatusEntry": {
       "type": "object",
       "properties": {
-        "logLevel": {
-          "description": "Set level to debug,info,error,warning,notice,critical,emergency,alert",
+        "instance": {
+          "description": "Instance name",
+          "type": "string"
+        },
+        "state": {
+          "description": "Current Cluster Instance State",
+          "type": "string"
+        },
+        "vip": {
+          "description": "Instance Virtual IP address",
           "type": "string"
         }
       }
     },
-    "PolicyEntry": {
+    "CIStatusGetEntry": {
       "type": "object",
+      "required": [
+        "sync"
+      ],
       "properties": {
-        "policyIdent": {
-          "description": "Policy name",
+        "instance": {
+          "description": "Instance name",
           "type": "string"
         },
-        "policyInfo": {
-          "type": "object",
-          "properties": {
-            "colorAware": {
-              "description": "Policy color for QoS",
-              "type": "boolean"
-            },
-            "committedBlkSize": {
-              "description": "policy type",
-              "type": "integer"
-            },
-            "committedInfoRate": {
-              "description": "policy type",
-              "type": "integer"
-            },
-            "excessBlkSize": {
-              "description": "policy type",
-              "type": "integer"
-            },
-            "peakInfoRate": {
-              "description": "policy type",
-              "type": "integer"
-            },
-            "type": {
-              "description": "policy type",
-              "type": "integer"
-            }
-          }
+        "state": {
+          "description": "Current Cluster Instance State",
+          "type": "string"
         },
-        "targetObject": {
-          "type": "object",
-          "properties": {
-            "attachment": {
-              "description": "Target Attachment",
-              "type": "integer"
-            },
-            "polObjName": {
-              "description": "Target Names",
-              "type": "string"
-            }
-          }
+        "sync": {
+          "description": "Sync - sync state",
+          "type": "integer"
+        },
+        "vip": {
+          "description": "Instance Virtual IP address",
+          "type": "string"
         }
       }
     },
-    "PortEntry": {
+    "ConfigTransaction": {
       "type": "object",
       "properties": {
-        "DataplaneSync": {
-          "description": "Dataplan Sync check",
-          "type": "integer"
+        "endPoints": {
+          "description": "End-points to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndPoint"
+          }
         },
-        "portHardwareInformation": {
-          "type": "object",
-          "properties": {
-            "link": {
-              "description": "link status",
-              "type": "boolean"
-            },
-            "macAddress": {
-              "description": "MAC address of the port",
-              "type": "string"
-            },
-            "master": {
-              "description": "Port's mater",
-              "type": "string"
-            },
-            "mtu": {
-              "description": "MTU of the port",
-              "type": "integer"
-            },
-            "rawMacAddress": {
-              "description": "MAC address written by byte array",
-              "type": "array",
-              "items": {
-                "type": "integer"
-              }
-            },
-            "real": {
-              "description": "real port..",
-              "type": "string"
-            },
-            "state": {
-              "description": "state...",
-              "type": "boolean"
-            },
-            "tunnelId": {
-              "description": "Tunnel Id such as VxLAN.",
-              "type": "integer"
-            }
+        "fwRules": {
+          "description": "Firewall rules to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallEntry"
           }
         },
-        "portL2Information": {
-          "type": "object",
-          "properties": {
-            "isPvid": {
-              "description": "Is PVID config or not",
-              "type": "boolean"
-            },
-            "vid": {
-              "description": "virtual lan id(VLAN ID)",
-              "type": "integer"
-            }
+        "fwRulesDel": {
+          "description": "Firewall rules to be deleted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallEntry"
           }
         },
-        "portL3Information": {
-          "type": "object",
-          "properties": {
-            "IPv4Address": {
-              "description": "List of IP address v4",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            },
-            "IPv6Address": {
-              "description": "List of the IP address v6",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            },
-            "routed": {
-              "description": "Is routed or not",
-              "type": "boolean"
-            }
-          }
-        },
-        "portName": {
-          "description": "The name of the Port interface",
-          "type": "string"
-        },
-        "portNo": {
-          "description": "Index of the Port",
-          "type": "integer"
+        "generation": {
+          "description": "Config generation the transaction is based on. If set, the transaction is committed only if it matches the current generation",
+          "type": "integer",
+          "format": "uint64"
         },
-        "portSoftwareInformation": {
-          "type": "object",
-          "properties": {
-            "bpfLoaded": {
-              "description": "The status of the eBPF loaded",
-              "type": "boolean"
-            },
-            "osId": {
-              "description": "The ID of the Port in the software(OS)",
-              "type": "integer"
-            },
-            "portActive": {
-              "description": "Activation status of the port",
-              "type": "boolean"
-            },
-            "portProp": {
-              "description": "Priority of the port",
-              "type": "integer"
-            },
-            "portType": {
-              "description": "port type",
-              "type": "integer"
-            }
+        "lbRules": {
+          "description": "Load balancer rules to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
           }
         },
-        "portStatisticInformation": {
-          "type": "object",
-          "properties": {
-            "rxBytes": {
-              "description": "Statistic of the ingress port bytes.",
-              "type": "integer"
-            },
-            "rxErrors": {
-              "description": "Statistic of the number of ingress Error packets.",
-              "type": "integer"
-            },
-            "rxPackets": {
-              "description": "Statistic of the number of ingress packets.",
-              "type": "integer"
-            },
-            "txBytes": {
-              "description": "Statistic of the egress port bytes.",
-              "type": "integer"
-            },
-            "txErrors": {
-              "description": "Statistic of the number of egress Error packets.",
-              "type": "integer"
-            },
-            "txPackets": {
-              "description": "Statistic of the number of egress packets.",
-              "type": "integer"
-            }
+        "lbRulesDel": {
+          "description": "Load balancer rules to be deleted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
           }
-        },
-        "zone": {
-          "description": "network zone",
-          "type": "string"
         }
       }
     },
-    "PostSuccess": {
+    "ConfigTransactionResult": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer"
+        "added": {
+          "description": "Objects which were added",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "message": {
-          "type": "string"
+        "deleted": {
+          "description": "Objects which were deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "generation": {
+          "description": "Config generation after the transaction",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "updated": {
+          "description": "Objects which were updated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "ProcessInfoEntry": {
+    "ConntrackEntry": {
       "type": "object",
       "properties": {
-        "CPUUsage": {
-          "description": "CPU usage of the process",
+        "bytes": {
+          "description": "Packet bytes of the conntrack",
+          "type": "integer"
+        },
+        "conntrackAct": {
+          "description": "value for Conntrack Act",
           "type": "string"
         },
-        "MemoryUsage": {
-          "description": "Memory usage of the process",
+        "conntrackState": {
+          "description": "value for Conntrack state",
           "type": "string"
         },
-        "command": {
-          "description": "process command",
+        "destinationIP": {
+          "description": "IP address for externel access",
           "type": "string"
         },
-        "nice": {
-          "description": "process nice value",
+        "destinationPort": {
+          "description": "port number for the access",
+          "type": "integer"
+        },
+        "packets": {
+          "description": "Packet counts of the conntrack",
+          "type": "integer"
+        },
+        "protocol": {
+          "description": "value for access protocol",
           "type": "string"
         },
-        "pid": {
-          "description": "process ID",
+        "servName": {
+          "description": "Connection's Service Name",
           "type": "string"
         },
-        "priority": {
-          "description": "process priority",
+        "sourceIP": {
+          "description": "IP address for externel access",
           "type": "string"
         },
-        "residentSize": {
-          "description": "Physical memory usage",
+        "sourcePort": {
+          "description": "port number for the access",
+          "type": "integer"
+        }
+      }
+    },
+    "DeviceInfoEntry": {
+      "type": "object",
+      "properties": {
+        "OS": {
+          "description": "Operation System of the device",
           "type": "string"
         },
-        "sharedMemory": {
-          "description": "Shared memory usage",
+        "architecture": {
+          "description": "CPU architecture of the device",
           "type": "string"
         },
-        "status": {
-          "description": "process status",
+        "bootID": {
+          "description": "Boot ID in the linux",
           "type": "string"
         },
-        "time": {
-          "description": "Executation time",
+        "hostName": {
+          "description": "Device host name",
           "type": "string"
         },
-        "user": {
-          "description": "User name that start the process",
+        "kernel": {
+          "description": "Kernel version of the device",
           "type": "string"
         },
-        "virtMemory": {
-          "description": "virtual memory usage",
+        "machineID": {
+          "description": "Device machine ID",
+          "type": "string"
+        },
+        "uptime": {
+          "description": "system uptime",
           "type": "string"
         }
       }
     },
-    "RouteEntry": {
+    "EndPoint": {
       "type": "object",
       "properties": {
-        "destinationIPNet": {
-          "description": "IP address and netmask",
+        "activeReTries": {
+          "description": "Number of consecutive successful probes to mark end-point active",
+          "type": "integer"
+        },
+        "hostName": {
+          "description": "Host name in CIDR",
           "type": "string"
         },
-        "gateway": {
-          "description": "IP address for nexthop",
+        "inactiveReTries": {
+          "description": "Number of inactive retries",
+          "type": "integer"
+        },
+        "name": {
+          "description": "Endpoint Identifier",
           "type": "string"
         },
-        "protocol": {
-          "description": "Protocol type of the route like \"static\"",
+        "probeCmd": {
+          "description": "Command to run for exec probes. End-point IP and port are passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables",
+          "type": "string"
+        },
+        "probeDuration": {
+          "description": "How frequently to probe in seconds",
+          "type": "integer"
+        },
+        "probeHeaders": {
+          "description": "Extra headers as Name:Value for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header for http/https probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Max random delay in seconds added to each probe interval",
+          "type": "integer"
+        },
+        "probeMethod": {
+          "description": "Method for http/https probes",
+          "type": "string"
+        },
+        "probePort": {
+          "description": "The l4port to probe on",
+          "type": "integer"
+        },
+        "probeReq": {
+          "description": "URI for http/https probes or service name for grpc probes",
+          "type": "string"
+        },
+        "probeResp": {
+          "description": "Response for http/https probes",
+          "type": "string"
+        },
+        "probeRespCodes": {
+          "description": "Accepted status codes for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "probeRespRegex": {
+          "description": "Regex to match response body for http/https probes",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of each probe in seconds, 2 by default and at most 30. It must be less than probeDuration",
+          "type": "integer"
+        },
+        "probeType": {
+          "description": "Type of probe used",
           "type": "string"
         }
       }
     },
-    "RouteGetEntry": {
+    "EndPointEvent": {
       "type": "object",
       "properties": {
-        "destinationIPNet": {
-          "description": "IP address and netmask",
+        "currState": {
+          "description": "Current state of the end-point (ok/nok)",
           "type": "string"
         },
-        "flags": {
-          "description": "Route flags",
+        "hostName": {
+          "description": "Host name in CIDR",
           "type": "string"
         },
-        "gateway": {
-          "description": "IP address for nexthop",
+        "kind": {
+          "description": "Kind of event - ep-state for end-point health changes, lb-ep-set for load-balancer end-point set changes",
           "type": "string"
         },
-        "hardwareMark": {
-          "description": "index of the route",
+        "lastDelay": {
+          "description": "Last measured probe delay",
+          "type": "string"
+        },
+        "name": {
+          "description": "Endpoint Identifier",
+          "type": "string"
+        },
+        "probePort": {
+          "description": "The l4port to probe on",
           "type": "integer"
         },
-        "protocol": {
-          "description": "Route protocol",
+        "probeType": {
+          "description": "Type of probe used",
           "type": "string"
         },
-        "statistic": {
-          "type": "object",
-          "required": [
-            "bytes",
-            "packets"
-          ],
-          "properties": {
-            "bytes": {
-              "description": "Statistic of the ingress port bytes.",
-              "type": "integer"
-            },
-            "packets": {
-              "description": "Statistic of the egress port bytes.",
-              "type": "integer"
+        "rules": {
+          "description": "Load-balancer rules affected by this event",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "description": "Time of the event in RFC3339 format",
+          "type": "string"
+        }
+      }
+    },
+    "EndPointGetEntry": {
+      "type": "object",
+      "properties": {
+        "activeReTries": {
+          "description": "Number of consecutive successful probes to mark end-point active",
+          "type": "integer"
+        },
+        "avgDelay": {
+          "description": "Average delay seen for endpoint",
+          "type": "string"
+        },
+        "currState": {
+          "description": "Current state of this endpoint",
+          "type": "string"
+        },
+        "hostName": {
+          "description": "Host name",
+          "type": "string"
+        },
+        "inactiveReTries": {
+          "description": "Number of inactive retries",
+          "type": "integer"
+        },
+        "maxDelay": {
+          "description": "Maximum delay seen for endpoint",
+          "type": "string"
+        },
+        "minDelay": {
+          "description": "Minimum delay seen for endpoint",
+          "type": "string"
+        },
+        "name": {
+          "description": "Endpoint Identifier",
+          "type": "string"
+        },
+        "probeCmd": {
+          "description": "Command to run for exec probes. End-point IP and port are passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables",
+          "type": "string"
+        },
+        "probeDuration": {
+          "description": "How frequently to probe in seconds",
+          "type": "integer"
+        },
+        "probeHeaders": {
+          "description": "Extra headers as Name:Value for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header for http/https probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Max random delay in seconds added to each probe interval",
+          "type": "integer"
+        },
+        "probeMethod": {
+          "description": "Method for http/https probes",
+          "type": "string"
+        },
+        "probePort": {
+          "description": "The l4port to probe on",
+          "type": "integer"
+        },
+        "probeReq": {
+          "description": "URI for http/https probes or service name for grpc probes",
+          "type": "string"
+        },
+        "probeResp": {
+          "description": "Response for http/https probes",
+          "type": "string"
+        },
+        "probeRespCodes": {
+          "description": "Accepted status codes for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "probeRespRegex": {
+          "description": "Regex to match response body for http/https probes",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of each probe in seconds, 2 by default and at most 30. It must be less than probeDuration",
+          "type": "integer"
+        },
+        "probeType": {
+          "description": "Type of probe used",
+          "type": "string"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "details": {
+          "type": "string"
+        },
+        "fields": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "sub-code": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "FDBEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Name of the interface device to which you want to modify FDB",
+          "type": "string"
+        },
+        "macAddress": {
+          "description": "MAC address to FDB",
+          "type": "string"
+        }
+      }
+    },
+    "FileSystemInfoEntry": {
+      "type": "object",
+      "properties": {
+        "avail": {
+          "description": "size of remain the disk",
+          "type": "string"
+        },
+        "fileSystem": {
+          "description": "File system name mounted on this device",
+          "type": "string"
+        },
+        "mountedOn": {
+          "description": "path of the mounted on",
+          "type": "string"
+        },
+        "size": {
+          "description": "Boot ID in the linux",
+          "type": "string"
+        },
+        "type": {
+          "description": "File type (ex. nfs, ext4..)",
+          "type": "string"
+        },
+        "usePercent": {
+          "description": "usage per total size",
+          "type": "string"
+        },
+        "used": {
+          "description": "size of used the disk",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallEntry": {
+      "type": "object",
+      "properties": {
+        "opts": {
+          "$ref": "#/definitions/FirewallOptionEntry"
+        },
+        "ruleArguments": {
+          "$ref": "#/definitions/FirewallRuleEntry"
+        }
+      }
+    },
+    "FirewallEvent": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "description": "Kind of event - fw-expired, fw-active or fw-inactive",
+          "type": "string"
+        },
+        "name": {
+          "description": "Firewall rule as shown in rule dumps",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time of the event in RFC3339 format",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallLogConfig": {
+      "type": "object",
+      "properties": {
+        "dropped": {
+          "description": "Number of hits not logged due to rate limit or overload",
+          "type": "integer"
+        },
+        "file": {
+          "description": "Path of the JSON lines log file, empty for no file",
+          "type": "string"
+        },
+        "fileMaxBackups": {
+          "description": "Number of rotated log files kept",
+          "type": "integer"
+        },
+        "fileMaxSize": {
+          "description": "Size in MB at which the log file is rotated",
+          "type": "integer"
+        },
+        "logged": {
+          "description": "Number of hits logged",
+          "type": "integer"
+        },
+        "rateLimit": {
+          "description": "Max number of hits logged per second, 0 for no limit",
+          "type": "integer"
+        },
+        "sample": {
+          "description": "Log one in every sample hits, 0 or 1 logs every hit",
+          "type": "integer"
+        },
+        "sampled": {
+          "description": "Number of hits skipped by sampling",
+          "type": "integer"
+        },
+        "syslog": {
+          "description": "Syslog server as udp://host:port, tcp://host:port or local, empty for no syslog",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallLogRecord": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Action taken - allow, drop, trap or redirect",
+          "type": "string"
+        },
+        "destinationIP": {
+          "description": "Destination IP of the packet",
+          "type": "string"
+        },
+        "destinationPort": {
+          "description": "Destination port of the packet",
+          "type": "integer"
+        },
+        "port": {
+          "description": "Port the packet came in on",
+          "type": "string"
+        },
+        "preference": {
+          "description": "Preference of the firewall rule",
+          "type": "integer"
+        },
+        "proto": {
+          "description": "Protocol of the packet",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Firewall rule as shown in rule dumps",
+          "type": "string"
+        },
+        "ruleID": {
+          "description": "Identifier of the firewall rule",
+          "type": "integer"
+        },
+        "sourceIP": {
+          "description": "Source IP of the packet",
+          "type": "string"
+        },
+        "sourcePort": {
+          "description": "Source port of the packet",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time of the hit in RFC3339 format",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallOptionEntry": {
+      "type": "object",
+      "properties": {
+        "allow": {
+          "description": "Allow any matching rule",
+          "type": "boolean"
+        },
+        "counter": {
+          "description": "traffic counters",
+          "type": "string"
+        },
+        "doSnat": {
+          "description": "Do SNAT on matching rule",
+          "type": "boolean"
+        },
+        "drop": {
+          "description": "Drop any matching rule",
+          "type": "boolean"
+        },
+        "expiry": {
+          "description": "Time in RFC3339 format at which the rule expires",
+          "type": "string"
+        },
+        "fwMark": {
+          "description": "Set a fwmark for any matching rule",
+          "type": "integer"
+        },
+        "record": {
+          "description": "Record or dump for matching rule",
+          "type": "boolean"
+        },
+        "redirect": {
+          "description": "Redirect any matching rule",
+          "type": "boolean"
+        },
+        "redirectPortName": {
+          "description": "Redirect any matching rule",
+          "type": "string"
+        },
+        "remaining": {
+          "description": "Remaining lifetime of the rule in seconds",
+          "type": "integer"
+        },
+        "schedule": {
+          "description": "Recurring active window as \"min hour dom mon dow window\" e.g \"0 9 * * 1-5 8h\"",
+          "type": "string"
+        },
+        "scheduleState": {
+          "description": "State of a scheduled rule - active or inactive",
+          "type": "string"
+        },
+        "toIP": {
+          "description": "Modify to given IP in CIDR notation",
+          "type": "string"
+        },
+        "toPort": {
+          "description": "Modify to given Port (Zero if port is not to be modified)",
+          "type": "integer"
+        },
+        "trap": {
+          "description": "Trap anything matching rule",
+          "type": "boolean"
+        },
+        "ttl": {
+          "description": "Lifetime of the rule in seconds",
+          "type": "integer"
+        }
+      }
+    },
+    "FirewallPolicyEntry": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "description": "Policy - accept or drop",
+          "type": "string"
+        },
+        "zone": {
+          "description": "Name of the zone, root zone if empty",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallRuleEntry": {
+      "type": "object",
+      "properties": {
+        "destinationIP": {
+          "description": "Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
+          "type": "string"
+        },
+        "maxDestinationPort": {
+          "description": "Maximum  destination port range",
+          "type": "integer"
+        },
+        "maxSourcePort": {
+          "description": "Maximum  source port range",
+          "type": "integer"
+        },
+        "minDestinationPort": {
+          "description": "Minimum destination port range",
+          "type": "integer"
+        },
+        "minSourcePort": {
+          "description": "Minimum source port range",
+          "type": "integer"
+        },
+        "portName": {
+          "description": "the incoming port",
+          "type": "string"
+        },
+        "preference": {
+          "description": "User preference for ordering",
+          "type": "integer"
+        },
+        "protocol": {
+          "description": "the protocol",
+          "type": "integer"
+        },
+        "sourceIP": {
+          "description": "Source IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
+          "type": "string"
+        }
+      }
+    },
+    "IPConfigStatusEntry": {
+      "description": "Apply status of saved ip config of an interface",
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "Number of config entries applied",
+          "type": "integer"
+        },
+        "attempts": {
+          "description": "Number of apply attempts",
+          "type": "integer"
+        },
+        "dev": {
+          "description": "Name of the interface",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "Error of the last apply attempt",
+          "type": "string"
+        },
+        "linkUp": {
+          "description": "Operational state of the interface",
+          "type": "boolean"
+        },
+        "pending": {
+          "description": "Number of config entries still to be applied",
+          "type": "integer"
+        },
+        "status": {
+          "description": "Apply status - applied, pending, link-down or failed",
+          "type": "string"
+        }
+      }
+    },
+    "IPPoolEntry": {
+      "description": "IP pool for VIP allocation",
+      "type": "object",
+      "properties": {
+        "cidrs": {
+          "description": "Subnets of the pool, at most one IPv4 and one IPv6",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "reserved": {
+          "description": "IPs which are only allocated when asked for explicitly",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "IPPoolGetEntry": {
+      "description": "IP pool along with its VIP allocations",
+      "type": "object",
+      "properties": {
+        "allocs": {
+          "description": "VIP allocations from the pool",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VIPEntry"
+          }
+        },
+        "cidrs": {
+          "description": "Subnets of the pool",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "reserved": {
+          "description": "IPs which are only allocated when asked for explicitly",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "IPSetEntry": {
+      "description": "Named ip set used by firewall rules",
+      "type": "object",
+      "properties": {
+        "cidrs": {
+          "description": "Members of the set in CIDR notation, IPv4 and/or IPv6",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip set",
+          "type": "string"
+        },
+        "refs": {
+          "description": "Number of firewall rules using the set",
+          "type": "integer"
+        }
+      }
+    },
+    "IPv4AddressEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Name of the interface device to which you want to modify the IP address",
+          "type": "string"
+        },
+        "ipAddress": {
+          "description": "IP address to modify.",
+          "type": "string"
+        }
+      }
+    },
+    "IPv4AddressGetEntry": {
+      "type": "object",
+      "required": [
+        "sync"
+      ],
+      "properties": {
+        "dev": {
+          "description": "Name of the interface device to which you want to modify the IP address",
+          "type": "string"
+        },
+        "ipAddress": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sync": {
+          "description": "Sync - sync state",
+          "type": "integer"
+        }
+      }
+    },
+    "LoadbalanceEntry": {
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "values of End point servers",
+          "type": "array",
+          "items": {
+            "properties": {
+              "activeConns": {
+                "description": "Remaining active connections of a draining endpoint",
+                "type": "integer"
+              },
+              "counter": {
+                "description": "traffic counters of the endpoint",
+                "type": "string"
+              },
+              "currWeight": {
+                "description": "Weight currently in effect for the endpoint",
+                "type": "integer"
+              },
+              "endpointIP": {
+                "description": "IP address for external access",
+                "type": "string"
+              },
+              "state": {
+                "description": "state of the endpoint",
+                "type": "string"
+              },
+              "targetPort": {
+                "description": "port number for access service",
+                "type": "integer"
+              },
+              "weight": {
+                "description": "Weight for the load balancing",
+                "type": "integer"
+              }
+            }
+          }
+        },
+        "secondaryIPs": {
+          "description": "values of Secondary IPs",
+          "type": "array",
+          "items": {
+            "properties": {
+              "secondaryIP": {
+                "description": "IP address for secondary access",
+                "type": "string"
+              }
+            }
+          }
+        },
+        "serviceArguments": {
+          "type": "object",
+          "properties": {
+            "bgp": {
+              "description": "value for BGP enable or not",
+              "type": "boolean"
+            },
+            "block": {
+              "description": "block-number if any of this LB entry",
+              "type": "integer",
+              "format": "uint16"
+            },
+            "drainTimeout": {
+              "description": "max time for which removed end-points are drained (in seconds), 0 disables draining",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "externalIP": {
+              "description": "IP address for externel access",
+              "type": "string"
+            },
+            "host": {
+              "description": "Ingress specific host URL path",
+              "type": "string"
+            },
+            "inactiveTimeOut": {
+              "description": "value for inactivity timeout (in seconds)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "managed": {
+              "description": "externally managed rule or not",
+              "type": "boolean"
+            },
+            "maxConns": {
+              "description": "max concurrent connections to the service, 0 means no limit (checked periodically with eBPF datapath)",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "maxConnsPerEndpoint": {
+              "description": "max concurrent connections to an end-point, end-points at limit are skipped, 0 means no limit (checked periodically with eBPF datapath)",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "mode": {
+              "description": "value for NAT mode (0-DNAT, 1-oneArm, 2-fullNAT)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "monitor": {
+              "description": "value for monitoring enabled or not",
+              "type": "boolean"
+            },
+            "name": {
+              "description": "service name",
+              "type": "string"
+            },
+            "newConnRate": {
+              "description": "max new connections per second to the service, 0 means no limit (experimental, userspace datapath only)",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "odEjectTime": {
+              "description": "base ejection time of an outlier end-point (in seconds)",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "odFailRatio": {
+              "description": "failed connection ratio (in percent) to eject an end-point as outlier, 0 disables outlier detection",
+              "type": "integer",
+              "format": "uint8"
+            },
+            "odMaxEjectPct": {
+              "description": "max percent of end-points ejected as outliers at a time",
+              "type": "integer",
+              "format": "uint8"
+            },
+            "oper": {
+              "description": "end-point specific op (0-create, 1-attachEP, 2-detachEP)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "port": {
+              "description": "port number for the access",
+              "type": "integer"
+            },
+            "portMask": {
+              "description": "prefix mask of the service port range, alternative to portMax (at most 4096 ports, 256 with eBPF datapath)",
+              "type": "integer"
+            },
+            "portMax": {
+              "description": "last port of the service port range starting at port (at most 4096 ports, 256 with eBPF datapath)",
+              "type": "integer"
+            },
+            "probeActRetries": {
+              "description": "value for consecutive successful probes to mark end-point active",
+              "type": "integer",
+              "format": "int32"
+            },
+            "probeHeaders": {
+              "description": "extra headers as Name:Value for http/https probe",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "probeHost": {
+              "description": "host header for http/https probe",
+              "type": "string"
+            },
+            "probeInterval": {
+              "description": "value for probe interval (in seconds), probeTimeout is used if not set",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "probeJitter": {
+              "description": "value for max random delay added to each probe interval (in seconds)",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "probeMethod": {
+              "description": "method for http/https probe",
+              "type": "string"
+            },
+            "probeRespCodes": {
+              "description": "accepted status codes for http/https probe",
+              "type": "array",
+              "items": {
+                "type": "integer"
+              }
+            },
+            "probeRespRegex": {
+              "description": "regex to match response body for http/https probe",
+              "type": "string"
+            },
+            "probeRetries": {
+              "description": "value for probe retries",
+              "type": "integer",
+              "format": "int32"
+            },
+            "probeTimeout": {
+              "description": "value for timeout of each probe (in seconds), 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set, 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "probeport": {
+              "description": "probe port if probetype is tcp/udp/sctp",
+              "type": "integer",
+              "format": "uint16"
+            },
+            "probereq": {
+              "description": "probe request string",
+              "type": "string"
+            },
+            "proberesp": {
+              "description": "probe response string",
+              "type": "string"
+            },
+            "probetype": {
+              "description": "probe type for any end-point of this entry",
+              "type": "string"
+            },
+            "protocol": {
+              "description": "value for access protocol",
+              "type": "string"
+            },
+            "rejectedConns": {
+              "description": "number of new connections rejected due to connection limits",
+              "type": "integer",
+              "format": "uint64"
+            },
+            "security": {
+              "description": "value for Security mode (0-Plain, 1-HTTPs)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "sel": {
+              "description": "value for load balance algorithim (maglev is approximated with 16 hash slots by eBPF datapath)",
+              "type": "integer"
+            },
+            "slowStart": {
+              "description": "slow-start window of a newly active end-point (in seconds), 0 disables slow-start, only valid with weighted (prio or maglev) selection",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "slowStartCurve": {
+              "description": "weight ramp curve during slow-start (linear, quadratic or sqrt)",
+              "type": "string"
+            },
+            "slowStartFloor": {
+              "description": "weight (in percent) of an end-point as slow-start begins",
+              "type": "integer",
+              "format": "uint8"
+            },
+            "snat": {
+              "description": "snat rule",
+              "type": "boolean"
+            },
+            "sourceRangeDrops": {
+              "description": "counter (packets:bytes) of traffic dropped due to source ranges",
+              "type": "string"
+            },
+            "sourceRanges": {
+              "description": "allowed source prefixes in CIDR notation, all sources are allowed if empty",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "LoadbalanceEntryList": {
+      "description": "Complete set of load balancer services",
+      "type": "object",
+      "properties": {
+        "lbAttr": {
+          "description": "Load balancer services",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
+          }
+        }
+      }
+    },
+    "MirrorEntry": {
+      "type": "object",
+      "properties": {
+        "mirrorIdent": {
+          "description": "Mirror name",
+          "type": "string"
+        },
+        "mirrorInfo": {
+          "type": "object",
+          "properties": {
+            "port": {
+              "description": "Port where mirrored traffic needs to be sent",
+              "type": "string"
+            },
+            "remoteIP": {
+              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "string"
+            },
+            "sourceIP": {
+              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "string"
+            },
+            "tunnelID": {
+              "description": "mirror tunnel-id. For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "integer"
+            },
+            "type": {
+              "description": "One of MirrTypeSpan, MirrTypeRspan or MirrTypeErspan",
+              "type": "integer"
+            },
+            "vlan": {
+              "description": "For RSPAN we may need to send tagged mirror traffic",
+              "type": "integer"
+            }
+          }
+        },
+        "targetObject": {
+          "type": "object",
+          "properties": {
+            "attachment": {
+              "description": "Target Attachment",
+              "type": "integer"
+            },
+            "mirrObjName": {
+              "description": "Target Names",
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "MirrorGetEntry": {
+      "type": "object",
+      "required": [
+        "sync"
+      ],
+      "properties": {
+        "mirrorIdent": {
+          "description": "Mirror name",
+          "type": "string"
+        },
+        "mirrorInfo": {
+          "type": "object",
+          "properties": {
+            "port": {
+              "description": "Port where mirrored traffic needs to be sent",
+              "type": "string"
+            },
+            "remoteIP": {
+              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "string"
+            },
+            "sourceIP": {
+              "description": "For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "string"
+            },
+            "tunnelID": {
+              "description": "mirror tunnel-id. For ERSPAN we may need to send tunnelled mirror traffic",
+              "type": "integer"
+            },
+            "type": {
+              "description": "One of MirrTypeSpan, MirrTypeRspan or MirrTypeErspan",
+              "type": "integer"
+            },
+            "vlan": {
+              "description": "For RSPAN we may need to send tagged mirror traffic",
+              "type": "integer"
+            }
+          }
+        },
+        "sync": {
+          "description": "Sync - sync state",
+          "type": "integer"
+        },
+        "targetObject": {
+          "type": "object",
+          "properties": {
+            "attachment": {
+              "description": "Target Attachment",
+              "type": "integer"
+            },
+            "mirrObjName": {
+              "description": "Target Names",
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "NeighborEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Name of the interface device to which you want to add neighbor",
+          "type": "string"
+        },
+        "ipAddress": {
+          "description": "IP address to neighbor",
+          "type": "string"
+        },
+        "macAddress": {
+          "description": "MAC address to neighbor",
+          "type": "string"
+        }
+      }
+    },
+    "OperParams": {
+      "type": "object",
+      "properties": {
+        "logLevel": {
+          "description": "Set level to debug,info,error,warning,notice,critical,emergency,alert",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyEntry": {
+      "type": "object",
+      "properties": {
+        "policyIdent": {
+          "description": "Policy name",
+          "type": "string"
+        },
+        "policyInfo": {
+          "type": "object",
+          "properties": {
+            "colorAware": {
+              "description": "Policy color for QoS",
+              "type": "boolean"
+            },
+            "committedBlkSize": {
+              "description": "policy type",
+              "type": "integer"
+            },
+            "committedInfoRate": {
+              "description": "policy type",
+              "type": "integer"
+            },
+            "excessBlkSize": {
+              "description": "policy type",
+              "type": "integer"
+            },
+            "peakInfoRate": {
+              "description": "policy type",
+              "type": "integer"
+            },
+            "type": {
+              "description": "policy type",
+              "type": "integer"
+            }
+          }
+        },
+        "targetObject": {
+          "type": "object",
+          "properties": {
+            "attachment": {
+              "description": "Target Attachment",
+              "type": "integer"
+            },
+            "polObjName": {
+              "description": "Target Names",
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "PortEntry": {
+      "type": "object",
+      "properties": {
+        "DataplaneSync": {
+          "description": "Dataplan Sync check",
+          "type": "integer"
+        },
+        "portHardwareInformation": {
+          "type": "object",
+          "properties": {
+            "link": {
+              "description": "link status",
+              "type": "boolean"
+            },
+            "macAddress": {
+              "description": "MAC address of the port",
+              "type": "string"
+            },
+            "master": {
+              "description": "Port's mater",
+              "type": "string"
+            },
+            "mtu": {
+              "description": "MTU of the port",
+              "type": "integer"
+            },
+            "rawMacAddress": {
+              "description": "MAC address written by byte array",
+              "type": "array",
+              "items": {
+                "type": "integer"
+              }
+            },
+            "real": {
+              "description": "real port..",
+              "type": "string"
+            },
+            "state": {
+              "description": "state...",
+              "type": "boolean"
+            },
+            "tunnelId": {
+              "description": "Tunnel Id such as VxLAN.",
+              "type": "integer"
+            }
+          }
+        },
+        "portL2Information": {
+          "type": "object",
+          "properties": {
+            "isPvid": {
+              "description": "Is PVID config or not",
+              "type": "boolean"
+            },
+            "vid": {
+              "description": "virtual lan id(VLAN ID)",
+              "type": "integer"
+            }
+          }
+        },
+        "portL3Information": {
+          "type": "object",
+          "properties": {
+            "IPv4Address": {
+              "description": "List of IP address v4",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "IPv6Address": {
+              "description": "List of the IP address v6",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "routed": {
+              "description": "Is routed or not",
+              "type": "boolean"
+            }
+          }
+        },
+        "portName": {
+          "description": "The name of the Port interface",
+          "type": "string"
+        },
+        "portNo": {
+          "description": "Index of the Port",
+          "type": "integer"
+        },
+        "portSoftwareInformation": {
+          "type": "object",
+          "properties": {
+            "bpfLoaded": {
+              "description": "The status of the eBPF loaded",
+              "type": "boolean"
+            },
+            "osId": {
+              "description": "The ID of the Port in the software(OS)",
+              "type": "integer"
+            },
+            "portActive": {
+              "description": "Activation status of the port",
+              "type": "boolean"
+            },
+            "portProp": {
+              "description": "Priority of the port",
+              "type": "integer"
+            },
+            "portType": {
+              "description": "port type",
+              "type": "integer"
+            }
+          }
+        },
+        "portStatisticInformation": {
+          "type": "object",
+          "properties": {
+            "rxBytes": {
+              "description": "Statistic of the ingress port bytes.",
+              "type": "integer"
+            },
+            "rxErrors": {
+              "description": "Statistic of the number of ingress Error packets.",
+              "type": "integer"
+            },
+            "rxPackets": {
+              "description": "Statistic of the number of ingress packets.",
+              "type": "integer"
+            },
+            "txBytes": {
+              "description": "Statistic of the egress port bytes.",
+              "type": "integer"
+            },
+            "txErrors": {
+              "description": "Statistic of the number of egress Error packets.",
+              "type": "integer"
+            },
+            "txPackets": {
+              "description": "Statistic of the number of egress packets.",
+              "type": "integer"
+            }
+          }
+        },
+        "zone": {
+          "description": "network zone",
+          "type": "string"
+        }
+      }
+    },
+    "PostSuccess": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ProcessInfoEntry": {
+      "type": "object",
+      "properties": {
+        "CPUUsage": {
+          "description": "CPU usage of the process",
+          "type": "string"
+        },
+        "MemoryUsage": {
+          "description": "Memory usage of the process",
+          "type": "string"
+        },
+        "command": {
+          "description": "process command",
+          "type": "string"
+        },
+        "nice": {
+          "description": "process nice value",
+          "type": "string"
+        },
+        "pid": {
+          "description": "process ID",
+          "type": "string"
+        },
+        "priority": {
+          "description": "process priority",
+          "type": "string"
+        },
+        "residentSize": {
+          "description": "Physical memory usage",
+          "type": "string"
+        },
+        "sharedMemory": {
+          "description": "Shared memory usage",
+          "type": "string"
+        },
+        "status": {
+          "description": "process status",
+          "type": "string"
+        },
+        "time": {
+          "description": "Executation time",
+          "type": "string"
+        },
+        "user": {
+          "description": "User name that start the process",
+          "type": "string"
+        },
+        "virtMemory": {
+          "description": "virtual memory usage",
+          "type": "string"
+        }
+      }
+    },
+    "RouteEntry": {
+      "type": "object",
+      "properties": {
+        "destinationIPNet": {
+          "description": "IP address and netmask",
+          "type": "string"
+        },
+        "gateway": {
+          "description": "IP address for nexthop",
+          "type": "string"
+        },
+        "protocol": {
+          "description": "Protocol type of the route like \"static\"",
+          "type": "string"
+        }
+      }
+    },
+    "RouteGetEntry": {
+      "type": "object",
+      "properties": {
+        "destinationIPNet": {
+          "description": "IP address and netmask",
+          "type": "string"
+        },
+        "flags": {
+          "description": "Route flags",
+          "type": "string"
+        },
+        "gateway": {
+          "description": "IP address for nexthop",
+          "type": "string"
+        },
+        "hardwareMark": {
+          "description": "index of the route",
+          "type": "integer"
+        },
+        "protocol": {
+          "description": "Route protocol",
+          "type": "string"
+        },
+        "statistic": {
+          "type": "object",
+          "required": [
+            "bytes",
+            "packets"
+          ],
+          "properties": {
+            "bytes": {
+              "description": "Statistic of the ingress port bytes.",
+              "type": "integer"
+            },
+            "packets": {
+              "description": "Statistic of the egress port bytes.",
+              "type": "integer"
+            }
+          }
+        },
+        "sync": {
+          "type": "integer"
+        }
+      }
+    },
+    "SessionEntry": {
+      "type": "object",
+      "properties": {
+        "accessNetworkTunnel": {
+          "type": "object",
+          "properties": {
+            "TeID": {
+              "description": "ID of the tunnel",
+              "type": "integer"
+            },
+            "tunnelIP": {
+              "description": "Access network IP address",
+              "type": "string"
+            }
+          }
+        },
+        "coreNetworkTunnel": {
+          "type": "object",
+          "properties": {
+            "teID": {
+              "description": "ID of the tunnel",
+              "type": "integer"
+            },
+            "tunnelIP": {
+              "description": "Connection network IP address",
+              "type": "string"
+            }
+          }
+        },
+        "ident": {
+          "description": "IP address and netmask",
+          "type": "string"
+        },
+        "sessionIP": {
+          "description": "IP address for nexthop",
+          "type": "string"
+        }
+      }
+    },
+    "SessionUlClEntry": {
+      "type": "object",
+      "properties": {
+        "ulclArgument": {
+          "type": "object",
+          "properties": {
+            "qfi": {
+              "description": "QFI number",
+              "type": "integer"
+            },
+            "ulclIP": {
+              "description": "Access network IP address",
+              "type": "string"
+            }
+          }
+        },
+        "ulclIdent": {
+          "description": "IP address and netmask",
+          "type": "string"
+        }
+      }
+    },
+    "VIPEntry": {
+      "description": "VIP allocation of a service from an ip pool",
+      "type": "object",
+      "properties": {
+        "family": {
+          "description": "Address family to allocate - ipv4, ipv6 or dual",
+          "type": "string"
+        },
+        "ips": {
+          "description": "Allocated VIPs. In a request, specific VIPs to allocate",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pool": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "service": {
+          "description": "Name of the service which owns the VIPs",
+          "type": "string"
+        }
+      }
+    },
+    "VlanBridgeEntry": {
+      "type": "object",
+      "properties": {
+        "vid": {
+          "description": "Vlan ID",
+          "type": "integer"
+        }
+      }
+    },
+    "VlanGetEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Interface device name",
+          "type": "string"
+        },
+        "member": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VlanMemberEntry"
+          }
+        },
+        "vid": {
+          "description": "Vlan ID",
+          "type": "integer"
+        },
+        "vlanStatistic": {
+          "type": "object",
+          "properties": {
+            "inBytes": {
+              "type": "integer"
+            },
+            "inPackets": {
+              "type": "integer"
+            },
+            "outBytes": {
+              "type": "integer"
+            },
+            "outPackets": {
+              "type": "integer"
+            }
+          }
+        }
+      }
+    },
+    "VlanMemberEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Interface device name",
+          "type": "string"
+        },
+        "tagged": {
+          "description": "Tagged status added",
+          "type": "boolean"
+        }
+      }
+    },
+    "VxlanBridgeEntry": {
+      "type": "object",
+      "properties": {
+        "epIntf": {
+          "type": "string"
+        },
+        "vxlanID": {
+          "type": "integer"
+        }
+      }
+    },
+    "VxlanEntry": {
+      "type": "object",
+      "properties": {
+        "epIntf": {
+          "type": "string"
+        },
+        "peerIP": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vxlanID": {
+          "type": "integer"
+        },
+        "vxlanName": {
+          "type": "string"
+        }
+      }
+    },
+    "VxlanPeerEntry": {
+      "type": "object",
+      "properties": {
+        "peerIP": {
+          "type": "string"
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Loxilb REST API for Baremetal Scenarios",
+    "title": "Loxilb Rest API",
+    "version": "0.0.1"
+  },
+  "host": "0.0.0.0:11111",
+  "basePath": "/netlox/v1",
+  "paths": {
+    "/config/bfd": {
+      "post": {
+        "description": "Create vlan interface in the device",
+        "summary": "Create vlan interface in the device",
+        "parameters": [
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BfdEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. BFD session not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bfd/all": {
+      "get": {
+        "description": "Get BFD session inforrmation",
+        "summary": "Get BFD session inforrmation in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "Attr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BfdGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bfd/remoteIP/{remote_ip}": {
+      "delete": {
+        "description": "Delete a BFD session",
+        "summary": "Delete a BFD session",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Remote IP address",
+            "name": "remote_ip",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Cluster instance name",
+            "name": "instance",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. BFD session already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/global": {
+      "post": {
+        "description": "Adds a BGP global config",
+        "summary": "Adds a BGP global config",
+        "parameters": [
+          {
+            "description": "Attributes of bgp global config",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPGlobalConfig"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/neigh": {
+      "post": {
+        "description": "Adds a BGP Neighbor",
+        "summary": "Adds a BGP Neighbor",
+        "parameters": [
+          {
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPNeigh"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/neigh/all": {
+      "get": {
+        "description": "Get the all of BGP Neighbor",
+        "summary": "Get the all of BGP Neighbor",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "bgpNeiAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BGPNeighGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/neigh/{ip_address}": {
+      "delete": {
+        "description": "Delete a BGP Neighbor",
+        "summary": "Delete a BGP neighbor",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Neighbor IP address",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Remote ASN number",
+            "name": "remoteAs",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. Neigh already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/policy/apply": {
+      "post": {
+        "description": "Apply BGP Policy in neighbor",
+        "summary": "Apply BGP Policy in neighbor",
+        "parameters": [
+          {
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete BGP Policy in neighbor. It don't need \"routeAction\" in the attr body",
+        "summary": "Delete BGP Policy in neighbor",
+        "parameters": [
+          {
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. Neigh already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/policy/definedsets/{defineset_type}": {
+      "post": {
+        "description": "Adds a BGP definedsets for making Policy",
+        "summary": "Adds a BGP  definedsets for making Policy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPPolicyDefinedSetsMod"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/policy/definedsets/{defineset_type}/{type_name}": {
+      "get": {
+        "description": "Get the all of BGP, prefix/neighbor/community/extcommunity/aspath/largecommunity",
+        "summary": "Get the all of BGP definedsets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "type name",
+            "name": "type_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "definedsetsAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BGPPolicyDefinedSetGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a BGP definedsets",
+        "summary": "Delete a BGP definedsets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "type name",
+            "name": "type_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. Neigh already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        },
-        "sync": {
-          "type": "integer"
         }
       }
     },
-    "SessionEntry": {
-      "type": "object",
-      "properties": {
-        "accessNetworkTunnel": {
-          "type": "object",
-          "properties": {
-            "TeID": {
-              "description": "ID of the tunnel",
-              "type": "integer"
-            },
-            "tunnelIP": {
-              "description": "Access network IP address",
-              "type": "string"
+    "/config/bgp/policy/definitions": {
+      "post": {
+        "description": "Adds a BGP Policy",
+        "summary": "Adds a BGP Policy",
+        "parameters": [
+          {
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPPolicyDefinitionsMod"
             }
           }
-        },
-        "coreNetworkTunnel": {
-          "type": "object",
-          "properties": {
-            "teID": {
-              "description": "ID of the tunnel",
-              "type": "integer"
-            },
-            "tunnelIP": {
-              "description": "Connection network IP address",
-              "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        },
-        "ident": {
-          "description": "IP address and netmask",
-          "type": "string"
-        },
-        "sessionIP": {
-          "description": "IP address for nexthop",
-          "type": "string"
         }
       }
     },
-    "SessionUlClEntry": {
-      "type": "object",
-      "properties": {
-        "ulclArgument": {
-          "type": "object",
-          "properties": {
-            "qfi": {
-              "description": "QFI number",
-              "type": "integer"
-            },
-            "ulclIP": {
-              "description": "Access network IP address",
-              "type": "string"
+    "/config/bgp/policy/definitions/all": {
+      "get": {
+        "description": "Get BGP Policy definitions",
+        "summary": "Get BGP Policy definitions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "bgpPolicyAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BGPPolicyDefinitionsMod"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        },
-        "ulclIdent": {
-          "description": "IP address and netmask",
-          "type": "string"
-        }
-      }
-    },
-    "VlanBridgeEntry": {
-      "type": "object",
-      "properties": {
-        "vid": {
-          "description": "Vlan ID",
-          "type": "integer"
         }
       }
     },
-    "VlanGetEntry": {
-      "type": "object",
-      "properties": {
-        "dev": {
-          "description": "Interface device name",
-          "type": "string"
-        },
-        "member": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/VlanMemberEntry"
+    "/config/bgp/policy/definitions/{policy_name}": {
+      "delete": {
+        "description": "Delete a BGP Policy",
+        "summary": "Delete a BGP policy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the community",
+            "name": "policy_name",
+            "in": "path",
+            "required": true
           }
-        },
-        "vid": {
-          "description": "Vlan ID",
-          "type": "integer"
-        },
-        "vlanStatistic": {
-          "type": "object",
-          "properties": {
-            "inBytes": {
-              "type": "integer"
-            },
-            "inPackets": {
-              "type": "integer"
-            },
-            "outBytes": {
-              "type": "integer"
-            },
-            "outPackets": {
-              "type": "integer"
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. Neigh already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
         }
       }
     },
-    "VlanMemberEntry": {
-      "type": "object",
-      "properties": {
-        "dev": {
-          "description": "Interface device name",
-          "type": "string"
-        },
-        "tagged": {
-          "description": "Tagged status added",
-          "type": "boolean"
-        }
-      }
-    },
-    "VxlanBridgeEntry": {
-      "type": "object",
-      "properties": {
-        "epIntf": {
-          "type": "string"
-        },
-        "vxlanID": {
-          "type": "integer"
-        }
-      }
-    },
-    "VxlanEntry": {
-      "type": "object",
-      "properties": {
-        "epIntf": {
-          "type": "string"
-        },
-        "peerIP": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "vxlanID": {
-          "type": "integer"
-        },
-        "vxlanName": {
-          "type": "string"
-        }
-      }
-    },
-    "VxlanPeerEntry": {
-      "type": "object",
-      "properties": {
-        "peerIP": {
-          "type": "string"
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Loxilb REST API for Baremetal Scenarios",
-    "title": "Loxilb Rest API",
-    "version": "0.0.1"
-  },
-  "host": "0.0.0.0:11111",
-  "basePath": "/netlox/v1",
-  "paths": {
-    "/config/bfd": {
+    "/config/cistate": {
       "post": {
-        "description": "Create vlan interface in the device",
-        "summary": "Create vlan interface in the device",
+        "description": "Informs Current Cluster Instance state in the device",
+        "summary": "Informs Current Cluster Instance state in the device",
         "parameters": [
           {
-            "description": "Attributes for Vlan Interface",
+            "description": "Attributes for CI State",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BfdEntry"
+              "$ref": "#/definitions/CIStatusEntry"
             }
           }
         ],
@@ -5788,7 +8431,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5808,10 +8451,10 @@ func init() {
         }
       }
     },
-    "/config/bfd/all": {
+    "/config/cistate/all": {
       "get": {
-        "description": "Get BFD session inforrmation",
-        "summary": "Get BFD session inforrmation in the device",
+        "description": "Get Cluster Instance State in the device",
+        "summary": "Get Cluster Instance State in the device",
         "responses": {
           "200": {
             "description": "OK",
@@ -5821,7 +8464,7 @@ func init() {
                 "Attr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BfdGetEntry"
+                    "$ref": "#/definitions/CIStatusGetEntry"
                   }
                 }
               }
@@ -5848,23 +8491,59 @@ func init() {
         }
       }
     },
-    "/config/bfd/remoteIP/{remote_ip}": {
-      "delete": {
-        "description": "Delete a BFD session",
-        "summary": "Delete a BFD session",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Remote IP address",
-            "name": "remote_ip",
-            "in": "path",
-            "required": true
+    "/config/conntrack/all": {
+      "get": {
+        "description": "Get all of the conntrack infomation for all of the service.",
+        "summary": "Get all of the conntrack entries.",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ctAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ConntrackEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/endpoint": {
+      "post": {
+        "description": "Adds a LB endpoint for monitoring",
+        "summary": "Adds a LB endpoint for monitoring",
+        "parameters": [
           {
-            "type": "string",
-            "description": "Cluster instance name",
-            "name": "instance",
-            "in": "query"
+            "description": "Attributes of end point",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EndPoint"
+            }
           }
         ],
         "responses": {
@@ -5896,7 +8575,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session already exists",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5916,20 +8595,76 @@ func init() {
         }
       }
     },
-    "/config/bgp/global": {
-      "post": {
-        "description": "Adds a BGP global config",
-        "summary": "Adds a BGP global config",
-        "parameters": [
-          {
-            "description": "Attributes of bgp global config",
-            "name": "attr",
-            "in": "body",
-            "required": true,
+    "/config/endpoint/all": {
+      "get": {
+        "description": "Get End-Points State in loxilb",
+        "summary": "Get End-Points State in loxilb",
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/BGPGlobalConfig"
+              "type": "object",
+              "properties": {
+                "Attr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/EndPointGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
+        }
+      }
+    },
+    "/config/endpoint/epipaddress/{ip_address}": {
+      "delete": {
+        "description": "Delete an LB end-point from monitoring",
+        "summary": "Delete an LB end-point from monitoring",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes of end point",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Endpoint Identifier",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Probe type",
+            "name": "probe_type",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Probe port",
+            "name": "probe_port",
+            "in": "query"
+          }
         ],
         "responses": {
           "204": {
@@ -5950,17 +8685,59 @@ func init() {
           "403": {
             "description": "Capacity insufficient",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/endpoint/events": {
+      "get": {
+        "description": "Stream end-point health state changes and load-balancer end-point set changes as server-sent events.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "summary": "Stream end-point health events",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EndPointEvent"
             }
           },
-          "404": {
-            "description": "Resource not found",
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "409": {
-            "description": "Resource Conflict.",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5980,18 +8757,18 @@ func init() {
         }
       }
     },
-    "/config/bgp/neigh": {
+    "/config/fdb": {
       "post": {
-        "description": "Adds a BGP Neighbor",
-        "summary": "Adds a BGP Neighbor",
+        "description": "Assign FDB in the device",
+        "summary": "Assign FDB in the device",
         "parameters": [
           {
-            "description": "Attributes of bgp neighbor",
+            "description": "Attributes for IPv4 address",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPNeigh"
+              "$ref": "#/definitions/FDBEntry"
             }
           }
         ],
@@ -6024,7 +8801,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict.",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6044,58 +8821,31 @@ func init() {
         }
       }
     },
-    "/config/bgp/neigh/all": {
+    "/config/fdb/all": {
       "get": {
-        "description": "Get the all of BGP Neighbor",
-        "summary": "Get the all of BGP Neighbor",
+        "description": "Get FDB in the device(interface).",
+        "summary": "Get FDB in the device(interface)",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "bgpNeiAttr": {
+                "fdbAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BGPNeighGetEntry"
+                    "$ref": "#/definitions/FDBEntry"
                   }
                 }
               }
             }
           },
-          "204": {
-            "description": "OK"
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -6111,24 +8861,24 @@ func init() {
         }
       }
     },
-    "/config/bgp/neigh/{ip_address}": {
+    "/config/fdb/{mac_address}/dev/{if_name}": {
       "delete": {
-        "description": "Delete a BGP Neighbor",
-        "summary": "Delete a BGP neighbor",
+        "description": "Delete FDB in the device",
+        "summary": "Delete FDB in the device",
         "parameters": [
           {
             "type": "string",
-            "description": "Neighbor IP address",
-            "name": "ip_address",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "mac_address",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "Remote ASN number",
-            "name": "remoteAs",
-            "in": "query"
+            "type": "string",
+            "description": "Attributes of the target device",
+            "name": "if_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -6160,7 +8910,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6180,18 +8930,18 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/apply": {
+    "/config/firewall": {
       "post": {
-        "description": "Apply BGP Policy in neighbor",
-        "summary": "Apply BGP Policy in neighbor",
+        "description": "Create a new firewall config for security.",
+        "summary": "Create a new firewall config",
         "parameters": [
           {
-            "description": "Attributes of bgp neighbor",
+            "description": "Attributes for  firewall sevice",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
+              "$ref": "#/definitions/FirewallEntry"
             }
           }
         ],
@@ -6224,7 +8974,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict.",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6244,17 +8994,62 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete BGP Policy in neighbor. It don't need \"routeAction\" in the attr body",
-        "summary": "Delete BGP Policy in neighbor",
+        "description": "Delete of the firewall service.",
+        "summary": "Delete of the firewall service",
         "parameters": [
           {
-            "description": "Attributes of bgp neighbor",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
-            }
+            "type": "string",
+            "description": "Source IP address",
+            "name": "sourceIP",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
+            "name": "destinationIP",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Minimum source port range",
+            "name": "minSourcePort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum source port range",
+            "name": "maxSourcePort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Minimum destination port range",
+            "name": "minDestinationPort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum destination port range",
+            "name": "maxDestinationPort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "the protocol",
+            "name": "protocol",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "the incoming port",
+            "name": "portName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "User preference for ordering",
+            "name": "preference",
+            "in": "query"
           }
         ],
         "responses": {
@@ -6286,7 +9081,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6306,36 +9101,23 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definedsets/{defineset_type}": {
-      "post": {
-        "description": "Adds a BGP definedsets for making Policy",
-        "summary": "Adds a BGP  definedsets for making Policy",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Attributes of bgp neighbor",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BGPPolicyDefinedSetsMod"
-            }
-          }
-        ],
+    "/config/firewall/all": {
+      "get": {
+        "description": "Get all of the firewall configuration.",
+        "summary": "Get all of the firewall config",
         "responses": {
-          "204": {
-            "description": "OK"
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "fwAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/FirewallEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -6344,24 +9126,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict.",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -6377,44 +9141,21 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definedsets/{defineset_type}/{type_name}": {
+    "/config/firewall/events": {
       "get": {
-        "description": "Get the all of BGP, prefix/neighbor/community/extcommunity/aspath/largecommunity",
-        "summary": "Get the all of BGP definedsets",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "type name",
-            "name": "type_name",
-            "in": "path",
-            "required": true
-          }
+        "description": "Stream expiry of timed firewall rules and schedule changes of scheduled firewall rules as server-sent events.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
         ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "definedsetsAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/BGPPolicyDefinedSetGetEntry"
-                  }
-                }
-              }
+        "summary": "Stream firewall rule events",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallEvent"
             }
           },
-          "204": {
-            "description": "OK"
-          },
           "400": {
             "description": "Malformed arguments for API call",
             "schema": {
@@ -6427,20 +9168,40 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Resource not found",
+          "503": {
+            "description": "Maintanence mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      }
+    },
+    "/config/firewall/log": {
+      "get": {
+        "description": "Get the sampling, rate limit and outputs of logging of hits on firewall rules marked record.",
+        "summary": "Get firewall hit logging config",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallLogConfig"
+            }
           },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6459,23 +9220,18 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete a BGP definedsets",
-        "summary": "Delete a BGP definedsets",
+      "post": {
+        "description": "Set the sampling, rate limit and outputs of logging of hits on firewall rules marked record..",
+        "summary": "Set firewall hit logging config",
         "parameters": [
           {
-            "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "type name",
-            "name": "type_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes of firewall hit logging",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FirewallLogConfig"
+            }
           }
         ],
         "responses": {
@@ -6507,7 +9263,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6527,24 +9283,20 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definitions": {
-      "post": {
-        "description": "Adds a BGP Policy",
-        "summary": "Adds a BGP Policy",
-        "parameters": [
-          {
-            "description": "Attributes of bgp neighbor",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BGPPolicyDefinitionsMod"
-            }
-          }
+    "/config/firewall/log/stream": {
+      "get": {
+        "description": "Stream records of hits on firewall rules marked record as server-sent events..",
+        "produces": [
+          "application/json",
+          "text/event-stream"
         ],
+        "summary": "Stream firewall hit log records",
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallLogRecord"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -6558,24 +9310,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict.",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -6591,20 +9325,20 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definitions/all": {
+    "/config/firewall/policy": {
       "get": {
-        "description": "Get BGP Policy definitions",
-        "summary": "Get BGP Policy definitions",
+        "description": "Get the policy of each zone for packets which match no firewall rule.",
+        "summary": "Get firewall default policies",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "bgpPolicyAttr": {
+                "fwPolicyAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BGPPolicyDefinitionsMod"
+                    "$ref": "#/definitions/FirewallPolicyEntry"
                   }
                 }
               }
@@ -6629,19 +9363,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/bgp/policy/definitions/{policy_name}": {
-      "delete": {
-        "description": "Delete a BGP Policy",
-        "summary": "Delete a BGP policy",
+      },
+      "post": {
+        "description": "Set the policy of a zone for packets which match no firewall rule.",
+        "summary": "Set firewall default policy of a zone",
         "parameters": [
           {
-            "type": "string",
-            "description": "The name of the community",
-            "name": "policy_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes of firewall default policy",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FirewallPolicyEntry"
+            }
           }
         ],
         "responses": {
@@ -6673,7 +9407,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -6693,18 +9427,18 @@ func init() {
         }
       }
     },
-    "/config/cistate": {
+    "/config/ippool": {
       "post": {
-        "description": "Informs Current Cluster Instance state in the device",
-        "summary": "Informs Current Cluster Instance state in the device",
+        "description": "Create an ip pool with an IPv4 and/or an IPv6 subnet from which VIPs are allocated.",
+        "summary": "Create an ip pool for VIP allocation",
         "parameters": [
           {
-            "description": "Attributes for CI State",
+            "description": "Attributes of the ip pool",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CIStatusEntry"
+              "$ref": "#/definitions/IPPoolEntry"
             }
           }
         ],
@@ -6757,20 +9491,20 @@ func init() {
         }
       }
     },
-    "/config/cistate/all": {
+    "/config/ippool/all": {
       "get": {
-        "description": "Get Cluster Instance State in the device",
-        "summary": "Get Cluster Instance State in the device",
+        "description": "Get all ip pools along with their VIP allocations.",
+        "summary": "Get all ip pools",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "Attr": {
+                "ippoolAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/CIStatusGetEntry"
+                    "$ref": "#/definitions/IPPoolGetEntry"
                   }
                 }
               }
@@ -6797,23 +9531,27 @@ func init() {
         }
       }
     },
-    "/config/conntrack/all": {
-      "get": {
-        "description": "Get all of the conntrack infomation for all of the service.",
-        "summary": "Get all of the conntrack entries.",
+    "/config/ippool/name/{name}": {
+      "delete": {
+        "description": "Delete an ip pool. A pool with VIP allocations can not be deleted.",
+        "summary": "Delete an ip pool",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the ip pool",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "ctAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/ConntrackEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -6822,6 +9560,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -6837,24 +9593,34 @@ func init() {
         }
       }
     },
-    "/config/endpoint": {
+    "/config/ippool/name/{name}/vip": {
       "post": {
-        "description": "Adds a LB endpoint for monitoring",
-        "summary": "Adds a LB endpoint for monitoring",
+        "description": "Allocate VIPs for a service from an ip pool. Specific VIPs can be asked for, otherwise new VIPs of the asked family are allocated. A service which already holds VIPs gets the same ones back.",
+        "summary": "Allocate VIPs for a service",
         "parameters": [
           {
-            "description": "Attributes of end point",
+            "type": "string",
+            "description": "Name of the ip pool",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Attributes of the VIP allocation",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/EndPoint"
+              "$ref": "#/definitions/VIPEntry"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/VIPEntry"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -6901,75 +9667,24 @@ func init() {
         }
       }
     },
-    "/config/endpoint/all": {
-      "get": {
-        "description": "Get End-Points State in loxilb",
-        "summary": "Get End-Points State in loxilb",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "Attr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/EndPointGetEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/endpoint/epipaddress/{ip_address}": {
+    "/config/ippool/name/{name}/vip/service/{service}": {
       "delete": {
-        "description": "Delete an LB end-point from monitoring",
-        "summary": "Delete an LB end-point from monitoring",
+        "description": "Release all VIPs held by a service back to the ip pool.",
+        "summary": "Release VIPs of a service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes of end point",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Endpoint Identifier",
-            "name": "name",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Probe type",
-            "name": "probe_type",
-            "in": "query"
+            "description": "Name of the ip pool",
+            "name": "name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "number",
-            "description": "Probe port",
-            "name": "probe_port",
-            "in": "query"
+            "type": "string",
+            "description": "Name of the service",
+            "name": "service",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -7001,7 +9716,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -7021,18 +9736,18 @@ func init() {
         }
       }
     },
-    "/config/fdb": {
+    "/config/ipset": {
       "post": {
-        "description": "Assign FDB in the device",
-        "summary": "Assign FDB in the device",
+        "description": "Create a named ip set of CIDRs which can be used in place of a source or destination CIDR in firewall rules. Only IPv4 members are used by eBPF datapath.",
+        "summary": "Create an ip set",
         "parameters": [
           {
-            "description": "Attributes for IPv4 address",
+            "description": "Attributes of the ip set",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/FDBEntry"
+              "$ref": "#/definitions/IPSetEntry"
             }
           }
         ],
@@ -7065,7 +9780,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -7085,20 +9800,20 @@ func init() {
         }
       }
     },
-    "/config/fdb/all": {
+    "/config/ipset/all": {
       "get": {
-        "description": "Get FDB in the device(interface).",
-        "summary": "Get FDB in the device(interface)",
+        "description": "Get all ip sets along with the number of firewall rules using them.",
+        "summary": "Get all ip sets",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "fdbAttr": {
+                "ipsetAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/FDBEntry"
+                    "$ref": "#/definitions/IPSetEntry"
                   }
                 }
               }
@@ -7125,87 +9840,25 @@ func init() {
         }
       }
     },
-    "/config/fdb/{mac_address}/dev/{if_name}": {
-      "delete": {
-        "description": "Delete FDB in the device",
-        "summary": "Delete FDB in the device",
+    "/config/ipset/name/{name}": {
+      "put": {
+        "description": "Replace all members of an ip set at once. Firewall rules using the set are updated in the datapath without being re-created.",
+        "summary": "Replace members of an ip set",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "mac_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes of the target device",
-            "name": "if_name",
+            "description": "Name of the ip set",
+            "name": "name",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "OK"
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
           },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/firewall": {
-      "post": {
-        "description": "Create a new firewall config for security.",
-        "summary": "Create a new firewall config",
-        "parameters": [
           {
-            "description": "Attributes for  firewall sevice",
+            "description": "Attributes of the ip set",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/FirewallEntry"
+              "$ref": "#/definitions/IPSetEntry"
             }
           }
         ],
@@ -7238,7 +9891,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -7258,62 +9911,15 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete of the firewall service.",
-        "summary": "Delete of the firewall service",
+        "description": "Delete an ip set. A set used by firewall rules can not be deleted.",
+        "summary": "Delete an ip set",
         "parameters": [
           {
             "type": "string",
-            "description": "Source IP address",
-            "name": "sourceIP",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Destination IP in CIDR notation",
-            "name": "destinationIP",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Minimum source port range",
-            "name": "minSourcePort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Maximum source port range",
-            "name": "maxSourcePort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Minimum destination port range",
-            "name": "minDestinationPort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Maximum destination port range",
-            "name": "maxDestinationPort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "the protocol",
-            "name": "protocol",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "the incoming port",
-            "name": "portName",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "User preference for ordering",
-            "name": "preference",
-            "in": "query"
+            "description": "Name of the ip set",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -7345,47 +9951,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintanence mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/firewall/all": {
-      "get": {
-        "description": "Get all of the firewall configuration.",
-        "summary": "Get all of the firewall config",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fwAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/FirewallEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -7691,6 +10257,77 @@ func init() {
           }
         }
       },
+      "put": {
+        "description": "Make the load balancer services match the given set. Missing services are added, changed services are updated and services not in the set are deleted. Unchanged services and their connections are left untouched.",
+        "summary": "Set the complete set of load balancer services",
+        "parameters": [
+          {
+            "description": "Complete set of load balancer services",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceEntryList"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only return the changes which would be done without applying them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
       "delete": {
         "description": "Delete all load balancer services.",
         "summary": "Delete all Load balancer services",
@@ -9190,6 +11827,103 @@ func init() {
         }
       }
     },
+    "/config/transaction": {
+      "get": {
+        "description": "Get the current config generation to be used for compare-and-set of transactions.",
+        "summary": "Get the current config generation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Validate and apply a set of load balancer rules, firewall rules and end-points as one transaction. Nothing is changed if any of them fails.",
+        "summary": "Commit a bulk configuration transaction",
+        "parameters": [
+          {
+            "description": "Attributes of the transaction",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ConfigTransaction"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigTransactionResult"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/config/tunnel/vxlan": {
       "post": {
         "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
@@ -9803,6 +12537,46 @@ func init() {
         }
       }
     },
+    "/status/ipconfig": {
+      "get": {
+        "description": "Get apply status of the saved ip config (addresses, routes, vlan and vxlan) of each interface.",
+        "summary": "Get apply status of saved per-interface ip config",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipconfigAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPConfigStatusEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintanence mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/status/process": {
       "get": {
         "description": "Get a process based on high usage CPU(linux command \"top\") in the device or system.",
@@ -10746,6 +13520,82 @@ func init() {
         }
       }
     },
+    "ConfigTransaction": {
+      "type": "object",
+      "properties": {
+        "endPoints": {
+          "description": "End-points to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndPoint"
+          }
+        },
+        "fwRules": {
+          "description": "Firewall rules to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallEntry"
+          }
+        },
+        "fwRulesDel": {
+          "description": "Firewall rules to be deleted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallEntry"
+          }
+        },
+        "generation": {
+          "description": "Config generation the transaction is based on. If set, the transaction is committed only if it matches the current generation",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lbRules": {
+          "description": "Load balancer rules to be added or updated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
+          }
+        },
+        "lbRulesDel": {
+          "description": "Load balancer rules to be deleted",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
+          }
+        }
+      }
+    },
+    "ConfigTransactionResult": {
+      "type": "object",
+      "properties": {
+        "added": {
+          "description": "Objects which were added",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "deleted": {
+          "description": "Objects which were deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "generation": {
+          "description": "Config generation after the transaction",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "updated": {
+          "description": "Objects which were updated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ConntrackEntry": {
       "type": "object",
       "properties": {
@@ -10827,6 +13677,10 @@ func init() {
     "EndPoint": {
       "type": "object",
       "properties": {
+        "activeReTries": {
+          "description": "Number of consecutive successful probes to mark end-point active",
+          "type": "integer"
+        },
         "hostName": {
           "description": "Host name in CIDR",
           "type": "string"
@@ -10839,31 +13693,117 @@ func init() {
           "description": "Endpoint Identifier",
           "type": "string"
         },
+        "probeCmd": {
+          "description": "Command to run for exec probes. End-point IP and port are passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables",
+          "type": "string"
+        },
         "probeDuration": {
           "description": "How frequently to probe in seconds",
           "type": "integer"
         },
+        "probeHeaders": {
+          "description": "Extra headers as Name:Value for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header for http/https probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Max random delay in seconds added to each probe interval",
+          "type": "integer"
+        },
+        "probeMethod": {
+          "description": "Method for http/https probes",
+          "type": "string"
+        },
         "probePort": {
           "description": "The l4port to probe on",
           "type": "integer"
         },
         "probeReq": {
-          "description": "URI for http/https probes",
+          "description": "URI for http/https probes or service name for grpc probes",
           "type": "string"
         },
         "probeResp": {
           "description": "Response for http/https probes",
           "type": "string"
         },
+        "probeRespCodes": {
+          "description": "Accepted status codes for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "probeRespRegex": {
+          "description": "Regex to match response body for http/https probes",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of each probe in seconds, 2 by default and at most 30. It must be less than probeDuration",
+          "type": "integer"
+        },
+        "probeType": {
+          "description": "Type of probe used",
+          "type": "string"
+        }
+      }
+    },
+    "EndPointEvent": {
+      "type": "object",
+      "properties": {
+        "currState": {
+          "description": "Current state of the end-point (ok/nok)",
+          "type": "string"
+        },
+        "hostName": {
+          "description": "Host name in CIDR",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Kind of event - ep-state for end-point health changes, lb-ep-set for load-balancer end-point set changes",
+          "type": "string"
+        },
+        "lastDelay": {
+          "description": "Last measured probe delay",
+          "type": "string"
+        },
+        "name": {
+          "description": "Endpoint Identifier",
+          "type": "string"
+        },
+        "probePort": {
+          "description": "The l4port to probe on",
+          "type": "integer"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string"
+        },
+        "rules": {
+          "description": "Load-balancer rules affected by this event",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "description": "Time of the event in RFC3339 format",
+          "type": "string"
         }
       }
     },
     "EndPointGetEntry": {
       "type": "object",
       "properties": {
+        "activeReTries": {
+          "description": "Number of consecutive successful probes to mark end-point active",
+          "type": "integer"
+        },
         "avgDelay": {
           "description": "Average delay seen for endpoint",
           "type": "string"
@@ -10892,22 +13832,60 @@ func init() {
           "description": "Endpoint Identifier",
           "type": "string"
         },
+        "probeCmd": {
+          "description": "Command to run for exec probes. End-point IP and port are passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables",
+          "type": "string"
+        },
         "probeDuration": {
           "description": "How frequently to probe in seconds",
           "type": "integer"
         },
+        "probeHeaders": {
+          "description": "Extra headers as Name:Value for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header for http/https probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Max random delay in seconds added to each probe interval",
+          "type": "integer"
+        },
+        "probeMethod": {
+          "description": "Method for http/https probes",
+          "type": "string"
+        },
         "probePort": {
           "description": "The l4port to probe on",
           "type": "integer"
         },
         "probeReq": {
-          "description": "URI for http/https probes",
+          "description": "URI for http/https probes or service name for grpc probes",
+          "type": "string"
+        },
+        "probeResp": {
+          "description": "Response for http/https probes",
           "type": "string"
         },
-        "probeResp": {
-          "description": "Response for http/https probes",
+        "probeRespCodes": {
+          "description": "Accepted status codes for http/https probes",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "probeRespRegex": {
+          "description": "Regex to match response body for http/https probes",
           "type": "string"
         },
+        "probeTimeout": {
+          "description": "Timeout of each probe in seconds, 2 by default and at most 30. It must be less than probeDuration",
+          "type": "integer"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string"
@@ -10996,6 +13974,113 @@ func init() {
         }
       }
     },
+    "FirewallEvent": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "description": "Kind of event - fw-expired, fw-active or fw-inactive",
+          "type": "string"
+        },
+        "name": {
+          "description": "Firewall rule as shown in rule dumps",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time of the event in RFC3339 format",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallLogConfig": {
+      "type": "object",
+      "properties": {
+        "dropped": {
+          "description": "Number of hits not logged due to rate limit or overload",
+          "type": "integer"
+        },
+        "file": {
+          "description": "Path of the JSON lines log file, empty for no file",
+          "type": "string"
+        },
+        "fileMaxBackups": {
+          "description": "Number of rotated log files kept",
+          "type": "integer"
+        },
+        "fileMaxSize": {
+          "description": "Size in MB at which the log file is rotated",
+          "type": "integer"
+        },
+        "logged": {
+          "description": "Number of hits logged",
+          "type": "integer"
+        },
+        "rateLimit": {
+          "description": "Max number of hits logged per second, 0 for no limit",
+          "type": "integer"
+        },
+        "sample": {
+          "description": "Log one in every sample hits, 0 or 1 logs every hit",
+          "type": "integer"
+        },
+        "sampled": {
+          "description": "Number of hits skipped by sampling",
+          "type": "integer"
+        },
+        "syslog": {
+          "description": "Syslog server as udp://host:port, tcp://host:port or local, empty for no syslog",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallLogRecord": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Action taken - allow, drop, trap or redirect",
+          "type": "string"
+        },
+        "destinationIP": {
+          "description": "Destination IP of the packet",
+          "type": "string"
+        },
+        "destinationPort": {
+          "description": "Destination port of the packet",
+          "type": "integer"
+        },
+        "port": {
+          "description": "Port the packet came in on",
+          "type": "string"
+        },
+        "preference": {
+          "description": "Preference of the firewall rule",
+          "type": "integer"
+        },
+        "proto": {
+          "description": "Protocol of the packet",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Firewall rule as shown in rule dumps",
+          "type": "string"
+        },
+        "ruleID": {
+          "description": "Identifier of the firewall rule",
+          "type": "integer"
+        },
+        "sourceIP": {
+          "description": "Source IP of the packet",
+          "type": "string"
+        },
+        "sourcePort": {
+          "description": "Source port of the packet",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time of the hit in RFC3339 format",
+          "type": "string"
+        }
+      }
+    },
     "FirewallOptionEntry": {
       "type": "object",
       "properties": {
@@ -11015,6 +14100,10 @@ func init() {
           "description": "Drop any matching rule",
           "type": "boolean"
         },
+        "expiry": {
+          "description": "Time in RFC3339 format at which the rule expires",
+          "type": "string"
+        },
         "fwMark": {
           "description": "Set a fwmark for any matching rule",
           "type": "integer"
@@ -11031,6 +14120,18 @@ func init() {
           "description": "Redirect any matching rule",
           "type": "string"
         },
+        "remaining": {
+          "description": "Remaining lifetime of the rule in seconds",
+          "type": "integer"
+        },
+        "schedule": {
+          "description": "Recurring active window as \"min hour dom mon dow window\" e.g \"0 9 * * 1-5 8h\"",
+          "type": "string"
+        },
+        "scheduleState": {
+          "description": "State of a scheduled rule - active or inactive",
+          "type": "string"
+        },
         "toIP": {
           "description": "Modify to given IP in CIDR notation",
           "type": "string"
@@ -11042,6 +14143,23 @@ func init() {
         "trap": {
           "description": "Trap anything matching rule",
           "type": "boolean"
+        },
+        "ttl": {
+          "description": "Lifetime of the rule in seconds",
+          "type": "integer"
+        }
+      }
+    },
+    "FirewallPolicyEntry": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "description": "Policy - accept or drop",
+          "type": "string"
+        },
+        "zone": {
+          "description": "Name of the zone, root zone if empty",
+          "type": "string"
         }
       }
     },
@@ -11049,7 +14167,7 @@ func init() {
       "type": "object",
       "properties": {
         "destinationIP": {
-          "description": "Destination IP in CIDR notation",
+          "description": "Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
           "type": "string"
         },
         "maxDestinationPort": {
@@ -11081,8 +14199,118 @@ func init() {
           "type": "integer"
         },
         "sourceIP": {
-          "description": "Source IP in CIDR notation",
+          "description": "Source IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)",
+          "type": "string"
+        }
+      }
+    },
+    "IPConfigStatusEntry": {
+      "description": "Apply status of saved ip config of an interface",
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "Number of config entries applied",
+          "type": "integer"
+        },
+        "attempts": {
+          "description": "Number of apply attempts",
+          "type": "integer"
+        },
+        "dev": {
+          "description": "Name of the interface",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "Error of the last apply attempt",
+          "type": "string"
+        },
+        "linkUp": {
+          "description": "Operational state of the interface",
+          "type": "boolean"
+        },
+        "pending": {
+          "description": "Number of config entries still to be applied",
+          "type": "integer"
+        },
+        "status": {
+          "description": "Apply status - applied, pending, link-down or failed",
+          "type": "string"
+        }
+      }
+    },
+    "IPPoolEntry": {
+      "description": "IP pool for VIP allocation",
+      "type": "object",
+      "properties": {
+        "cidrs": {
+          "description": "Subnets of the pool, at most one IPv4 and one IPv6",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "reserved": {
+          "description": "IPs which are only allocated when asked for explicitly",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "IPPoolGetEntry": {
+      "description": "IP pool along with its VIP allocations",
+      "type": "object",
+      "properties": {
+        "allocs": {
+          "description": "VIP allocations from the pool",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VIPEntry"
+          }
+        },
+        "cidrs": {
+          "description": "Subnets of the pool",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "reserved": {
+          "description": "IPs which are only allocated when asked for explicitly",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "IPSetEntry": {
+      "description": "Named ip set used by firewall rules",
+      "type": "object",
+      "properties": {
+        "cidrs": {
+          "description": "Members of the set in CIDR notation, IPv4 and/or IPv6",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the ip set",
           "type": "string"
+        },
+        "refs": {
+          "description": "Number of firewall rules using the set",
+          "type": "integer"
         }
       }
     },
@@ -11195,7 +14423,7 @@ func init() {
               "format": "int32"
             },
             "probeTimeout": {
-              "description": "value for probe timer (in seconds)",
+              "description": "value for timeout of each probe (in seconds), 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set, 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set",
               "type": "integer",
               "format": "uint32"
             },
@@ -11226,7 +14454,7 @@ func init() {
               "format": "int32"
             },
             "sel": {
-              "description": "value for load balance algorithim",
+              "description": "value for load balance algorithim (maglev is approximated with 16 hash slots by eBPF datapath)",
               "type": "integer"
             },
             "snat": {
@@ -11239,10 +14467,18 @@ func init() {
     },
     "LoadbalanceEntryEndpointsItems0": {
       "properties": {
+        "activeConns": {
+          "description": "Remaining active connections of a draining endpoint",
+          "type": "integer"
+        },
         "counter": {
           "description": "traffic counters of the endpoint",
           "type": "string"
         },
+        "currWeight": {
+          "description": "Weight currently in effect for the endpoint",
+          "type": "integer"
+        },
         "endpointIP": {
           "description": "IP address for external access",
           "type": "string"
@@ -11261,6 +14497,19 @@ func init() {
         }
       }
     },
+    "LoadbalanceEntryList": {
+      "description": "Complete set of load balancer services",
+      "type": "object",
+      "properties": {
+        "lbAttr": {
+          "description": "Load balancer services",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceEntry"
+          }
+        }
+      }
+    },
     "LoadbalanceEntrySecondaryIPsItems0": {
       "properties": {
         "secondaryIP": {
@@ -11281,6 +14530,11 @@ func init() {
           "type": "integer",
           "format": "uint16"
         },
+        "drainTimeout": {
+          "description": "max time for which removed end-points are drained (in seconds), 0 disables draining",
+          "type": "integer",
+          "format": "uint32"
+        },
         "externalIP": {
           "description": "IP address for externel access",
           "type": "string"
@@ -11298,6 +14552,16 @@ func init() {
           "description": "externally managed rule or not",
           "type": "boolean"
         },
+        "maxConns": {
+          "description": "max concurrent connections to the service, 0 means no limit (checked periodically with eBPF datapath)",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "maxConnsPerEndpoint": {
+          "description": "max concurrent connections to an end-point, end-points at limit are skipped, 0 means no limit (checked periodically with eBPF datapath)",
+          "type": "integer",
+          "format": "uint32"
+        },
         "mode": {
           "description": "value for NAT mode (0-DNAT, 1-oneArm, 2-fullNAT)",
           "type": "integer",
@@ -11311,6 +14575,26 @@ func init() {
           "description": "service name",
           "type": "string"
         },
+        "newConnRate": {
+          "description": "max new connections per second to the service, 0 means no limit (experimental, userspace datapath only)",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "odEjectTime": {
+          "description": "base ejection time of an outlier end-point (in seconds)",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "odFailRatio": {
+          "description": "failed connection ratio (in percent) to eject an end-point as outlier, 0 disables outlier detection",
+          "type": "integer",
+          "format": "uint8"
+        },
+        "odMaxEjectPct": {
+          "description": "max percent of end-points ejected as outliers at a time",
+          "type": "integer",
+          "format": "uint8"
+        },
         "oper": {
           "description": "end-point specific op (0-create, 1-attachEP, 2-detachEP)",
           "type": "integer",
@@ -11320,13 +14604,62 @@ func init() {
           "description": "port number for the access",
           "type": "integer"
         },
+        "portMask": {
+          "description": "prefix mask of the service port range, alternative to portMax (at most 4096 ports, 256 with eBPF datapath)",
+          "type": "integer"
+        },
+        "portMax": {
+          "description": "last port of the service port range starting at port (at most 4096 ports, 256 with eBPF datapath)",
+          "type": "integer"
+        },
+        "probeActRetries": {
+          "description": "value for consecutive successful probes to mark end-point active",
+          "type": "integer",
+          "format": "int32"
+        },
+        "probeHeaders": {
+          "description": "extra headers as Name:Value for http/https probe",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "host header for http/https probe",
+          "type": "string"
+        },
+        "probeInterval": {
+          "description": "value for probe interval (in seconds), probeTimeout is used if not set",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "probeJitter": {
+          "description": "value for max random delay added to each probe interval (in seconds)",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "probeMethod": {
+          "description": "method for http/https probe",
+          "type": "string"
+        },
+        "probeRespCodes": {
+          "description": "accepted status codes for http/https probe",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "probeRespRegex": {
+          "description": "regex to match response body for http/https probe",
+          "type": "string"
+        },
         "probeRetries": {
           "description": "value for probe retries",
           "type": "integer",
           "format": "int32"
         },
         "probeTimeout": {
-          "description": "value for probe timer (in seconds)",
+          "description": "value for timeout of each probe (in seconds), 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set, 2 by default and at most 30. It must be less than probeInterval and is taken as the probe interval if that is not set",
           "type": "integer",
           "format": "uint32"
         },
@@ -11351,18 +14684,48 @@ func init() {
           "description": "value for access protocol",
           "type": "string"
         },
+        "rejectedConns": {
+          "description": "number of new connections rejected due to connection limits",
+          "type": "integer",
+          "format": "uint64"
+        },
         "security": {
           "description": "value for Security mode (0-Plain, 1-HTTPs)",
           "type": "integer",
           "format": "int32"
         },
         "sel": {
-          "description": "value for load balance algorithim",
+          "description": "value for load balance algorithim (maglev is approximated with 16 hash slots by eBPF datapath)",
           "type": "integer"
         },
+        "slowStart": {
+          "description": "slow-start window of a newly active end-point (in seconds), 0 disables slow-start, only valid with weighted (prio or maglev) selection",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "slowStartCurve": {
+          "description": "weight ramp curve during slow-start (linear, quadratic or sqrt)",
+          "type": "string"
+        },
+        "slowStartFloor": {
+          "description": "weight (in percent) of an end-point as slow-start begins",
+          "type": "integer",
+          "format": "uint8"
+        },
         "snat": {
           "description": "snat rule",
           "type": "boolean"
+        },
+        "sourceRangeDrops": {
+          "description": "counter (packets:bytes) of traffic dropped due to source ranges",
+          "type": "string"
+        },
+        "sourceRanges": {
+          "description": "allowed source prefixes in CIDR notation, all sources are allowed if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -12202,6 +15565,31 @@ func init() {
         }
       }
     },
+    "VIPEntry": {
+      "description": "VIP allocation of a service from an ip pool",
+      "type": "object",
+      "properties": {
+        "family": {
+          "description": "Address family to allocate - ipv4, ipv6 or dual",
+          "type": "string"
+        },
+        "ips": {
+          "description": "Allocated VIPs. In a request, specific VIPs to allocate",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pool": {
+          "description": "Name of the ip pool",
+          "type": "string"
+        },
+        "service": {
+          "description": "Name of the service which owns the VIPs",
+          "type": "string"
+        }
+      }
+    },
     "VlanBridgeEntry": {
       "type": "object",
       "properties": {
@@ -16,11 +16,16 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 )
 
 var ApiHooks cmn.NetHookInterface
@@ -37,3 +42,71 @@ func (result *ResultResponse) WriteResponse(w http.ResponseWriter, producer runt
 func (c CustomResponder) WriteResponse(w http.ResponseWriter, p runtime.Producer) {
 	c(w, p)
 }
+
+// EventKeepAlive - Interval for sending keep-alive comments on an idle event stream
+const EventKeepAlive = 15 * time.Second
+
+// EventStream - Send items received on ch to the client as server-sent events
+// till the client goes away. conv gives event name and payload of an item.
+// unSub is called once the stream is over
+func EventStream[T any](req *http.Request, what string, ch <-chan T, unSub func(),
+	conv func(T) (string, interface{})) middleware.Responder {
+	return CustomResponder(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer unSub()
+
+		rc := http.NewResponseController(w)
+		// The stream lives until the client goes away
+		rc.SetWriteDeadline(time.Time{})
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		if err := rc.Flush(); err != nil {
+			tk.LogIt(tk.LogError, "[API] %s stream not supported : %v\n", what, err)
+			return
+		}
+
+		ka := time.NewTicker(EventKeepAlive)
+		defer ka.Stop()
+
+		for {
+			select {
+			case <-req.Context().Done():
+				return
+			case <-ka.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case item := <-ch:
+				event, payload := conv(item)
+				data, err := json.Marshal(payload)
+				if err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+					return
+				}
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	})
+}
+
+func intsToInt64s(in []int) []int64 {
+	var out []int64
+	for _, v := range in {
+		out = append(out, int64(v))
+	}
+	return out
+}
+
+func int64sToInts(in []int64) []int {
+	var out []int
+	for _, v := range in {
+		out = append(out, int(v))
+	}
+	return out
+}
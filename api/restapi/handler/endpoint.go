@@ -16,6 +16,8 @@
 package handler
 
 import (
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/loxilb-io/loxilb/api/models"
 	"github.com/loxilb-io/loxilb/api/restapi/operations"
@@ -41,11 +43,20 @@ func ConfigGetEndPoint(params operations.GetConfigEndpointAllParams) middleware.
 		tmpEP.HostName = ep.HostName
 		tmpEP.Name = ep.Name
 		tmpEP.InactiveReTries = int64(ep.InActTries)
+		tmpEP.ActiveReTries = int64(ep.ActTries)
+		tmpEP.ProbeJitter = int64(ep.ProbeJitter)
 		tmpEP.ProbeType = ep.ProbeType
 		tmpEP.ProbeReq = ep.ProbeReq
 		tmpEP.ProbeResp = ep.ProbeResp
 		tmpEP.ProbeDuration = int64(ep.ProbeDuration)
+		tmpEP.ProbeTimeout = int64(ep.ProbeTimeout)
 		tmpEP.ProbePort = int64(ep.ProbePort)
+		tmpEP.ProbeMethod = ep.ProbeMethod
+		tmpEP.ProbeHost = ep.ProbeHost
+		tmpEP.ProbeHeaders = ep.ProbeHeaders
+		tmpEP.ProbeRespCodes = intsToInt64s(ep.ProbeRespCodes)
+		tmpEP.ProbeRespRegex = ep.ProbeRespRegex
+		tmpEP.ProbeCmd = ep.ProbeCmd
 		tmpEP.MinDelay = ep.MinDelay
 		tmpEP.AvgDelay = ep.AvgDelay
 		tmpEP.MaxDelay = ep.MaxDelay
@@ -60,15 +71,7 @@ func ConfigGetEndPoint(params operations.GetConfigEndpointAllParams) middleware.
 func ConfigPostEndPoint(params operations.PostConfigEndpointParams) middleware.Responder {
 	tk.LogIt(tk.LogDebug, "[API] EndPoint %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
 
-	EP := cmn.EndPointMod{}
-	EP.HostName = params.Attr.HostName
-	EP.Name = params.Attr.Name
-	EP.ProbeType = params.Attr.ProbeType
-	EP.InActTries = int(params.Attr.InactiveReTries)
-	EP.ProbeReq = params.Attr.ProbeReq
-	EP.ProbeResp = params.Attr.ProbeResp
-	EP.ProbeDuration = uint32(params.Attr.ProbeDuration)
-	EP.ProbePort = uint16(params.Attr.ProbePort)
+	EP := epModFromEntry(params.Attr)
 
 	_, err := ApiHooks.NetEpHostAdd(&EP)
 	if err != nil {
@@ -100,3 +103,57 @@ func ConfigDeleteEndPoint(params operations.DeleteConfigEndpointEpipaddressIPAdd
 	}
 	return &ResultResponse{Result: "Success"}
 }
+
+// EpEventQueueLen - Number of events queued for a slow event stream client
+const EpEventQueueLen = 128
+
+func ConfigGetEndPointEvents(params operations.GetConfigEndpointEventsParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] EndPoint events %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	evCh := make(chan cmn.EndPointEvent, EpEventQueueLen)
+	_, err := ApiHooks.NetEpHostEventSub(evCh)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+
+	unSub := func() { ApiHooks.NetEpHostEventUnSub(evCh) }
+	return EventStream(params.HTTPRequest, "EndPoint events", evCh, unSub,
+		func(ev cmn.EndPointEvent) (string, interface{}) {
+			return ev.Kind, &models.EndPointEvent{
+				Timestamp: ev.Time.Format(time.RFC3339),
+				Kind:      ev.Kind,
+				HostName:  ev.HostName,
+				Name:      ev.Name,
+				ProbeType: ev.ProbeType,
+				ProbePort: int64(ev.ProbePort),
+				CurrState: ev.CurrState,
+				LastDelay: ev.LastDelay,
+				Rules:     ev.Rules,
+			}
+		})
+}
+
+// epModFromEntry - Convert an end-point entry of the API to cmn.EndPointMod
+func epModFromEntry(attr *models.EndPoint) cmn.EndPointMod {
+	EP := cmn.EndPointMod{}
+	EP.HostName = attr.HostName
+	EP.Name = attr.Name
+	EP.ProbeType = attr.ProbeType
+	EP.InActTries = int(attr.InactiveReTries)
+	EP.ActTries = int(attr.ActiveReTries)
+	EP.ProbeJitter = uint32(attr.ProbeJitter)
+	EP.ProbeReq = attr.ProbeReq
+	EP.ProbeResp = attr.ProbeResp
+	EP.ProbeDuration = uint32(attr.ProbeDuration)
+	EP.ProbeTimeout = uint32(attr.ProbeTimeout)
+	EP.ProbePort = uint16(attr.ProbePort)
+	EP.ProbeMethod = attr.ProbeMethod
+	EP.ProbeHost = attr.ProbeHost
+	EP.ProbeHeaders = attr.ProbeHeaders
+	EP.ProbeRespCodes = int64sToInts(attr.ProbeRespCodes)
+	EP.ProbeRespRegex = attr.ProbeRespRegex
+	EP.ProbeCmd = attr.ProbeCmd
+
+	return EP
+}
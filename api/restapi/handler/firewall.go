@@ -17,6 +17,7 @@ package handler
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/loxilb-io/loxilb/api/models"
@@ -27,41 +28,7 @@ import (
 
 func ConfigPostFW(params operations.PostConfigFirewallParams) middleware.Responder {
 	tk.LogIt(tk.LogDebug, "[API] Firewall %s API callded. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
-	Opts := cmn.FwOptArg{}
-	Rules := cmn.FwRuleArg{}
-	FW := cmn.FwRuleMod{}
-	//Body Maker
-	Rules.DstIP = params.Attr.RuleArguments.DestinationIP
-	Rules.DstPortMax = uint16(params.Attr.RuleArguments.MaxDestinationPort)
-	Rules.DstPortMin = uint16(params.Attr.RuleArguments.MinDestinationPort)
-	Rules.InPort = params.Attr.RuleArguments.PortName
-	Rules.Pref = uint16(params.Attr.RuleArguments.Preference)
-	Rules.Proto = uint8(params.Attr.RuleArguments.Protocol)
-	Rules.SrcIP = params.Attr.RuleArguments.SourceIP
-	Rules.SrcPortMax = uint16(params.Attr.RuleArguments.MaxSourcePort)
-	Rules.SrcPortMin = uint16(params.Attr.RuleArguments.MinSourcePort)
-
-	if Rules.DstIP == "" {
-		Rules.DstIP = "0.0.0.0/0"
-	}
-
-	if Rules.SrcIP == "" {
-		Rules.SrcIP = "0.0.0.0/0"
-	}
-	// opts
-	Opts.Allow = params.Attr.Opts.Allow
-	Opts.Drop = params.Attr.Opts.Drop
-	Opts.Rdr = params.Attr.Opts.Redirect
-	Opts.RdrPort = params.Attr.Opts.RedirectPortName
-	Opts.Trap = params.Attr.Opts.Trap
-	Opts.Record = params.Attr.Opts.Record
-	Opts.Mark = uint32(params.Attr.Opts.FwMark)
-	Opts.DoSnat = params.Attr.Opts.DoSnat
-	Opts.ToIP = params.Attr.Opts.ToIP
-	Opts.ToPort = uint16(params.Attr.Opts.ToPort)
-
-	FW.Rule = Rules
-	FW.Opts = Opts
+	FW := fwRuleModFromEntry(params.Attr)
 	fmt.Printf("FW: %v\n", FW)
 	_, err := ApiHooks.NetFwRuleAdd(&FW)
 	if err != nil {
@@ -163,6 +130,10 @@ func ConfigGetFW(params operations.GetConfigFirewallAllParams) middleware.Respon
 		tmpOpts.ToIP = FW.Opts.ToIP
 		tmpOpts.ToPort = int64(FW.Opts.ToPort)
 		tmpOpts.Counter = FW.Opts.Counter
+		tmpOpts.Expiry = FW.Opts.Expiry
+		tmpOpts.Remaining = int64(FW.Opts.Remaining)
+		tmpOpts.Schedule = FW.Opts.Schedule
+		tmpOpts.ScheduleState = FW.Opts.SchedState
 
 		tmpResult.RuleArguments = &tmpRule
 		tmpResult.Opts = &tmpOpts
@@ -171,3 +142,182 @@ func ConfigGetFW(params operations.GetConfigFirewallAllParams) middleware.Respon
 	}
 	return operations.NewGetConfigFirewallAllOK().WithPayload(&operations.GetConfigFirewallAllOKBody{FwAttr: result})
 }
+
+// FwEventQueueLen - Number of events queued for a slow event stream client
+const FwEventQueueLen = 64
+
+func ConfigGetFWEvents(params operations.GetConfigFirewallEventsParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall events %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	evCh := make(chan cmn.FwRuleEvent, FwEventQueueLen)
+	_, err := ApiHooks.NetFwRuleEventSub(evCh)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+
+	unSub := func() { ApiHooks.NetFwRuleEventUnSub(evCh) }
+	return EventStream(params.HTTPRequest, "Firewall events", evCh, unSub,
+		func(ev cmn.FwRuleEvent) (string, interface{}) {
+			return ev.Kind, &models.FirewallEvent{
+				Timestamp: ev.Time.Format(time.RFC3339),
+				Kind:      ev.Kind,
+				Name:      ev.Name,
+			}
+		})
+}
+
+func ConfigPostFWLog(params operations.PostConfigFirewallLogParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall log %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var LogCfg cmn.FwLogConfig
+	LogCfg.Sample = uint32(params.Attr.Sample)
+	LogCfg.RateLimit = uint32(params.Attr.RateLimit)
+	LogCfg.File = params.Attr.File
+	LogCfg.FileMaxSize = uint32(params.Attr.FileMaxSize)
+	LogCfg.FileMaxBackups = uint32(params.Attr.FileMaxBackups)
+	LogCfg.Syslog = params.Attr.Syslog
+
+	tk.LogIt(tk.LogDebug, "[API] FwLogConfig : %v\n", LogCfg)
+	_, err := ApiHooks.NetFwLogConfigSet(&LogCfg)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigGetFWLog(params operations.GetConfigFirewallLogParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall log %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	LogCfg, err := ApiHooks.NetFwLogConfigGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+
+	var result models.FirewallLogConfig
+	result.Sample = int64(LogCfg.Sample)
+	result.RateLimit = int64(LogCfg.RateLimit)
+	result.File = LogCfg.File
+	result.FileMaxSize = int64(LogCfg.FileMaxSize)
+	result.FileMaxBackups = int64(LogCfg.FileMaxBackups)
+	result.Syslog = LogCfg.Syslog
+	result.Logged = int64(LogCfg.Logged)
+	result.Dropped = int64(LogCfg.Dropped)
+	result.Sampled = int64(LogCfg.Sampled)
+
+	return operations.NewGetConfigFirewallLogOK().WithPayload(&result)
+}
+
+func ConfigPostFWPolicy(params operations.PostConfigFirewallPolicyParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall policy %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var PolicyMod cmn.FwPolicyMod
+	PolicyMod.Zone = params.Attr.Zone
+	PolicyMod.Policy = params.Attr.Policy
+
+	tk.LogIt(tk.LogDebug, "[API] FwPolicyMod : %v\n", PolicyMod)
+	_, err := ApiHooks.NetFwPolicySet(&PolicyMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigGetFWPolicy(params operations.GetConfigFirewallPolicyParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall policy %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	Policies, err := ApiHooks.NetFwPolicyGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+
+	var result []*models.FirewallPolicyEntry
+	for _, policy := range Policies {
+		result = append(result, &models.FirewallPolicyEntry{Zone: policy.Zone, Policy: policy.Policy})
+	}
+
+	return operations.NewGetConfigFirewallPolicyOK().WithPayload(&operations.GetConfigFirewallPolicyOKBody{FwPolicyAttr: result})
+}
+
+// FwLogQueueLen - Number of log records queued for a slow log stream client
+const FwLogQueueLen = 256
+
+func ConfigGetFWLogStream(params operations.GetConfigFirewallLogStreamParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Firewall log stream %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	recCh := make(chan cmn.FwLogRecord, FwLogQueueLen)
+	_, err := ApiHooks.NetFwLogSub(recCh)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+
+	unSub := func() { ApiHooks.NetFwLogUnSub(recCh) }
+	return EventStream(params.HTTPRequest, "Firewall log", recCh, unSub,
+		func(rec cmn.FwLogRecord) (string, interface{}) {
+			return rec.Action, &models.FirewallLogRecord{
+				Timestamp:       rec.Time.Format(time.RFC3339Nano),
+				RuleID:          int64(rec.RuleID),
+				Preference:      int64(rec.Pref),
+				Rule:            rec.Rule,
+				Proto:           rec.Proto,
+				SourceIP:        rec.SrcIP,
+				DestinationIP:   rec.DstIP,
+				SourcePort:      int64(rec.SrcPort),
+				DestinationPort: int64(rec.DstPort),
+				Port:            rec.Port,
+				Action:          rec.Action,
+			}
+		})
+}
+
+// fwRuleModFromEntry - Convert a firewall entry of the API to cmn.FwRuleMod
+func fwRuleModFromEntry(attr *models.FirewallEntry) cmn.FwRuleMod {
+	Opts := cmn.FwOptArg{}
+	Rules := cmn.FwRuleArg{}
+	FW := cmn.FwRuleMod{}
+	//Body Maker
+	if attr.RuleArguments != nil {
+		Rules.DstIP = attr.RuleArguments.DestinationIP
+		Rules.DstPortMax = uint16(attr.RuleArguments.MaxDestinationPort)
+		Rules.DstPortMin = uint16(attr.RuleArguments.MinDestinationPort)
+		Rules.InPort = attr.RuleArguments.PortName
+		Rules.Pref = uint16(attr.RuleArguments.Preference)
+		Rules.Proto = uint8(attr.RuleArguments.Protocol)
+		Rules.SrcIP = attr.RuleArguments.SourceIP
+		Rules.SrcPortMax = uint16(attr.RuleArguments.MaxSourcePort)
+		Rules.SrcPortMin = uint16(attr.RuleArguments.MinSourcePort)
+	}
+
+	if Rules.DstIP == "" {
+		Rules.DstIP = "0.0.0.0/0"
+	}
+
+	if Rules.SrcIP == "" {
+		Rules.SrcIP = "0.0.0.0/0"
+	}
+	// opts
+	if attr.Opts != nil {
+		Opts.Allow = attr.Opts.Allow
+		Opts.Drop = attr.Opts.Drop
+		Opts.Rdr = attr.Opts.Redirect
+		Opts.RdrPort = attr.Opts.RedirectPortName
+		Opts.Trap = attr.Opts.Trap
+		Opts.Record = attr.Opts.Record
+		Opts.Mark = uint32(attr.Opts.FwMark)
+		Opts.DoSnat = attr.Opts.DoSnat
+		Opts.ToIP = attr.Opts.ToIP
+		Opts.ToPort = uint16(attr.Opts.ToPort)
+		Opts.TTL = uint32(attr.Opts.TTL)
+		Opts.Expiry = attr.Opts.Expiry
+		Opts.Schedule = attr.Opts.Schedule
+	}
+
+	FW.Rule = Rules
+	FW.Opts = Opts
+	return FW
+}
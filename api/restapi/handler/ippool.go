@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func ConfigPostIPPool(params operations.PostConfigIppoolParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var PoolMod cmn.IPPoolMod
+	PoolMod.Name = params.Attr.Name
+	PoolMod.CIDRs = params.Attr.Cidrs
+	PoolMod.Reserved = params.Attr.Reserved
+
+	tk.LogIt(tk.LogDebug, "[API] IPPoolMod : %v\n", PoolMod)
+	_, err := ApiHooks.NetIPPoolAdd(&PoolMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigDeleteIPPool(params operations.DeleteConfigIppoolNameNameParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var PoolMod cmn.IPPoolMod
+	PoolMod.Name = params.Name
+
+	tk.LogIt(tk.LogDebug, "[API] IPPoolMod : %v\n", PoolMod)
+	_, err := ApiHooks.NetIPPoolDel(&PoolMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func vipEntryModel(am cmn.VIPAllocMod) *models.VIPEntry {
+	return &models.VIPEntry{
+		Pool:    am.Pool,
+		Service: am.Service,
+		Family:  am.Family,
+		Ips:     am.IPs,
+	}
+}
+
+func ConfigGetIPPool(params operations.GetConfigIppoolAllParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	pools, err := ApiHooks.NetIPPoolGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	var result []*models.IPPoolGetEntry
+	result = make([]*models.IPPoolGetEntry, 0)
+	for _, pool := range pools {
+		var tmpResult models.IPPoolGetEntry
+		tmpResult.Name = pool.Name
+		tmpResult.Cidrs = pool.CIDRs
+		tmpResult.Reserved = pool.Reserved
+		for _, alloc := range pool.Allocs {
+			tmpResult.Allocs = append(tmpResult.Allocs, vipEntryModel(alloc))
+		}
+		result = append(result, &tmpResult)
+	}
+
+	return operations.NewGetConfigIppoolAllOK().WithPayload(&operations.GetConfigIppoolAllOKBody{IppoolAttr: result})
+}
+
+func ConfigPostIPPoolVIP(params operations.PostConfigIppoolNameNameVipParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var AllocMod cmn.VIPAllocMod
+	AllocMod.Pool = params.Name
+	AllocMod.Service = params.Attr.Service
+	AllocMod.Family = params.Attr.Family
+	AllocMod.IPs = params.Attr.Ips
+
+	tk.LogIt(tk.LogDebug, "[API] VIPAllocMod : %v\n", AllocMod)
+	res, err := ApiHooks.NetVIPAlloc(&AllocMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return operations.NewPostConfigIppoolNameNameVipOK().WithPayload(vipEntryModel(res))
+}
+
+func ConfigDeleteIPPoolVIP(params operations.DeleteConfigIppoolNameNameVipServiceServiceParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var AllocMod cmn.VIPAllocMod
+	AllocMod.Pool = params.Name
+	AllocMod.Service = params.Service
+
+	tk.LogIt(tk.LogDebug, "[API] VIPAllocMod : %v\n", AllocMod)
+	_, err := ApiHooks.NetVIPRelease(&AllocMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
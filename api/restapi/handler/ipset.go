@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func ConfigPostIPSet(params operations.PostConfigIpsetParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var SetMod cmn.IPSetMod
+	SetMod.Name = params.Attr.Name
+	SetMod.CIDRs = params.Attr.Cidrs
+
+	tk.LogIt(tk.LogDebug, "[API] IPSetMod : %s (%d)\n", SetMod.Name, len(SetMod.CIDRs))
+	_, err := ApiHooks.NetIPSetAdd(&SetMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigPutIPSet(params operations.PutConfigIpsetNameNameParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var SetMod cmn.IPSetMod
+	SetMod.Name = params.Name
+	SetMod.CIDRs = params.Attr.Cidrs
+
+	tk.LogIt(tk.LogDebug, "[API] IPSetMod : %s (%d)\n", SetMod.Name, len(SetMod.CIDRs))
+	_, err := ApiHooks.NetIPSetUpdate(&SetMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigDeleteIPSet(params operations.DeleteConfigIpsetNameNameParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var SetMod cmn.IPSetMod
+	SetMod.Name = params.Name
+
+	tk.LogIt(tk.LogDebug, "[API] IPSetMod : %v\n", SetMod)
+	_, err := ApiHooks.NetIPSetDel(&SetMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigGetIPSet(params operations.GetConfigIpsetAllParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	sets, err := ApiHooks.NetIPSetGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	var result []*models.IPSetEntry
+	result = make([]*models.IPSetEntry, 0)
+	for _, set := range sets {
+		var tmpResult models.IPSetEntry
+		tmpResult.Name = set.Name
+		tmpResult.Cidrs = set.CIDRs
+		tmpResult.Refs = int64(set.Refs)
+		result = append(result, &tmpResult)
+	}
+
+	return operations.NewGetConfigIpsetAllOK().WithPayload(&operations.GetConfigIpsetAllOKBody{IpsetAttr: result})
+}
@@ -26,47 +26,10 @@ import (
 func ConfigPostLoadbalancer(params operations.PostConfigLoadbalancerParams) middleware.Responder {
 	tk.LogIt(tk.LogDebug, "[API] Load balancer %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
 
-	var lbRules cmn.LbRuleMod
-
-	lbRules.Serv.ServIP = params.Attr.ServiceArguments.ExternalIP
-	lbRules.Serv.ServPort = uint16(params.Attr.ServiceArguments.Port)
-	lbRules.Serv.Proto = params.Attr.ServiceArguments.Protocol
-	lbRules.Serv.BlockNum = params.Attr.ServiceArguments.Block
-	lbRules.Serv.Sel = cmn.EpSelect(params.Attr.ServiceArguments.Sel)
-	lbRules.Serv.Bgp = params.Attr.ServiceArguments.Bgp
-	lbRules.Serv.Monitor = params.Attr.ServiceArguments.Monitor
-	lbRules.Serv.Mode = cmn.LBMode(params.Attr.ServiceArguments.Mode)
-	lbRules.Serv.Security = cmn.LBSec(params.Attr.ServiceArguments.Security)
-	lbRules.Serv.InactiveTimeout = uint32(params.Attr.ServiceArguments.InactiveTimeOut)
-	lbRules.Serv.Managed = params.Attr.ServiceArguments.Managed
-	lbRules.Serv.ProbeType = params.Attr.ServiceArguments.Probetype
-	lbRules.Serv.ProbePort = params.Attr.ServiceArguments.Probeport
-	lbRules.Serv.ProbeReq = params.Attr.ServiceArguments.Probereq
-	lbRules.Serv.ProbeResp = params.Attr.ServiceArguments.Proberesp
-	lbRules.Serv.ProbeTimeout = params.Attr.ServiceArguments.ProbeTimeout
-	lbRules.Serv.ProbeRetries = int(params.Attr.ServiceArguments.ProbeRetries)
-	lbRules.Serv.Name = params.Attr.ServiceArguments.Name
-	lbRules.Serv.Oper = cmn.LBOp(params.Attr.ServiceArguments.Oper)
-	lbRules.Serv.HostUrl = params.Attr.ServiceArguments.Host
-
-	if lbRules.Serv.Proto == "sctp" {
-		for _, data := range params.Attr.SecondaryIPs {
-			lbRules.SecIPs = append(lbRules.SecIPs, cmn.LbSecIPArg{
-				SecIP: data.SecondaryIP,
-			})
-		}
-	}
-
-	for _, data := range params.Attr.Endpoints {
-		lbRules.Eps = append(lbRules.Eps, cmn.LbEndPointArg{
-			EpIP:   data.EndpointIP,
-			EpPort: uint16(data.TargetPort),
-			Weight: uint8(data.Weight),
-		})
-	}
+	lbRules := lbRuleModFromEntry(params.Attr)
 
-	if lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash {
-		return &ResultResponse{Result: "Error: Only Hash Selection criteria allowed for DSR mode"}
+	if lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash && lbRules.Serv.Sel != cmn.LbSelMaglev {
+		return &ResultResponse{Result: "Error: Only Hash or Maglev Selection criteria allowed for DSR mode"}
 	}
 
 	tk.LogIt(tk.LogDebug, "[API] lbRules : %v\n", lbRules)
@@ -153,6 +116,7 @@ func ConfigGetLoadbalancer(params operations.GetConfigLoadbalancerAllParams) mid
 		tmpSvc.ExternalIP = lb.Serv.ServIP
 		tmpSvc.Bgp = lb.Serv.Bgp
 		tmpSvc.Port = int64(lb.Serv.ServPort)
+		tmpSvc.PortMax = int64(lb.Serv.ServPortMax)
 		tmpSvc.Protocol = lb.Serv.Proto
 		tmpSvc.Block = uint16(lb.Serv.BlockNum)
 		tmpSvc.Sel = int64(lb.Serv.Sel)
@@ -163,6 +127,29 @@ func ConfigGetLoadbalancer(params operations.GetConfigLoadbalancerAllParams) mid
 		tmpSvc.Managed = lb.Serv.Managed
 		tmpSvc.Probetype = lb.Serv.ProbeType
 		tmpSvc.Probeport = lb.Serv.ProbePort
+		tmpSvc.ProbeTimeout = lb.Serv.ProbeTimeout
+		tmpSvc.ProbeInterval = lb.Serv.ProbeInterval
+		tmpSvc.ProbeRetries = int32(lb.Serv.ProbeRetries)
+		tmpSvc.ProbeActRetries = int32(lb.Serv.ProbeActRetries)
+		tmpSvc.ProbeJitter = lb.Serv.ProbeJitter
+		tmpSvc.ProbeMethod = lb.Serv.ProbeMethod
+		tmpSvc.ProbeHost = lb.Serv.ProbeHost
+		tmpSvc.ProbeHeaders = lb.Serv.ProbeHeaders
+		tmpSvc.ProbeRespCodes = intsToInt64s(lb.Serv.ProbeRespCodes)
+		tmpSvc.ProbeRespRegex = lb.Serv.ProbeRespRegex
+		tmpSvc.OdFailRatio = lb.Serv.OdFailRatio
+		tmpSvc.OdEjectTime = lb.Serv.OdEjectTime
+		tmpSvc.OdMaxEjectPct = lb.Serv.OdMaxEjectPct
+		tmpSvc.SlowStart = lb.Serv.SlowStart
+		tmpSvc.SlowStartFloor = lb.Serv.SlowStartFloor
+		tmpSvc.SlowStartCurve = lb.Serv.SlowStartCurve
+		tmpSvc.DrainTimeout = lb.Serv.DrainTimeout
+		tmpSvc.SourceRanges = lb.Serv.SrcRanges
+		tmpSvc.MaxConns = lb.Serv.MaxConns
+		tmpSvc.MaxConnsPerEndpoint = lb.Serv.MaxConnsPerEp
+		tmpSvc.NewConnRate = lb.Serv.NewConnRate
+		tmpSvc.RejectedConns = lb.Serv.RejectedConns
+		tmpSvc.SourceRangeDrops = lb.Serv.SrcRangeDrops
 		tmpSvc.Name = lb.Serv.Name
 		tmpSvc.Snat = lb.Serv.Snat
 		tmpSvc.Host = lb.Serv.HostUrl
@@ -181,8 +168,10 @@ func ConfigGetLoadbalancer(params operations.GetConfigLoadbalancerAllParams) mid
 			tmpEp.EndpointIP = ep.EpIP
 			tmpEp.TargetPort = int64(ep.EpPort)
 			tmpEp.Weight = int64(ep.Weight)
+			tmpEp.CurrWeight = int64(ep.CurrWeight)
 			tmpEp.State = ep.State
 			tmpEp.Counter = ep.Counters
+			tmpEp.ActiveConns = int64(ep.ActConns)
 			tmpLB.Endpoints = append(tmpLB.Endpoints, tmpEp)
 		}
 
@@ -211,6 +200,27 @@ func ConfigDeleteAllLoadbalancer(params operations.DeleteConfigLoadbalancerAllPa
 	return &ResultResponse{Result: "Success"}
 }
 
+func ConfigPutAllLoadbalancer(params operations.PutConfigLoadbalancerAllParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Load balancer %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var lbRules []cmn.LbRuleMod
+	for _, data := range params.Attr.LbAttr {
+		lbRule := lbRuleModFromEntry(data)
+		if lbRule.Serv.Mode == cmn.LBModeDSR && lbRule.Serv.Sel != cmn.LbSelHash && lbRule.Serv.Sel != cmn.LbSelMaglev {
+			return &ResultResponse{Result: "Error: Only Hash or Maglev Selection criteria allowed for DSR mode"}
+		}
+		lbRules = append(lbRules, lbRule)
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	res, err := ApiHooks.NetLbRuleReconcile(lbRules, dryRun)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return operations.NewPutConfigLoadbalancerAllOK().WithPayload(txnResultModel(res))
+}
+
 func ConfigDeleteLoadbalancerByName(params operations.DeleteConfigLoadbalancerNameLbNameParams) middleware.Responder {
 	tk.LogIt(tk.LogDebug, "[API] Load balancer %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
 
@@ -234,3 +244,72 @@ func ConfigDeleteLoadbalancerByName(params operations.DeleteConfigLoadbalancerNa
 
 	return &ResultResponse{Result: "Success"}
 }
+
+// lbRuleModFromEntry - Convert a load balancer entry of the API to cmn.LbRuleMod
+func lbRuleModFromEntry(attr *models.LoadbalanceEntry) cmn.LbRuleMod {
+	var lbRules cmn.LbRuleMod
+
+	if attr.ServiceArguments == nil {
+		return lbRules
+	}
+
+	lbRules.Serv.ServIP = attr.ServiceArguments.ExternalIP
+	lbRules.Serv.ServPort = uint16(attr.ServiceArguments.Port)
+	lbRules.Serv.ServPortMax = uint16(attr.ServiceArguments.PortMax)
+	lbRules.Serv.ServPortMask = uint16(attr.ServiceArguments.PortMask)
+	lbRules.Serv.Proto = attr.ServiceArguments.Protocol
+	lbRules.Serv.BlockNum = attr.ServiceArguments.Block
+	lbRules.Serv.Sel = cmn.EpSelect(attr.ServiceArguments.Sel)
+	lbRules.Serv.Bgp = attr.ServiceArguments.Bgp
+	lbRules.Serv.Monitor = attr.ServiceArguments.Monitor
+	lbRules.Serv.Mode = cmn.LBMode(attr.ServiceArguments.Mode)
+	lbRules.Serv.Security = cmn.LBSec(attr.ServiceArguments.Security)
+	lbRules.Serv.InactiveTimeout = uint32(attr.ServiceArguments.InactiveTimeOut)
+	lbRules.Serv.Managed = attr.ServiceArguments.Managed
+	lbRules.Serv.ProbeType = attr.ServiceArguments.Probetype
+	lbRules.Serv.ProbePort = attr.ServiceArguments.Probeport
+	lbRules.Serv.ProbeReq = attr.ServiceArguments.Probereq
+	lbRules.Serv.ProbeResp = attr.ServiceArguments.Proberesp
+	lbRules.Serv.ProbeTimeout = attr.ServiceArguments.ProbeTimeout
+	lbRules.Serv.ProbeInterval = attr.ServiceArguments.ProbeInterval
+	lbRules.Serv.ProbeRetries = int(attr.ServiceArguments.ProbeRetries)
+	lbRules.Serv.ProbeActRetries = int(attr.ServiceArguments.ProbeActRetries)
+	lbRules.Serv.ProbeJitter = attr.ServiceArguments.ProbeJitter
+	lbRules.Serv.ProbeMethod = attr.ServiceArguments.ProbeMethod
+	lbRules.Serv.ProbeHost = attr.ServiceArguments.ProbeHost
+	lbRules.Serv.ProbeHeaders = attr.ServiceArguments.ProbeHeaders
+	lbRules.Serv.ProbeRespCodes = int64sToInts(attr.ServiceArguments.ProbeRespCodes)
+	lbRules.Serv.ProbeRespRegex = attr.ServiceArguments.ProbeRespRegex
+	lbRules.Serv.OdFailRatio = attr.ServiceArguments.OdFailRatio
+	lbRules.Serv.OdEjectTime = attr.ServiceArguments.OdEjectTime
+	lbRules.Serv.OdMaxEjectPct = attr.ServiceArguments.OdMaxEjectPct
+	lbRules.Serv.SlowStart = attr.ServiceArguments.SlowStart
+	lbRules.Serv.SlowStartFloor = attr.ServiceArguments.SlowStartFloor
+	lbRules.Serv.SlowStartCurve = attr.ServiceArguments.SlowStartCurve
+	lbRules.Serv.DrainTimeout = attr.ServiceArguments.DrainTimeout
+	lbRules.Serv.SrcRanges = attr.ServiceArguments.SourceRanges
+	lbRules.Serv.MaxConns = attr.ServiceArguments.MaxConns
+	lbRules.Serv.MaxConnsPerEp = attr.ServiceArguments.MaxConnsPerEndpoint
+	lbRules.Serv.NewConnRate = attr.ServiceArguments.NewConnRate
+	lbRules.Serv.Name = attr.ServiceArguments.Name
+	lbRules.Serv.Oper = cmn.LBOp(attr.ServiceArguments.Oper)
+	lbRules.Serv.HostUrl = attr.ServiceArguments.Host
+
+	if lbRules.Serv.Proto == "sctp" {
+		for _, data := range attr.SecondaryIPs {
+			lbRules.SecIPs = append(lbRules.SecIPs, cmn.LbSecIPArg{
+				SecIP: data.SecondaryIP,
+			})
+		}
+	}
+
+	for _, data := range attr.Endpoints {
+		lbRules.Eps = append(lbRules.Eps, cmn.LbEndPointArg{
+			EpIP:   data.EndpointIP,
+			EpPort: uint16(data.TargetPort),
+			Weight: uint8(data.Weight),
+		})
+	}
+
+	return lbRules
+}
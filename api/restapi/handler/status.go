@@ -17,6 +17,8 @@ package handler
 
 import (
 	"github.com/loxilb-io/loxilb/api/apiutils/status"
+	"github.com/loxilb-io/loxilb/api/loxinlp"
+	"github.com/loxilb-io/loxilb/api/models"
 	"github.com/loxilb-io/loxilb/api/restapi/operations"
 	tk "github.com/loxilb-io/loxilib"
 
@@ -51,3 +53,23 @@ func ConfigGetFileSystem(params operations.GetStatusFilesystemParams) middleware
 	}
 	return operations.NewGetStatusFilesystemOK().WithPayload(&operations.GetStatusFilesystemOKBody{FilesystemAttr: res})
 }
+
+func ConfigGetIPConfigStatus(params operations.GetStatusIpconfigParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Status %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+	var result []*models.IPConfigStatusEntry
+	result = make([]*models.IPConfigStatusEntry, 0)
+
+	for _, st := range loxinlp.GetIPConfigStatusNoHook() {
+		var tmpResult models.IPConfigStatusEntry
+		tmpResult.Dev = st.Dev
+		tmpResult.Status = st.Status
+		tmpResult.LinkUp = st.LinkUp
+		tmpResult.Applied = int64(st.Applied)
+		tmpResult.Pending = int64(st.Pending)
+		tmpResult.Attempts = int64(st.Attempts)
+		tmpResult.LastError = st.LastError
+		result = append(result, &tmpResult)
+	}
+
+	return operations.NewGetStatusIpconfigOK().WithPayload(&operations.GetStatusIpconfigOKBody{IpconfigAttr: result})
+}
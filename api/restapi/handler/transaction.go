@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+func ConfigPostTransaction(params operations.PostConfigTransactionParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Transaction %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var txn cmn.ConfigTxnMod
+
+	txn.Generation = params.Attr.Generation
+	for _, data := range params.Attr.LbRules {
+		lbRules := lbRuleModFromEntry(data)
+		if lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash && lbRules.Serv.Sel != cmn.LbSelMaglev {
+			return &ResultResponse{Result: "Error: Only Hash or Maglev Selection criteria allowed for DSR mode"}
+		}
+		txn.LbRules = append(txn.LbRules, lbRules)
+	}
+	for _, data := range params.Attr.LbRulesDel {
+		txn.LbRulesDel = append(txn.LbRulesDel, lbRuleModFromEntry(data))
+	}
+	for _, data := range params.Attr.FwRules {
+		txn.FwRules = append(txn.FwRules, fwRuleModFromEntry(data))
+	}
+	for _, data := range params.Attr.FwRulesDel {
+		txn.FwRulesDel = append(txn.FwRulesDel, fwRuleModFromEntry(data))
+	}
+	for _, data := range params.Attr.EndPoints {
+		txn.EndPoints = append(txn.EndPoints, epModFromEntry(data))
+	}
+
+	res, err := ApiHooks.NetConfigTxnCommit(&txn)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return operations.NewPostConfigTransactionOK().WithPayload(txnResultModel(res))
+}
+
+func ConfigGetTransaction(params operations.GetConfigTransactionParams) middleware.Responder {
+	tk.LogIt(tk.LogDebug, "[API] Transaction %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	res, err := ApiHooks.NetConfigTxnGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "[API] Error occur : %v\n", err)
+		return &ResultResponse{Result: err.Error()}
+	}
+	return operations.NewGetConfigTransactionOK().WithPayload(txnResultModel(res))
+}
+
+// txnResultModel - Convert the result of a config transaction to its API model
+func txnResultModel(res cmn.ConfigTxnResult) *models.ConfigTransactionResult {
+	return &models.ConfigTransactionResult{
+		Generation: res.Generation,
+		Added:      res.Added,
+		Updated:    res.Updated,
+		Deleted:    res.Deleted,
+	}
+}
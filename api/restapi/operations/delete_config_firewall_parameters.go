@@ -32,7 +32,7 @@ type DeleteConfigFirewallParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*Destination IP in CIDR notation
+	/*Destination IP in CIDR notation or name of an ip set (only IPv4 members of an ip set are used by eBPF datapath)
 	  In: query
 	*/
 	DestinationIP *string
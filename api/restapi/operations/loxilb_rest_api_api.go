@@ -66,6 +66,15 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		DeleteConfigFirewallHandler: DeleteConfigFirewallHandlerFunc(func(params DeleteConfigFirewallParams) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteConfigFirewall has not yet been implemented")
 		}),
+		DeleteConfigIppoolNameNameHandler: DeleteConfigIppoolNameNameHandlerFunc(func(params DeleteConfigIppoolNameNameParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteConfigIppoolNameName has not yet been implemented")
+		}),
+		DeleteConfigIppoolNameNameVipServiceServiceHandler: DeleteConfigIppoolNameNameVipServiceServiceHandlerFunc(func(params DeleteConfigIppoolNameNameVipServiceServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteConfigIppoolNameNameVipServiceService has not yet been implemented")
+		}),
+		DeleteConfigIpsetNameNameHandler: DeleteConfigIpsetNameNameHandlerFunc(func(params DeleteConfigIpsetNameNameParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteConfigIpsetNameName has not yet been implemented")
+		}),
 		DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler: DeleteConfigIpv4addressIPAddressMaskDevIfNameHandlerFunc(func(params DeleteConfigIpv4addressIPAddressMaskDevIfNameParams) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteConfigIpv4addressIPAddressMaskDevIfName has not yet been implemented")
 		}),
@@ -132,12 +141,33 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		GetConfigEndpointAllHandler: GetConfigEndpointAllHandlerFunc(func(params GetConfigEndpointAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigEndpointAll has not yet been implemented")
 		}),
+		GetConfigEndpointEventsHandler: GetConfigEndpointEventsHandlerFunc(func(params GetConfigEndpointEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigEndpointEvents has not yet been implemented")
+		}),
 		GetConfigFdbAllHandler: GetConfigFdbAllHandlerFunc(func(params GetConfigFdbAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigFdbAll has not yet been implemented")
 		}),
 		GetConfigFirewallAllHandler: GetConfigFirewallAllHandlerFunc(func(params GetConfigFirewallAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigFirewallAll has not yet been implemented")
 		}),
+		GetConfigFirewallEventsHandler: GetConfigFirewallEventsHandlerFunc(func(params GetConfigFirewallEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigFirewallEvents has not yet been implemented")
+		}),
+		GetConfigFirewallLogHandler: GetConfigFirewallLogHandlerFunc(func(params GetConfigFirewallLogParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigFirewallLog has not yet been implemented")
+		}),
+		GetConfigFirewallLogStreamHandler: GetConfigFirewallLogStreamHandlerFunc(func(params GetConfigFirewallLogStreamParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigFirewallLogStream has not yet been implemented")
+		}),
+		GetConfigFirewallPolicyHandler: GetConfigFirewallPolicyHandlerFunc(func(params GetConfigFirewallPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigFirewallPolicy has not yet been implemented")
+		}),
+		GetConfigIppoolAllHandler: GetConfigIppoolAllHandlerFunc(func(params GetConfigIppoolAllParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigIppoolAll has not yet been implemented")
+		}),
+		GetConfigIpsetAllHandler: GetConfigIpsetAllHandlerFunc(func(params GetConfigIpsetAllParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigIpsetAll has not yet been implemented")
+		}),
 		GetConfigIpv4addressAllHandler: GetConfigIpv4addressAllHandlerFunc(func(params GetConfigIpv4addressAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigIpv4addressAll has not yet been implemented")
 		}),
@@ -168,6 +198,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		GetConfigSessionulclAllHandler: GetConfigSessionulclAllHandlerFunc(func(params GetConfigSessionulclAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigSessionulclAll has not yet been implemented")
 		}),
+		GetConfigTransactionHandler: GetConfigTransactionHandlerFunc(func(params GetConfigTransactionParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigTransaction has not yet been implemented")
+		}),
 		GetConfigTunnelVxlanAllHandler: GetConfigTunnelVxlanAllHandlerFunc(func(params GetConfigTunnelVxlanAllParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigTunnelVxlanAll has not yet been implemented")
 		}),
@@ -183,6 +216,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		GetStatusFilesystemHandler: GetStatusFilesystemHandlerFunc(func(params GetStatusFilesystemParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetStatusFilesystem has not yet been implemented")
 		}),
+		GetStatusIpconfigHandler: GetStatusIpconfigHandlerFunc(func(params GetStatusIpconfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetStatusIpconfig has not yet been implemented")
+		}),
 		GetStatusProcessHandler: GetStatusProcessHandlerFunc(func(params GetStatusProcessParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetStatusProcess has not yet been implemented")
 		}),
@@ -216,6 +252,21 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		PostConfigFirewallHandler: PostConfigFirewallHandlerFunc(func(params PostConfigFirewallParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigFirewall has not yet been implemented")
 		}),
+		PostConfigFirewallLogHandler: PostConfigFirewallLogHandlerFunc(func(params PostConfigFirewallLogParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigFirewallLog has not yet been implemented")
+		}),
+		PostConfigFirewallPolicyHandler: PostConfigFirewallPolicyHandlerFunc(func(params PostConfigFirewallPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigFirewallPolicy has not yet been implemented")
+		}),
+		PostConfigIppoolHandler: PostConfigIppoolHandlerFunc(func(params PostConfigIppoolParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigIppool has not yet been implemented")
+		}),
+		PostConfigIppoolNameNameVipHandler: PostConfigIppoolNameNameVipHandlerFunc(func(params PostConfigIppoolNameNameVipParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigIppoolNameNameVip has not yet been implemented")
+		}),
+		PostConfigIpsetHandler: PostConfigIpsetHandlerFunc(func(params PostConfigIpsetParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigIpset has not yet been implemented")
+		}),
 		PostConfigIpv4addressHandler: PostConfigIpv4addressHandlerFunc(func(params PostConfigIpv4addressParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigIpv4address has not yet been implemented")
 		}),
@@ -243,6 +294,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		PostConfigSessionulclHandler: PostConfigSessionulclHandlerFunc(func(params PostConfigSessionulclParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigSessionulcl has not yet been implemented")
 		}),
+		PostConfigTransactionHandler: PostConfigTransactionHandlerFunc(func(params PostConfigTransactionParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigTransaction has not yet been implemented")
+		}),
 		PostConfigTunnelVxlanHandler: PostConfigTunnelVxlanHandlerFunc(func(params PostConfigTunnelVxlanParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigTunnelVxlan has not yet been implemented")
 		}),
@@ -255,6 +309,12 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		PostConfigVlanVlanIDMemberHandler: PostConfigVlanVlanIDMemberHandlerFunc(func(params PostConfigVlanVlanIDMemberParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigVlanVlanIDMember has not yet been implemented")
 		}),
+		PutConfigIpsetNameNameHandler: PutConfigIpsetNameNameHandlerFunc(func(params PutConfigIpsetNameNameParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutConfigIpsetNameName has not yet been implemented")
+		}),
+		PutConfigLoadbalancerAllHandler: PutConfigLoadbalancerAllHandlerFunc(func(params PutConfigLoadbalancerAllParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutConfigLoadbalancerAll has not yet been implemented")
+		}),
 	}
 }
 
@@ -307,6 +367,12 @@ type LoxilbRestAPIAPI struct {
 	DeleteConfigFdbMacAddressDevIfNameHandler DeleteConfigFdbMacAddressDevIfNameHandler
 	// DeleteConfigFirewallHandler sets the operation handler for the delete config firewall operation
 	DeleteConfigFirewallHandler DeleteConfigFirewallHandler
+	// DeleteConfigIppoolNameNameHandler sets the operation handler for the delete config ippool name name operation
+	DeleteConfigIppoolNameNameHandler DeleteConfigIppoolNameNameHandler
+	// DeleteConfigIppoolNameNameVipServiceServiceHandler sets the operation handler for the delete config ippool name name vip service service operation
+	DeleteConfigIppoolNameNameVipServiceServiceHandler DeleteConfigIppoolNameNameVipServiceServiceHandler
+	// DeleteConfigIpsetNameNameHandler sets the operation handler for the delete config ipset name name operation
+	DeleteConfigIpsetNameNameHandler DeleteConfigIpsetNameNameHandler
 	// DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler sets the operation handler for the delete config ipv4address IP address mask dev if name operation
 	DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler
 	// DeleteConfigLoadbalancerAllHandler sets the operation handler for the delete config loadbalancer all operation
@@ -351,10 +417,24 @@ type LoxilbRestAPIAPI struct {
 	GetConfigConntrackAllHandler GetConfigConntrackAllHandler
 	// GetConfigEndpointAllHandler sets the operation handler for the get config endpoint all operation
 	GetConfigEndpointAllHandler GetConfigEndpointAllHandler
+	// GetConfigEndpointEventsHandler sets the operation handler for the get config endpoint events operation
+	GetConfigEndpointEventsHandler GetConfigEndpointEventsHandler
 	// GetConfigFdbAllHandler sets the operation handler for the get config fdb all operation
 	GetConfigFdbAllHandler GetConfigFdbAllHandler
 	// GetConfigFirewallAllHandler sets the operation handler for the get config firewall all operation
 	GetConfigFirewallAllHandler GetConfigFirewallAllHandler
+	// GetConfigFirewallEventsHandler sets the operation handler for the get config firewall events operation
+	GetConfigFirewallEventsHandler GetConfigFirewallEventsHandler
+	// GetConfigFirewallLogHandler sets the operation handler for the get config firewall log operation
+	GetConfigFirewallLogHandler GetConfigFirewallLogHandler
+	// GetConfigFirewallLogStreamHandler sets the operation handler for the get config firewall log stream operation
+	GetConfigFirewallLogStreamHandler GetConfigFirewallLogStreamHandler
+	// GetConfigFirewallPolicyHandler sets the operation handler for the get config firewall policy operation
+	GetConfigFirewallPolicyHandler GetConfigFirewallPolicyHandler
+	// GetConfigIppoolAllHandler sets the operation handler for the get config ippool all operation
+	GetConfigIppoolAllHandler GetConfigIppoolAllHandler
+	// GetConfigIpsetAllHandler sets the operation handler for the get config ipset all operation
+	GetConfigIpsetAllHandler GetConfigIpsetAllHandler
 	// GetConfigIpv4addressAllHandler sets the operation handler for the get config ipv4address all operation
 	GetConfigIpv4addressAllHandler GetConfigIpv4addressAllHandler
 	// GetConfigLoadbalancerAllHandler sets the operation handler for the get config loadbalancer all operation
@@ -375,6 +455,8 @@ type LoxilbRestAPIAPI struct {
 	GetConfigSessionAllHandler GetConfigSessionAllHandler
 	// GetConfigSessionulclAllHandler sets the operation handler for the get config sessionulcl all operation
 	GetConfigSessionulclAllHandler GetConfigSessionulclAllHandler
+	// GetConfigTransactionHandler sets the operation handler for the get config transaction operation
+	GetConfigTransactionHandler GetConfigTransactionHandler
 	// GetConfigTunnelVxlanAllHandler sets the operation handler for the get config tunnel vxlan all operation
 	GetConfigTunnelVxlanAllHandler GetConfigTunnelVxlanAllHandler
 	// GetConfigVlanAllHandler sets the operation handler for the get config vlan all operation
@@ -385,6 +467,8 @@ type LoxilbRestAPIAPI struct {
 	GetStatusDeviceHandler GetStatusDeviceHandler
 	// GetStatusFilesystemHandler sets the operation handler for the get status filesystem operation
 	GetStatusFilesystemHandler GetStatusFilesystemHandler
+	// GetStatusIpconfigHandler sets the operation handler for the get status ipconfig operation
+	GetStatusIpconfigHandler GetStatusIpconfigHandler
 	// GetStatusProcessHandler sets the operation handler for the get status process operation
 	GetStatusProcessHandler GetStatusProcessHandler
 	// PostConfigBfdHandler sets the operation handler for the post config bfd operation
@@ -407,6 +491,16 @@ type LoxilbRestAPIAPI struct {
 	PostConfigFdbHandler PostConfigFdbHandler
 	// PostConfigFirewallHandler sets the operation handler for the post config firewall operation
 	PostConfigFirewallHandler PostConfigFirewallHandler
+	// PostConfigFirewallLogHandler sets the operation handler for the post config firewall log operation
+	PostConfigFirewallLogHandler PostConfigFirewallLogHandler
+	// PostConfigFirewallPolicyHandler sets the operation handler for the post config firewall policy operation
+	PostConfigFirewallPolicyHandler PostConfigFirewallPolicyHandler
+	// PostConfigIppoolHandler sets the operation handler for the post config ippool operation
+	PostConfigIppoolHandler PostConfigIppoolHandler
+	// PostConfigIppoolNameNameVipHandler sets the operation handler for the post config ippool name name vip operation
+	PostConfigIppoolNameNameVipHandler PostConfigIppoolNameNameVipHandler
+	// PostConfigIpsetHandler sets the operation handler for the post config ipset operation
+	PostConfigIpsetHandler PostConfigIpsetHandler
 	// PostConfigIpv4addressHandler sets the operation handler for the post config ipv4address operation
 	PostConfigIpv4addressHandler PostConfigIpv4addressHandler
 	// PostConfigLoadbalancerHandler sets the operation handler for the post config loadbalancer operation
@@ -425,6 +519,8 @@ type LoxilbRestAPIAPI struct {
 	PostConfigSessionHandler PostConfigSessionHandler
 	// PostConfigSessionulclHandler sets the operation handler for the post config sessionulcl operation
 	PostConfigSessionulclHandler PostConfigSessionulclHandler
+	// PostConfigTransactionHandler sets the operation handler for the post config transaction operation
+	PostConfigTransactionHandler PostConfigTransactionHandler
 	// PostConfigTunnelVxlanHandler sets the operation handler for the post config tunnel vxlan operation
 	PostConfigTunnelVxlanHandler PostConfigTunnelVxlanHandler
 	// PostConfigTunnelVxlanVxlanIDPeerHandler sets the operation handler for the post config tunnel vxlan vxlan ID peer operation
@@ -433,6 +529,10 @@ type LoxilbRestAPIAPI struct {
 	PostConfigVlanHandler PostConfigVlanHandler
 	// PostConfigVlanVlanIDMemberHandler sets the operation handler for the post config vlan vlan ID member operation
 	PostConfigVlanVlanIDMemberHandler PostConfigVlanVlanIDMemberHandler
+	// PutConfigIpsetNameNameHandler sets the operation handler for the put config ipset name name operation
+	PutConfigIpsetNameNameHandler PutConfigIpsetNameNameHandler
+	// PutConfigLoadbalancerAllHandler sets the operation handler for the put config loadbalancer all operation
+	PutConfigLoadbalancerAllHandler PutConfigLoadbalancerAllHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -534,6 +634,15 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.DeleteConfigFirewallHandler == nil {
 		unregistered = append(unregistered, "DeleteConfigFirewallHandler")
 	}
+	if o.DeleteConfigIppoolNameNameHandler == nil {
+		unregistered = append(unregistered, "DeleteConfigIppoolNameNameHandler")
+	}
+	if o.DeleteConfigIppoolNameNameVipServiceServiceHandler == nil {
+		unregistered = append(unregistered, "DeleteConfigIppoolNameNameVipServiceServiceHandler")
+	}
+	if o.DeleteConfigIpsetNameNameHandler == nil {
+		unregistered = append(unregistered, "DeleteConfigIpsetNameNameHandler")
+	}
 	if o.DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler == nil {
 		unregistered = append(unregistered, "DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler")
 	}
@@ -600,12 +709,33 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.GetConfigEndpointAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigEndpointAllHandler")
 	}
+	if o.GetConfigEndpointEventsHandler == nil {
+		unregistered = append(unregistered, "GetConfigEndpointEventsHandler")
+	}
 	if o.GetConfigFdbAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigFdbAllHandler")
 	}
 	if o.GetConfigFirewallAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigFirewallAllHandler")
 	}
+	if o.GetConfigFirewallEventsHandler == nil {
+		unregistered = append(unregistered, "GetConfigFirewallEventsHandler")
+	}
+	if o.GetConfigFirewallLogHandler == nil {
+		unregistered = append(unregistered, "GetConfigFirewallLogHandler")
+	}
+	if o.GetConfigFirewallLogStreamHandler == nil {
+		unregistered = append(unregistered, "GetConfigFirewallLogStreamHandler")
+	}
+	if o.GetConfigFirewallPolicyHandler == nil {
+		unregistered = append(unregistered, "GetConfigFirewallPolicyHandler")
+	}
+	if o.GetConfigIppoolAllHandler == nil {
+		unregistered = append(unregistered, "GetConfigIppoolAllHandler")
+	}
+	if o.GetConfigIpsetAllHandler == nil {
+		unregistered = append(unregistered, "GetConfigIpsetAllHandler")
+	}
 	if o.GetConfigIpv4addressAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigIpv4addressAllHandler")
 	}
@@ -636,6 +766,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.GetConfigSessionulclAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigSessionulclAllHandler")
 	}
+	if o.GetConfigTransactionHandler == nil {
+		unregistered = append(unregistered, "GetConfigTransactionHandler")
+	}
 	if o.GetConfigTunnelVxlanAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigTunnelVxlanAllHandler")
 	}
@@ -651,6 +784,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.GetStatusFilesystemHandler == nil {
 		unregistered = append(unregistered, "GetStatusFilesystemHandler")
 	}
+	if o.GetStatusIpconfigHandler == nil {
+		unregistered = append(unregistered, "GetStatusIpconfigHandler")
+	}
 	if o.GetStatusProcessHandler == nil {
 		unregistered = append(unregistered, "GetStatusProcessHandler")
 	}
@@ -684,6 +820,21 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.PostConfigFirewallHandler == nil {
 		unregistered = append(unregistered, "PostConfigFirewallHandler")
 	}
+	if o.PostConfigFirewallLogHandler == nil {
+		unregistered = append(unregistered, "PostConfigFirewallLogHandler")
+	}
+	if o.PostConfigFirewallPolicyHandler == nil {
+		unregistered = append(unregistered, "PostConfigFirewallPolicyHandler")
+	}
+	if o.PostConfigIppoolHandler == nil {
+		unregistered = append(unregistered, "PostConfigIppoolHandler")
+	}
+	if o.PostConfigIppoolNameNameVipHandler == nil {
+		unregistered = append(unregistered, "PostConfigIppoolNameNameVipHandler")
+	}
+	if o.PostConfigIpsetHandler == nil {
+		unregistered = append(unregistered, "PostConfigIpsetHandler")
+	}
 	if o.PostConfigIpv4addressHandler == nil {
 		unregistered = append(unregistered, "PostConfigIpv4addressHandler")
 	}
@@ -711,6 +862,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.PostConfigSessionulclHandler == nil {
 		unregistered = append(unregistered, "PostConfigSessionulclHandler")
 	}
+	if o.PostConfigTransactionHandler == nil {
+		unregistered = append(unregistered, "PostConfigTransactionHandler")
+	}
 	if o.PostConfigTunnelVxlanHandler == nil {
 		unregistered = append(unregistered, "PostConfigTunnelVxlanHandler")
 	}
@@ -723,6 +877,12 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.PostConfigVlanVlanIDMemberHandler == nil {
 		unregistered = append(unregistered, "PostConfigVlanVlanIDMemberHandler")
 	}
+	if o.PutConfigIpsetNameNameHandler == nil {
+		unregistered = append(unregistered, "PutConfigIpsetNameNameHandler")
+	}
+	if o.PutConfigLoadbalancerAllHandler == nil {
+		unregistered = append(unregistered, "PutConfigLoadbalancerAllHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -846,6 +1006,18 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/config/ippool/name/{name}"] = NewDeleteConfigIppoolNameName(o.context, o.DeleteConfigIppoolNameNameHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/config/ippool/name/{name}/vip/service/{service}"] = NewDeleteConfigIppoolNameNameVipServiceService(o.context, o.DeleteConfigIppoolNameNameVipServiceServiceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/config/ipset/name/{name}"] = NewDeleteConfigIpsetNameName(o.context, o.DeleteConfigIpsetNameNameHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/config/ipv4address/{ip_address}/{mask}/dev/{if_name}"] = NewDeleteConfigIpv4addressIPAddressMaskDevIfName(o.context, o.DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -934,6 +1106,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config/endpoint/events"] = NewGetConfigEndpointEvents(o.context, o.GetConfigEndpointEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/config/fdb/all"] = NewGetConfigFdbAll(o.context, o.GetConfigFdbAllHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -942,6 +1118,30 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config/firewall/events"] = NewGetConfigFirewallEvents(o.context, o.GetConfigFirewallEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/firewall/log"] = NewGetConfigFirewallLog(o.context, o.GetConfigFirewallLogHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/firewall/log/stream"] = NewGetConfigFirewallLogStream(o.context, o.GetConfigFirewallLogStreamHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/firewall/policy"] = NewGetConfigFirewallPolicy(o.context, o.GetConfigFirewallPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/ippool/all"] = NewGetConfigIppoolAll(o.context, o.GetConfigIppoolAllHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/ipset/all"] = NewGetConfigIpsetAll(o.context, o.GetConfigIpsetAllHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/config/ipv4address/all"] = NewGetConfigIpv4addressAll(o.context, o.GetConfigIpv4addressAllHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -982,6 +1182,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config/transaction"] = NewGetConfigTransaction(o.context, o.GetConfigTransactionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/config/tunnel/vxlan/all"] = NewGetConfigTunnelVxlanAll(o.context, o.GetConfigTunnelVxlanAllHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1002,6 +1206,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/status/ipconfig"] = NewGetStatusIpconfig(o.context, o.GetStatusIpconfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/status/process"] = NewGetStatusProcess(o.context, o.GetStatusProcessHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1046,6 +1254,26 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/firewall/log"] = NewPostConfigFirewallLog(o.context, o.PostConfigFirewallLogHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/firewall/policy"] = NewPostConfigFirewallPolicy(o.context, o.PostConfigFirewallPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/ippool"] = NewPostConfigIppool(o.context, o.PostConfigIppoolHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/ippool/name/{name}/vip"] = NewPostConfigIppoolNameNameVip(o.context, o.PostConfigIppoolNameNameVipHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/ipset"] = NewPostConfigIpset(o.context, o.PostConfigIpsetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/ipv4address"] = NewPostConfigIpv4address(o.context, o.PostConfigIpv4addressHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1082,6 +1310,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/transaction"] = NewPostConfigTransaction(o.context, o.PostConfigTransactionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/tunnel/vxlan"] = NewPostConfigTunnelVxlan(o.context, o.PostConfigTunnelVxlanHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1095,6 +1327,14 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/config/vlan/{vlan_id}/member"] = NewPostConfigVlanVlanIDMember(o.context, o.PostConfigVlanVlanIDMemberHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config/ipset/name/{name}"] = NewPutConfigIpsetNameName(o.context, o.PutConfigIpsetNameNameHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config/loadbalancer/all"] = NewPutConfigLoadbalancerAll(o.context, o.PutConfigLoadbalancerAllHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
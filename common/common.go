@@ -18,6 +18,7 @@ package common
 
 import (
 	"net"
+	"time"
 )
 
 // This file defines the go interface implementation needed to interact with loxinet go module
@@ -410,13 +411,30 @@ type FwOptArg struct {
 	ToPort uint16 `json:"toPort"`
 	// Counter - Traffic counter
 	Counter string `json:"counter"`
+	// TTL - Lifetime of the rule in seconds
+	TTL uint32 `json:"ttl,omitempty"`
+	// Expiry - Time in RFC3339 format at which the rule expires
+	Expiry string `json:"expiry,omitempty"`
+	// Schedule - Recurring active window as "min hour dom mon dow window" e.g "0 9 * * 1-5 8h"
+	Schedule string `json:"schedule,omitempty"`
+	// Remaining - Remaining lifetime of the rule in seconds
+	Remaining uint32 `json:"remaining,omitempty"`
+	// SchedState - State of a scheduled rule, one of FwSchedActive or FwSchedInactive
+	SchedState string `json:"scheduleState,omitempty"`
 }
 
+const (
+	// FwSchedActive - scheduled firewall rule is in its active window
+	FwSchedActive = "active"
+	// FwSchedInactive - scheduled firewall rule is out of its active window
+	FwSchedInactive = "inactive"
+)
+
 // FwRuleArg - Information related to firewall rule
 type FwRuleArg struct {
-	// SrcIP - Source IP in CIDR notation
+	// SrcIP - Source IP in CIDR notation or name of an ip set
 	SrcIP string `json:"sourceIP"`
-	// DstIP - Destination IP in CIDR notation
+	// DstIP - Destination IP in CIDR notation or name of an ip set
 	DstIP string `json:"destinationIP"`
 	// SrcPortMin - Minimum source port range
 	SrcPortMin uint16 `json:"minSourcePort"`
@@ -434,6 +452,91 @@ type FwRuleArg struct {
 	Pref uint16 `json:"preference"`
 }
 
+const (
+	// FwPolicyAccept - accept packets which match no firewall rule
+	FwPolicyAccept = "accept"
+	// FwPolicyDrop - drop packets which match no firewall rule
+	FwPolicyDrop = "drop"
+)
+
+// FwPolicyMod - Info about the policy of a zone for packets which match no firewall rule
+type FwPolicyMod struct {
+	// Zone - Name of the zone, root zone if empty
+	Zone string `json:"zone"`
+	// Policy - One of FwPolicyAccept or FwPolicyDrop
+	Policy string `json:"policy"`
+}
+
+const (
+	// FwEventExpired - firewall rule expired and was deleted
+	FwEventExpired = "fw-expired"
+	// FwEventActive - scheduled firewall rule entered its active window
+	FwEventActive = "fw-active"
+	// FwEventInactive - scheduled firewall rule left its active window
+	FwEventInactive = "fw-inactive"
+)
+
+// FwRuleEvent - Info about a firewall rule event
+type FwRuleEvent struct {
+	// Time - Time of the event
+	Time time.Time `json:"timestamp"`
+	// Kind - Kind of event, one of FwEventExpired, FwEventActive or FwEventInactive
+	Kind string `json:"kind"`
+	// Name - Firewall rule as shown in rule dumps
+	Name string `json:"name"`
+	// Rule - Rule arguments of the firewall rule
+	Rule FwRuleArg `json:"ruleArguments"`
+}
+
+// FwLogConfig - Info about logging of hits on firewall rules marked record
+type FwLogConfig struct {
+	// Sample - Log one in every Sample hits, 0 or 1 logs every hit
+	Sample uint32 `json:"sample"`
+	// RateLimit - Max number of hits logged per second, 0 for no limit
+	RateLimit uint32 `json:"rateLimit"`
+	// File - Path of the JSON lines log file, empty for no file
+	File string `json:"file,omitempty"`
+	// FileMaxSize - Size in MB at which the log file is rotated
+	FileMaxSize uint32 `json:"fileMaxSize,omitempty"`
+	// FileMaxBackups - Number of rotated log files kept
+	FileMaxBackups uint32 `json:"fileMaxBackups,omitempty"`
+	// Syslog - Syslog server as "udp://host:port", "tcp://host:port" or
+	// "local" for the local syslog daemon, empty for no syslog
+	Syslog string `json:"syslog,omitempty"`
+	// Logged - Number of hits logged
+	Logged uint64 `json:"logged,omitempty"`
+	// Dropped - Number of hits not logged due to rate limit or overload
+	Dropped uint64 `json:"dropped,omitempty"`
+	// Sampled - Number of hits skipped by sampling
+	Sampled uint64 `json:"sampled,omitempty"`
+}
+
+// FwLogRecord - Info about a hit on a firewall rule marked record
+type FwLogRecord struct {
+	// Time - Time of the hit
+	Time time.Time `json:"timestamp"`
+	// RuleID - Identifier of the firewall rule
+	RuleID uint32 `json:"ruleID"`
+	// Pref - Preference of the firewall rule
+	Pref uint16 `json:"preference"`
+	// Rule - Firewall rule as shown in rule dumps
+	Rule string `json:"rule,omitempty"`
+	// Proto - Protocol of the packet
+	Proto string `json:"proto"`
+	// SrcIP - Source IP of the packet
+	SrcIP string `json:"sourceIP"`
+	// DstIP - Destination IP of the packet
+	DstIP string `json:"destinationIP"`
+	// SrcPort - Source port of the packet
+	SrcPort uint16 `json:"sourcePort"`
+	// DstPort - Destination port of the packet
+	DstPort uint16 `json:"destinationPort"`
+	// Port - Name of the port the packet came in on
+	Port string `json:"port,omitempty"`
+	// Action - Action taken, one of allow, drop, trap or redirect
+	Action string `json:"action"`
+}
+
 // FwRuleMod - Info related to a firewall entry
 type FwRuleMod struct {
 	// Rule - service argument of type FwRuleArg
@@ -451,16 +554,36 @@ type EndPointMod struct {
 	// InActTries - No. of inactive probes to mark
 	// an end-point inactive
 	InActTries int `json:"inactiveReTries"`
-	// ProbeType - Type of probe : "icmp","connect-tcp", "connect-udp", "connect-sctp", "http", "https"
+	// ActTries - No. of consecutive successful probes to mark
+	// an inactive end-point active again
+	ActTries int `json:"activeReTries"`
+	// ProbeType - Type of probe : "icmp","connect-tcp", "connect-udp", "connect-sctp", "http", "https", "grpc", "grpcs", "exec"
 	ProbeType string `json:"probeType"`
-	// ProbeReq - Request string in case of http probe
+	// ProbeReq - Request string in case of http probe or service name in case of grpc probe
 	ProbeReq string `json:"probeReq"`
 	// ProbeResp - Response string in case of http probe
 	ProbeResp string `json:"probeResp"`
 	// ProbeDuration - How frequently (in seconds) to check activity
 	ProbeDuration uint32 `json:"probeDuration"`
+	// ProbeTimeout - Timeout (in seconds) of each probe. It must be less than ProbeDuration
+	ProbeTimeout uint32 `json:"probeTimeout"`
+	// ProbeJitter - Max random delay (in seconds) added to each probe interval
+	ProbeJitter uint32 `json:"probeJitter"`
 	// ProbePort - Port to probe for connect type
 	ProbePort uint16 `json:"probePort"`
+	// ProbeMethod - Method to use in case of http(s) probe
+	ProbeMethod string `json:"probeMethod"`
+	// ProbeHost - Host header to use in case of http(s) probe
+	ProbeHost string `json:"probeHost"`
+	// ProbeHeaders - Extra headers as "Name:Value" in case of http(s) probe
+	ProbeHeaders []string `json:"probeHeaders"`
+	// ProbeRespCodes - Accepted status codes in case of http(s) probe
+	ProbeRespCodes []int `json:"probeRespCodes"`
+	// ProbeRespRegex - Regex to match response body in case of http(s) probe
+	ProbeRespRegex string `json:"probeRespRegex"`
+	// ProbeCmd - Command to run in case of exec probe. End-point IP and port are
+	// passed in LOXILB_EP_IP and LOXILB_EP_PORT env variables
+	ProbeCmd string `json:"probeCmd"`
 	// MinDelay - Minimum delay in this end-point
 	MinDelay string `json:"minDelay"`
 	// AvgDelay - Average delay in this end-point
@@ -471,6 +594,35 @@ type EndPointMod struct {
 	CurrState string `json:"currState"`
 }
 
+const (
+	// EpEventState - end-point health state changed
+	EpEventState = "ep-state"
+	// EpEventLbSet - effective end-point set of a load-balancer rule changed
+	EpEventLbSet = "lb-ep-set"
+)
+
+// EndPointEvent - Info about an end-point health event
+type EndPointEvent struct {
+	// Time - Time of the event
+	Time time.Time `json:"timestamp"`
+	// Kind - Kind of event, one of EpEventState or EpEventLbSet
+	Kind string `json:"kind"`
+	// HostName - hostname in CIDR
+	HostName string `json:"hostName"`
+	// Name - Endpoint Identifier
+	Name string `json:"name"`
+	// ProbeType - Type of probe used for this end-point
+	ProbeType string `json:"probeType"`
+	// ProbePort - Port used for probing this end-point
+	ProbePort uint16 `json:"probePort"`
+	// CurrState - Current state of this end-point
+	CurrState string `json:"currState"`
+	// LastDelay - Last measured delay of this end-point
+	LastDelay string `json:"lastDelay"`
+	// Rules - Load-balancer rules affected by this event
+	Rules []string `json:"rules"`
+}
+
 // EpSelect - Selection method of load-balancer end-point
 type EpSelect uint
 
@@ -487,6 +639,8 @@ const (
 	LbSelLeastConnections
 	// LbSelN2 - select client based on N2 SCTP interface
 	LbSelN2
+	// LbSelMaglev - select the lb end-points based on maglev consistent hashing
+	LbSelMaglev
 )
 
 // LBMode - Variable to define LB mode
@@ -535,6 +689,12 @@ type LbServiceArg struct {
 	ServIP string `json:"externalIP"`
 	// ServPort - the service port of the load-balancer rule
 	ServPort uint16 `json:"port"`
+	// ServPortMax - the last service port if the rule serves a port range starting at ServPort.
+	// A range can have at most 4096 ports and 256 ports with eBPF datapath
+	ServPortMax uint16 `json:"portMax"`
+	// ServPortMask - mask of the service port if the rule serves a port range. It is
+	// an alternative to ServPortMax and the mask needs to be a prefix
+	ServPortMask uint16 `json:"portMask"`
 	// Proto - the service protocol of the load-balancer rule
 	Proto string `json:"protocol"`
 	// BlockNum - An arbitrary block num to further segregate a service
@@ -555,18 +715,71 @@ type LbServiceArg struct {
 	InactiveTimeout uint32 `json:"inactiveTimeout"`
 	// Managed - This rule is managed by external entity e.g k8s
 	Managed bool `json:"managed"`
-	// ProbeType - Liveness check type for this rule : ping, tcp, udp, sctp, none, http(s)
+	// ProbeType - Liveness check type for this rule : ping, tcp, udp, sctp, none, http(s), grpc(s)
 	ProbeType string `json:"probetype"`
-	// ProbePort - Liveness check port number. Only valid for tcp, udp, sctp, http(s)
+	// ProbePort - Liveness check port number. Only valid for tcp, udp, sctp, http(s), grpc(s)
 	ProbePort uint16 `json:"probeport"`
 	// ProbeReq - Request string for liveness check
 	ProbeReq string `json:"probereq"`
 	// ProbeResp - Response string for liveness check
 	ProbeResp string `json:"proberesp"`
-	// ProbeTimeout - Probe Timeout
+	// ProbeTimeout - Timeout (in seconds) of each probe. It must be less than ProbeInterval
+	// and is taken as ProbeInterval if the latter is not set
 	ProbeTimeout uint32 `json:"probeTimeout"`
+	// ProbeInterval - How frequently (in seconds) to probe end-points. ProbeTimeout is used if not set
+	ProbeInterval uint32 `json:"probeInterval"`
 	// ProbeRetries - Probe Retries
 	ProbeRetries int `json:"probeRetries"`
+	// ProbeActRetries - No. of consecutive successful probes to mark an end-point active
+	ProbeActRetries int `json:"probeActRetries"`
+	// ProbeJitter - Max random delay (in seconds) added to each probe interval
+	ProbeJitter uint32 `json:"probeJitter"`
+	// ProbeMethod - Method to use in case of http(s) probe
+	ProbeMethod string `json:"probeMethod"`
+	// ProbeHost - Host header to use in case of http(s) probe
+	ProbeHost string `json:"probeHost"`
+	// ProbeHeaders - Extra headers as "Name:Value" in case of http(s) probe
+	ProbeHeaders []string `json:"probeHeaders"`
+	// ProbeRespCodes - Accepted status codes in case of http(s) probe
+	ProbeRespCodes []int `json:"probeRespCodes"`
+	// ProbeRespRegex - Regex to match response body in case of http(s) probe
+	ProbeRespRegex string `json:"probeRespRegex"`
+	// OdFailRatio - Failed connection ratio (in percent) of an end-point for it to be
+	// ejected as an outlier. Outlier detection is disabled if zero
+	OdFailRatio uint8 `json:"odFailRatio"`
+	// OdEjectTime - Base ejection time (in seconds) of an outlier end-point
+	OdEjectTime uint32 `json:"odEjectTime"`
+	// OdMaxEjectPct - Max percent of end-points ejected at a time
+	OdMaxEjectPct uint8 `json:"odMaxEjectPct"`
+	// SlowStart - Window (in seconds) over which weight of a newly active end-point
+	// is ramped up. Slow-start is disabled if zero. Only valid with LbSelPrio and LbSelMaglev
+	SlowStart uint32 `json:"slowStart"`
+	// SlowStartFloor - Weight (in percent) of an end-point as slow-start begins
+	SlowStartFloor uint8 `json:"slowStartFloor"`
+	// SlowStartCurve - Curve for ramping up weight : "linear", "quadratic", "sqrt"
+	SlowStartCurve string `json:"slowStartCurve"`
+	// DrainTimeout - Max time (in seconds) for which removed end-points are drained.
+	// Removed end-points are not drained if zero
+	DrainTimeout uint32 `json:"drainTimeout"`
+	// MaxConns - Max concurrent connections to the service. No limit if zero.
+	// With eBPF dp, it is checked against conntrack entries every sync interval
+	MaxConns uint32 `json:"maxConns"`
+	// MaxConnsPerEp - Max concurrent connections to an end-point of the service.
+	// End-points at their limit are skipped for new connections. No limit if zero.
+	// With eBPF dp, it is checked against conntrack entries every sync interval
+	MaxConnsPerEp uint32 `json:"maxConnsPerEndpoint"`
+	// NewConnRate - Max new connections per second to the service. No limit if zero.
+	// Supported only by userspace dp
+	NewConnRate uint32 `json:"newConnRate"`
+	// RejectedConns - Number of new connections rejected due to connection limits.
+	// Counted only by userspace dp
+	RejectedConns uint64 `json:"rejectedConns"`
+	// SrcRanges - Allowed source prefixes in CIDR notation. Traffic to the service
+	// from any other source is dropped. All sources are allowed if empty. The rest
+	// of the address space takes up firewall entries, up to 512 per service
+	SrcRanges []string `json:"sourceRanges"`
+	// SrcRangeDrops - Counter (packets:bytes) of traffic dropped due to SrcRanges
+	SrcRangeDrops string `json:"sourceRangeDrops"`
 	// Name - Service name
 	Name string `json:"name"`
 	// PersistTimeout - Persistence timeout in seconds
@@ -586,10 +799,15 @@ type LbEndPointArg struct {
 	// Weight - weight associated with end-point
 	// Only valid for weighted round-robin selection
 	Weight uint8 `json:"weight"`
+	// CurrWeight - weight currently in effect for the end-point.
+	// This differs from Weight only during slow-start
+	CurrWeight uint8 `json:"currWeight"`
 	// State - current state of the end-point
 	State string `json:"state"`
 	// Counters -  traffic counters of the end-point
 	Counters string `json:"counters"`
+	// ActConns - active connections of the end-point while it is draining
+	ActConns int `json:"activeConns"`
 }
 
 // LbSecIPArg - Secondary IP
@@ -608,6 +826,37 @@ type LbRuleMod struct {
 	Eps []LbEndPointArg `json:"endpoints"`
 }
 
+// ConfigTxnMod - Info related to a bulk configuration transaction. All objects
+// are validated before anything is applied and the whole transaction is rolled
+// back if any of them fails
+type ConfigTxnMod struct {
+	// Generation - config generation this transaction is based on. If non-zero,
+	// the transaction is committed only if it matches the current generation
+	Generation uint64 `json:"generation"`
+	// LbRules - load-balancer rules to be added or updated
+	LbRules []LbRuleMod `json:"lbRules"`
+	// LbRulesDel - load-balancer rules to be deleted
+	LbRulesDel []LbRuleMod `json:"lbRulesDel"`
+	// FwRules - firewall rules to be added or updated
+	FwRules []FwRuleMod `json:"fwRules"`
+	// FwRulesDel - firewall rules to be deleted
+	FwRulesDel []FwRuleMod `json:"fwRulesDel"`
+	// EndPoints - end-points to be added or updated
+	EndPoints []EndPointMod `json:"endPoints"`
+}
+
+// ConfigTxnResult - Info related to the outcome of a configuration transaction
+type ConfigTxnResult struct {
+	// Generation - config generation after the transaction
+	Generation uint64 `json:"generation"`
+	// Added - objects which were added
+	Added []string `json:"added"`
+	// Updated - objects which were updated
+	Updated []string `json:"updated"`
+	// Deleted - objects which were deleted
+	Deleted []string `json:"deleted"`
+}
+
 // CtInfo - Conntrack Information
 type CtInfo struct {
 	// Dip - destination ip address
@@ -994,6 +1243,96 @@ type MirrGetMod struct {
 	Sync DpStatusT
 }
 
+const (
+	// VIPFamilyIPv4 - allocate an IPv4 VIP
+	VIPFamilyIPv4 = "ipv4"
+	// VIPFamilyIPv6 - allocate an IPv6 VIP
+	VIPFamilyIPv6 = "ipv6"
+	// VIPFamilyDual - allocate an IPv4 and an IPv6 VIP
+	VIPFamilyDual = "dual"
+)
+
+// VIPAllocMod - information related to a VIP allocation from an IP pool
+type VIPAllocMod struct {
+	// Pool - name of the IP pool
+	Pool string `json:"pool"`
+	// Service - name of the service which owns the VIPs
+	Service string `json:"service"`
+	// Family - one of VIPFamilyIPv4, VIPFamilyIPv6 or VIPFamilyDual
+	Family string `json:"family,omitempty"`
+	// IPs - allocated VIPs. In a request, specific VIPs to allocate
+	IPs []string `json:"ips,omitempty"`
+}
+
+// IPPoolMod - information related to an IP pool for VIP allocation
+type IPPoolMod struct {
+	// Name - unique name of the pool
+	Name string `json:"name"`
+	// CIDRs - subnets of the pool, at most one IPv4 and one IPv6
+	CIDRs []string `json:"cidrs"`
+	// Reserved - IPs which are only allocated when asked for explicitly
+	Reserved []string `json:"reserved,omitempty"`
+	// Allocs - current VIP allocations from the pool
+	Allocs []VIPAllocMod `json:"allocs,omitempty"`
+}
+
+// IPSetMod - information related to a named ip set used by firewall rules
+type IPSetMod struct {
+	// Name - unique name of the set
+	Name string `json:"name"`
+	// CIDRs - members of the set in CIDR notation, IPv4 and/or IPv6
+	CIDRs []string `json:"cidrs"`
+	// Refs - number of firewall rules using the set
+	Refs int `json:"refs,omitempty"`
+}
+
+// ConfigStateVersion - version of the config state document written by loxilb
+const ConfigStateVersion = 1
+
+// ConfigState - running configuration of loxilb as persisted in the config path
+type ConfigState struct {
+	// Version - version of the document format
+	Version int `json:"version"`
+	// Generation - config generation when the document was written
+	Generation uint64 `json:"generation"`
+	// EndPoints - lb end-points
+	EndPoints []EndPointMod `json:"endPoints,omitempty"`
+	// LbRules - lb rules
+	LbRules []LbRuleMod `json:"lbRules,omitempty"`
+	// FwRules - firewall rules
+	FwRules []FwRuleMod `json:"fwRules,omitempty"`
+	// Sessions - 3gpp user-sessions
+	Sessions []SessionMod `json:"sessions,omitempty"`
+	// UlCls - 3gpp ulcl filters
+	UlCls []SessionUlClMod `json:"ulcls,omitempty"`
+	// Policers - policers
+	Policers []PolMod `json:"policers,omitempty"`
+	// Mirrors - mirrors
+	Mirrors []MirrMod `json:"mirrors,omitempty"`
+	// IPPools - ip pools along with their VIP allocations
+	IPPools []IPPoolMod `json:"ipPools,omitempty"`
+	// IPSets - ip sets used by firewall rules
+	IPSets []IPSetMod `json:"ipSets,omitempty"`
+	// FwLog - logging of hits on firewall rules
+	FwLog *FwLogConfig `json:"fwLog,omitempty"`
+	// FwPolicies - firewall default policies of zones which are not accept
+	FwPolicies []FwPolicyMod `json:"fwPolicies,omitempty"`
+	// BFD - BFD sessions
+	BFD []BFDMod `json:"bfd,omitempty"`
+	// ClusterState - HA state of cluster instances
+	ClusterState []HASMod `json:"clusterState,omitempty"`
+	// BGPGlobal - goBGP global config
+	BGPGlobal *GoBGPGlobalConfig `json:"bgpGlobal,omitempty"`
+	// BGPNeighs - goBGP neighbors
+	BGPNeighs []GoBGPNeighMod `json:"bgpNeighbors,omitempty"`
+	// BGPDefinedSets - goBGP policy defined sets
+	BGPDefinedSets []GoBGPPolicyDefinedSetMod `json:"bgpDefinedSets,omitempty"`
+	// BGPPolicies - goBGP policy definitions
+	BGPPolicies []GoBGPPolicyDefinitionsMod `json:"bgpPolicies,omitempty"`
+	// BGPPolicyApply - goBGP policies applied to neighbors
+	BGPPolicyApply []GoBGPPolicyApply `json:"bgpPolicyApply,omitempty"`
+}
+
 // NetHookInterface - Go interface which needs to be implemented to talk to loxinet module
 type NetHookInterface interface {
 	NetMirrorGet() ([]MirrGetMod, error)
@@ -1021,6 +1360,19 @@ type NetHookInterface interface {
 	NetLbRuleAdd(*LbRuleMod) (int, error)
 	NetLbRuleDel(*LbRuleMod) (int, error)
 	NetLbRuleGet() ([]LbRuleMod, error)
+	NetLbRuleReconcile([]LbRuleMod, bool) (ConfigTxnResult, error)
+	NetConfigTxnCommit(*ConfigTxnMod) (ConfigTxnResult, error)
+	NetConfigTxnGet() (ConfigTxnResult, error)
+	NetConfigRestore() (int, error)
+	NetIPPoolAdd(*IPPoolMod) (int, error)
+	NetIPPoolDel(*IPPoolMod) (int, error)
+	NetIPPoolGet() ([]IPPoolMod, error)
+	NetVIPAlloc(*VIPAllocMod) (VIPAllocMod, error)
+	NetVIPRelease(*VIPAllocMod) (int, error)
+	NetIPSetAdd(*IPSetMod) (int, error)
+	NetIPSetUpdate(*IPSetMod) (int, error)
+	NetIPSetDel(*IPSetMod) (int, error)
+	NetIPSetGet() ([]IPSetMod, error)
 	NetCtInfoGet() ([]CtInfo, error)
 	NetSessionGet() ([]SessionMod, error)
 	NetSessionUlClGet() ([]SessionUlClMod, error)
@@ -1036,9 +1388,19 @@ type NetHookInterface interface {
 	NetFwRuleAdd(*FwRuleMod) (int, error)
 	NetFwRuleDel(*FwRuleMod) (int, error)
 	NetFwRuleGet() ([]FwRuleMod, error)
+	NetFwRuleEventSub(ch chan FwRuleEvent) (int, error)
+	NetFwRuleEventUnSub(ch chan FwRuleEvent) (int, error)
+	NetFwLogConfigSet(*FwLogConfig) (int, error)
+	NetFwLogConfigGet() (FwLogConfig, error)
+	NetFwLogSub(ch chan FwLogRecord) (int, error)
+	NetFwLogUnSub(ch chan FwLogRecord) (int, error)
+	NetFwPolicySet(*FwPolicyMod) (int, error)
+	NetFwPolicyGet() ([]FwPolicyMod, error)
 	NetEpHostAdd(fm *EndPointMod) (int, error)
 	NetEpHostDel(fm *EndPointMod) (int, error)
 	NetEpHostGet() ([]EndPointMod, error)
+	NetEpHostEventSub(ch chan EndPointEvent) (int, error)
+	NetEpHostEventUnSub(ch chan EndPointEvent) (int, error)
 	NetParamSet(param ParamMod) (int, error)
 	NetParamGet(param *ParamMod) (int, error)
 	NetGoBGPNeighGet() ([]GoBGPNeighGetMod, error)
@@ -24,6 +24,7 @@ var Opts struct {
 	Prometheus        bool           `short:"p" long:"prometheus" description:"Run prometheus thread"`
 	CSumDisable       bool           `long:"disable-csum" description:"Disable checksum update(experimental)"`
 	PassiveEPProbe    bool           `long:"passive-probe" description:"Enable passive liveness probes(experimental)"`
+	UserSpaceDp       bool           `long:"userspace-dp" description:"Use pure-go userspace reference datapath(experimental)"`
 	RssEnable         bool           `long:"rss-enable" description:"Enable rss optimization(experimental)"`
 	EgrHooks          bool           `long:"egr-hooks" description:"Enable eBPF egress hooks(experimental)"`
 	BgpPeerMode       bool           `short:"r" long:"peer" description:"Run loxilb with goBGP only, no Datapath"`
@@ -35,5 +36,6 @@ var Opts struct {
 	LocalSockPolicy   bool           `long:"localsockpolicy" description:"support local socket policies (experimental)"`
 	SockMapSupport    bool           `long:"sockmapsupport" description:"Support sockmap based L4 proxying (experimental)"`
 	ConfigPath        string         `long:"config-path" description:"Config file path" default:"/etc/loxilb/"`
+	FwDefaultPolicy   string         `long:"fw-default-policy" description:"Firewall policy for packets matching no rule - accept or drop" default:"accept"`
 	ProxyModeOnly     bool           `long:"proxyonlymode" description:"Run loxilb in proxy mode only, no Datapath"`
 }
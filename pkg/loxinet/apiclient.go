@@ -18,6 +18,7 @@ package loxinet
 
 import (
 	"errors"
+	"sort"
 
 	cmn "github.com/loxilb-io/loxilb/common"
 	tk "github.com/loxilb-io/loxilib"
@@ -51,6 +52,9 @@ func (*NetAPIStruct) NetMirrorAdd(mm *cmn.MirrMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Mirrs.MirrAdd(mm.Ident, mm.Info, mm.Target)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -60,6 +64,9 @@ func (*NetAPIStruct) NetMirrorDel(mm *cmn.MirrMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Mirrs.MirrDelete(mm.Ident)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -333,6 +340,10 @@ func (na *NetAPIStruct) NetLbRuleAdd(lm *cmn.LbRuleMod) (int, error) {
 	defer mh.mtx.Unlock()
 	var ips []string
 	ret, err := mh.zr.Rules.AddNatLbRule(lm.Serv, lm.SecIPs[:], lm.Eps[:])
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	if err == nil && lm.Serv.Bgp {
 		if mh.bgp != nil {
 			ips = append(ips, lm.Serv.ServIP)
@@ -357,6 +368,10 @@ func (na *NetAPIStruct) NetLbRuleDel(lm *cmn.LbRuleMod) (int, error) {
 
 	ips := mh.zr.Rules.GetNatLbRuleSecIPs(lm.Serv)
 	ret, err := mh.zr.Rules.DeleteNatLbRule(lm.Serv)
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	if lm.Serv.Bgp {
 		if mh.bgp != nil {
 			ips = append(ips, lm.Serv.ServIP)
@@ -377,6 +392,180 @@ func (na *NetAPIStruct) NetLbRuleGet() ([]cmn.LbRuleMod, error) {
 	return ret, err
 }
 
+// NetLbRuleReconcile - Make the LB rules in loxinet match the complete set of rules
+// given. With dryRun, only the changes which would be done are returned
+func (na *NetAPIStruct) NetLbRuleReconcile(lms []cmn.LbRuleMod, dryRun bool) (cmn.ConfigTxnResult, error) {
+	if na.BgpPeerMode {
+		return cmn.ConfigTxnResult{}, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	res, _, err := mh.zr.Rules.ReconcileNatLbRules(lms, dryRun)
+	if err == nil && !dryRun {
+		mh.cfgStore.Changed()
+	}
+	return res, err
+}
+
+// NetConfigTxnCommit - Validate and apply a bulk configuration transaction in loxinet
+func (na *NetAPIStruct) NetConfigTxnCommit(tm *cmn.ConfigTxnMod) (cmn.ConfigTxnResult, error) {
+	if na.BgpPeerMode {
+		return cmn.ConfigTxnResult{}, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	res, _, err := mh.zr.Rules.ConfigTxnCommit(tm)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return res, err
+}
+
+// NetConfigTxnGet - Get the current config generation from loxinet
+func (na *NetAPIStruct) NetConfigTxnGet() (cmn.ConfigTxnResult, error) {
+	if na.BgpPeerMode {
+		return cmn.ConfigTxnResult{}, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	return cmn.ConfigTxnResult{Generation: mh.zr.Rules.ConfigGen()}, nil
+}
+
+// NetConfigRestore - Restore the config saved by loxinet
+func (na *NetAPIStruct) NetConfigRestore() (int, error) {
+	return mh.cfgStore.Restore(na)
+}
+
+// NetIPPoolAdd - Add an ip pool for VIP allocation in loxinet
+func (na *NetAPIStruct) NetIPPoolAdd(pm *cmn.IPPoolMod) (int, error) {
+	if na.BgpPeerMode {
+		return IpamErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.IPPoolAdd(*pm)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPPoolDel - Delete an ip pool in loxinet
+func (na *NetAPIStruct) NetIPPoolDel(pm *cmn.IPPoolMod) (int, error) {
+	if na.BgpPeerMode {
+		return IpamErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.IPPoolDelete(pm.Name)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPPoolGet - Get ip pools and their VIP allocations from loxinet
+func (na *NetAPIStruct) NetIPPoolGet() ([]cmn.IPPoolMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.RLock()
+	defer mh.mtx.RUnlock()
+
+	return mh.zr.Ipam.IPPoolGet()
+}
+
+// NetVIPAlloc - Allocate VIPs for a service from an ip pool in loxinet
+func (na *NetAPIStruct) NetVIPAlloc(am *cmn.VIPAllocMod) (cmn.VIPAllocMod, error) {
+	if na.BgpPeerMode {
+		return *am, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, _, err := mh.zr.Ipam.VIPAlloc(*am)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetVIPRelease - Release VIPs of a service to an ip pool in loxinet
+func (na *NetAPIStruct) NetVIPRelease(am *cmn.VIPAllocMod) (int, error) {
+	if na.BgpPeerMode {
+		return IpamErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.VIPRelease(*am)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPSetAdd - Add an ip set in loxinet
+func (na *NetAPIStruct) NetIPSetAdd(sm *cmn.IPSetMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetAdd(*sm)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPSetUpdate - Replace members of an ip set in loxinet
+func (na *NetAPIStruct) NetIPSetUpdate(sm *cmn.IPSetMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetUpdate(*sm)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPSetDel - Delete an ip set in loxinet
+func (na *NetAPIStruct) NetIPSetDel(sm *cmn.IPSetMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetDelete(sm.Name)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetIPSetGet - Get ip sets from loxinet
+func (na *NetAPIStruct) NetIPSetGet() ([]cmn.IPSetMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.RLock()
+	defer mh.mtx.RUnlock()
+
+	return mh.zr.IPSets.IPSetGet()
+}
+
 // NetCtInfoGet - Get connection track info from loxinet
 func (na *NetAPIStruct) NetCtInfoGet() ([]cmn.CtInfo, error) {
 	if na.BgpPeerMode {
@@ -396,6 +585,9 @@ func (na *NetAPIStruct) NetSessionAdd(sm *cmn.SessionMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.SessAdd(sm.Ident, sm.IP, sm.AnTun, sm.CnTun)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -408,6 +600,9 @@ func (na *NetAPIStruct) NetSessionDel(sm *cmn.SessionMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.SessDelete(sm.Ident)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -420,6 +615,9 @@ func (na *NetAPIStruct) NetSessionUlClAdd(sr *cmn.SessionUlClMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.UlClAddCls(sr.Ident, sr.Args)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -432,6 +630,9 @@ func (na *NetAPIStruct) NetSessionUlClDel(sr *cmn.SessionUlClMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.UlClDeleteCls(sr.Ident, sr.Args)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -474,6 +675,9 @@ func (na *NetAPIStruct) NetPolicerAdd(pm *cmn.PolMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Pols.PolAdd(pm.Ident, pm.Info, pm.Target)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -486,6 +690,9 @@ func (na *NetAPIStruct) NetPolicerDel(pm *cmn.PolMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Pols.PolDelete(pm.Ident)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -511,6 +718,7 @@ func (na *NetAPIStruct) NetCIStateMod(hm *cmn.HASMod) (int, error) {
 	if err != nil {
 		return -1, err
 	}
+	mh.cfgStore.Changed()
 
 	return 0, nil
 }
@@ -538,6 +746,7 @@ func (na *NetAPIStruct) NetBFDAdd(bm *cmn.BFDMod) (int, error) {
 	if err != nil {
 		return -1, err
 	}
+	mh.cfgStore.Changed()
 
 	return 0, nil
 }
@@ -554,6 +763,7 @@ func (na *NetAPIStruct) NetBFDDel(bm *cmn.BFDMod) (int, error) {
 	if err != nil {
 		return -1, err
 	}
+	mh.cfgStore.Changed()
 
 	return 0, nil
 }
@@ -567,6 +777,10 @@ func (na *NetAPIStruct) NetFwRuleAdd(fm *cmn.FwRuleMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Rules.AddFwRule(fm.Rule, fm.Opts)
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -579,6 +793,10 @@ func (na *NetAPIStruct) NetFwRuleDel(fm *cmn.FwRuleMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Rules.DeleteFwRule(fm.Rule)
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -591,6 +809,101 @@ func (na *NetAPIStruct) NetFwRuleGet() ([]cmn.FwRuleMod, error) {
 	return ret, err
 }
 
+// NetFwRuleEventSub - Subscribe to firewall rule events from loxinet
+func (na *NetAPIStruct) NetFwRuleEventSub(ch chan cmn.FwRuleEvent) (int, error) {
+	if na.BgpPeerMode {
+		return RuleErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.zr.Rules.FwEventSub(ch)
+	return ret, err
+}
+
+// NetFwRuleEventUnSub - Unsubscribe from firewall rule events
+func (na *NetAPIStruct) NetFwRuleEventUnSub(ch chan cmn.FwRuleEvent) (int, error) {
+	if na.BgpPeerMode {
+		return RuleErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.zr.Rules.FwEventUnSub(ch)
+	return ret, err
+}
+
+// NetFwLogConfigSet - Set the config of firewall hit logging in loxinet
+func (na *NetAPIStruct) NetFwLogConfigSet(fc *cmn.FwLogConfig) (int, error) {
+	if na.BgpPeerMode {
+		return FwLogErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.fwLog.ConfigSet(*fc)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetFwLogConfigGet - Get the config of firewall hit logging from loxinet
+func (na *NetAPIStruct) NetFwLogConfigGet() (cmn.FwLogConfig, error) {
+	if na.BgpPeerMode {
+		return cmn.FwLogConfig{}, errors.New("running in bgp only mode")
+	}
+	return mh.fwLog.ConfigGet(), nil
+}
+
+// NetFwLogSub - Subscribe to firewall hit log records from loxinet
+func (na *NetAPIStruct) NetFwLogSub(ch chan cmn.FwLogRecord) (int, error) {
+	if na.BgpPeerMode {
+		return FwLogErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.fwLog.Sub(ch)
+	return ret, err
+}
+
+// NetFwLogUnSub - Unsubscribe from firewall hit log records
+func (na *NetAPIStruct) NetFwLogUnSub(ch chan cmn.FwLogRecord) (int, error) {
+	if na.BgpPeerMode {
+		return FwLogErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.fwLog.UnSub(ch)
+	return ret, err
+}
+
+// NetFwPolicySet - Set the firewall default policy of a zone in loxinet
+func (na *NetAPIStruct) NetFwPolicySet(pm *cmn.FwPolicyMod) (int, error) {
+	if na.BgpPeerMode {
+		return RuleErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	zn := pm.Zone
+	if zn == "" {
+		zn = RootZone
+	}
+	zone, _ := mh.zn.Zonefind(zn)
+	if zone == nil {
+		return RuleArgsErr, errors.New("no such zone")
+	}
+	ret, err := zone.Rules.SetFwDefaultPolicy(pm.Policy)
+	if err == nil {
+		mh.cfgStore.Changed()
+	}
+	return ret, err
+}
+
+// NetFwPolicyGet - Get the firewall default policies of all zones from loxinet
+func (na *NetAPIStruct) NetFwPolicyGet() ([]cmn.FwPolicyMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.RLock()
+	defer mh.mtx.RUnlock()
+
+	var res []cmn.FwPolicyMod
+	for name, zone := range mh.zn.ZoneMap {
+		res = append(res, cmn.FwPolicyMod{Zone: name, Policy: zone.Rules.FwDefaultPolicy()})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Zone < res[j].Zone })
+	return res, nil
+}
+
 // NetEpHostAdd - Add a LB end-point in loxinet
 func (na *NetAPIStruct) NetEpHostAdd(em *cmn.EndPointMod) (int, error) {
 	if na.BgpPeerMode {
@@ -599,11 +912,12 @@ func (na *NetAPIStruct) NetEpHostAdd(em *cmn.EndPointMod) (int, error) {
 	mh.mtx.Lock()
 	defer mh.mtx.Unlock()
 
-	epArgs := epHostOpts{inActTryThr: em.InActTries, probeType: em.ProbeType,
-		probeReq: em.ProbeReq, probeResp: em.ProbeResp,
-		probeDuration: em.ProbeDuration, probePort: em.ProbePort,
-	}
+	epArgs := epHostOptsFromMod(em)
 	ret, err := mh.zr.Rules.AddEPHost(true, em.HostName, em.Name, epArgs)
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -616,6 +930,10 @@ func (na *NetAPIStruct) NetEpHostDel(em *cmn.EndPointMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Rules.DeleteEPHost(true, em.Name, em.HostName, em.ProbeType, em.ProbePort)
+	if err == nil {
+		mh.zr.Rules.ConfigGenBump()
+		mh.cfgStore.Changed()
+	}
 	return ret, err
 }
 
@@ -628,6 +946,24 @@ func (na *NetAPIStruct) NetEpHostGet() ([]cmn.EndPointMod, error) {
 	return ret, err
 }
 
+// NetEpHostEventSub - Subscribe to LB end-point health events from loxinet
+func (na *NetAPIStruct) NetEpHostEventSub(ch chan cmn.EndPointEvent) (int, error) {
+	if na.BgpPeerMode {
+		return RuleErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.zr.Rules.EpEventSub(ch)
+	return ret, err
+}
+
+// NetEpHostEventUnSub - Unsubscribe from LB end-point health events
+func (na *NetAPIStruct) NetEpHostEventUnSub(ch chan cmn.EndPointEvent) (int, error) {
+	if na.BgpPeerMode {
+		return RuleErrBase, errors.New("running in bgp only mode")
+	}
+	ret, err := mh.zr.Rules.EpEventUnSub(ch)
+	return ret, err
+}
+
 // NetParamSet - Set operational params of loxinet
 func (na *NetAPIStruct) NetParamSet(param cmn.ParamMod) (int, error) {
 	if na.BgpPeerMode {
@@ -663,7 +999,11 @@ func (na *NetAPIStruct) NetGoBGPNeighGet() ([]cmn.GoBGPNeighGetMod, error) {
 // NetGoBGPNeighAdd - Add bgp neigh to gobgp
 func (na *NetAPIStruct) NetGoBGPNeighAdd(param *cmn.GoBGPNeighMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.BGPNeighMod(true, param.Addr, param.RemoteAS, uint32(param.RemotePort), param.MultiHop)
+		ret, err := mh.bgp.BGPNeighMod(true, param.Addr, param.RemoteAS, uint32(param.RemotePort), param.MultiHop)
+		if err == nil {
+			mh.cfgStore.BgpNeighMod(true, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -673,7 +1013,11 @@ func (na *NetAPIStruct) NetGoBGPNeighAdd(param *cmn.GoBGPNeighMod) (int, error)
 // NetGoBGPNeighDel - Del bgp neigh from gobgp
 func (na *NetAPIStruct) NetGoBGPNeighDel(param *cmn.GoBGPNeighMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.BGPNeighMod(false, param.Addr, param.RemoteAS, uint32(param.RemotePort), param.MultiHop)
+		ret, err := mh.bgp.BGPNeighMod(false, param.Addr, param.RemoteAS, uint32(param.RemotePort), param.MultiHop)
+		if err == nil {
+			mh.cfgStore.BgpNeighMod(false, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -682,7 +1026,11 @@ func (na *NetAPIStruct) NetGoBGPNeighDel(param *cmn.GoBGPNeighMod) (int, error)
 // NetGoBGPGCAdd - Add bgp global config
 func (na *NetAPIStruct) NetGoBGPGCAdd(param *cmn.GoBGPGlobalConfig) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.BGPGlobalConfigAdd(*param)
+		ret, err := mh.bgp.BGPGlobalConfigAdd(*param)
+		if err == nil {
+			mh.cfgStore.BgpGlobalSet(*param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -710,7 +1058,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyDefinedSetGet(name string, DefinedTypeStri
 // NetGoBGPPolicyPrefixAdd - Add Prefixset in bgp
 func (na *NetAPIStruct) NetGoBGPPolicyDefinedSetAdd(param *cmn.GoBGPPolicyDefinedSetMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.AddPolicyDefinedSets(*param)
+		ret, err := mh.bgp.AddPolicyDefinedSets(*param)
+		if err == nil {
+			mh.cfgStore.BgpDefinedSetMod(true, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -720,7 +1072,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyDefinedSetAdd(param *cmn.GoBGPPolicyDefine
 // NetGoBGPPolicyPrefixAdd - Add Prefixset in bgp
 func (na *NetAPIStruct) NetGoBGPPolicyDefinedSetDel(param *cmn.GoBGPPolicyDefinedSetMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.DelPolicyDefinedSets(param.Name, param.DefinedTypeString)
+		ret, err := mh.bgp.DelPolicyDefinedSets(param.Name, param.DefinedTypeString)
+		if err == nil {
+			mh.cfgStore.BgpDefinedSetMod(false, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -744,7 +1100,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyDefinitionsGet() ([]cmn.GoBGPPolicyDefinit
 // NetGoBGPPolicyNeighAdd - Add bgp neigh to gobgp
 func (na *NetAPIStruct) NetGoBGPPolicyDefinitionAdd(param *cmn.GoBGPPolicyDefinitionsMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.AddPolicyDefinitions(param.Name, param.Statement)
+		ret, err := mh.bgp.AddPolicyDefinitions(param.Name, param.Statement)
+		if err == nil {
+			mh.cfgStore.BgpPolicyMod(true, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -754,7 +1114,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyDefinitionAdd(param *cmn.GoBGPPolicyDefini
 // NetGoBGPPolicyNeighAdd - Add bgp neigh to gobgp
 func (na *NetAPIStruct) NetGoBGPPolicyDefinitionDel(param *cmn.GoBGPPolicyDefinitionsMod) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.DelPolicyDefinitions(param.Name)
+		ret, err := mh.bgp.DelPolicyDefinitions(param.Name)
+		if err == nil {
+			mh.cfgStore.BgpPolicyMod(false, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -764,7 +1128,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyDefinitionDel(param *cmn.GoBGPPolicyDefini
 // NetGoBGPPolicyApplyAdd - Add bgp neigh to gobgp
 func (na *NetAPIStruct) NetGoBGPPolicyApplyAdd(param *cmn.GoBGPPolicyApply) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.BGPApplyPolicyToNeighbor("add", param.NeighIPAddress, param.PolicyType, param.Polices, param.RouteAction)
+		ret, err := mh.bgp.BGPApplyPolicyToNeighbor("add", param.NeighIPAddress, param.PolicyType, param.Polices, param.RouteAction)
+		if err == nil {
+			mh.cfgStore.BgpPolicyApplyMod(true, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -774,7 +1142,11 @@ func (na *NetAPIStruct) NetGoBGPPolicyApplyAdd(param *cmn.GoBGPPolicyApply) (int
 // NetGoBGPPolicyApplyDel - Del bgp neigh to gobgp
 func (na *NetAPIStruct) NetGoBGPPolicyApplyDel(param *cmn.GoBGPPolicyApply) (int, error) {
 	if mh.bgp != nil {
-		return mh.bgp.BGPApplyPolicyToNeighbor("del", param.NeighIPAddress, param.PolicyType, param.Polices, param.RouteAction)
+		ret, err := mh.bgp.BGPApplyPolicyToNeighbor("del", param.NeighIPAddress, param.PolicyType, param.Polices, param.RouteAction)
+		if err == nil {
+			mh.cfgStore.BgpPolicyApplyMod(false, *param)
+		}
+		return ret, err
 	}
 	tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
 	return 0, errors.New("loxilb BGP mode is disabled")
@@ -0,0 +1,744 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// constants
+const (
+	// CfgStoreFile - name of the config state document in the config path
+	CfgStoreFile = "loxilb.json"
+	// CfgStoreSaveDelay - changes done within this time are saved together
+	CfgStoreSaveDelay = 2 * time.Second
+)
+
+// config files written by "loxicmd save" before loxilb saved its own config
+const (
+	cfgLegacyEpFile   = "EPconfig.txt"
+	cfgLegacyLbFile   = "lbconfig.txt"
+	cfgLegacySessFile = "sessionconfig.txt"
+	cfgLegacyUlClFile = "sessionulclconfig.txt"
+	cfgLegacyFwFile   = "FWconfig.txt"
+	cfgLegacyBFDFile  = "BFDconfig.txt"
+)
+
+// CfgStoreH - context container for persisting the running config
+type CfgStoreH struct {
+	mtx      sync.Mutex
+	wMtx     sync.Mutex
+	path     string
+	boot     *cmn.ConfigState
+	restored bool
+	dirty    bool
+	pending  bool
+	bgp      cmn.ConfigState
+}
+
+// CfgStoreInit - Initialize the config store and load the config saved in path
+func CfgStoreInit(path string) *CfgStoreH {
+	cs := new(CfgStoreH)
+	cs.path = path
+	if path == "" {
+		return cs
+	}
+
+	boot, err := cfgStateLoad(path)
+	if err != nil {
+		tk.LogIt(tk.LogError, "config store - %s load failed: %s\n", path, err)
+		return cs
+	}
+	if boot != nil {
+		cs.boot = boot
+		cs.bgp.BGPGlobal = boot.BGPGlobal
+		cs.bgp.BGPNeighs = boot.BGPNeighs
+		cs.bgp.BGPDefinedSets = boot.BGPDefinedSets
+		cs.bgp.BGPPolicies = boot.BGPPolicies
+		cs.bgp.BGPPolicyApply = boot.BGPPolicyApply
+	}
+	return cs
+}
+
+// cfgLegacyRead - Read a config file written by "loxicmd save". It returns
+// false if the file does not exist
+func cfgLegacyRead(path, file string, v interface{}) (bool, error) {
+	buf, err := os.ReadFile(filepath.Join(path, file))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(buf, v); err != nil {
+		return false, fmt.Errorf("%s: %s", file, err)
+	}
+	return true, nil
+}
+
+// cfgStateLoadLegacy - Load the config saved as separate files by "loxicmd save"
+// as a version 0 config state. It returns nil if there are no such files
+func cfgStateLoadLegacy(path string) (*cmn.ConfigState, error) {
+	var lb struct {
+		Attr []cmn.LbRuleMod `json:"lbAttr"`
+	}
+	var sess struct {
+		Attr []cmn.SessionMod `json:"sessionAttr"`
+	}
+	var ulcl struct {
+		Attr []cmn.SessionUlClMod `json:"ulclAttr"`
+	}
+	var fw struct {
+		Attr []cmn.FwRuleMod `json:"fwAttr"`
+	}
+	var ep struct {
+		Attr []cmn.EndPointMod `json:"Attr"`
+	}
+	var bfd struct {
+		Attr []cmn.BFDMod `json:"Attr"`
+	}
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{cfgLegacyEpFile, &ep}, {cfgLegacyLbFile, &lb}, {cfgLegacySessFile, &sess},
+		{cfgLegacyUlClFile, &ulcl}, {cfgLegacyFwFile, &fw}, {cfgLegacyBFDFile, &bfd},
+	}
+
+	found := false
+	for _, f := range files {
+		ok, err := cfgLegacyRead(path, f.name, f.v)
+		if err != nil {
+			return nil, err
+		}
+		found = found || ok
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &cmn.ConfigState{Version: 0, EndPoints: ep.Attr, LbRules: lb.Attr,
+		Sessions: sess.Attr, UlCls: ulcl.Attr, FwRules: fw.Attr, BFD: bfd.Attr}, nil
+}
+
+// cfgStateMigrate - Bring a config state of an older version to the current one
+func cfgStateMigrate(st *cmn.ConfigState) error {
+	if st.Version > cmn.ConfigStateVersion {
+		return fmt.Errorf("unsupported config version %d", st.Version)
+	}
+	for st.Version < cmn.ConfigStateVersion {
+		switch st.Version {
+		case 0:
+			// loxicmd saved the operational state of objects along with
+			// their config and also the lb rules of firewall snat rules
+			var lbRules []cmn.LbRuleMod
+			for _, lm := range st.LbRules {
+				if lm.Serv.Snat && lm.Serv.BlockNum&0x1000 != 0 {
+					continue
+				}
+				cfgStateLbRule(&lm)
+				lbRules = append(lbRules, lm)
+			}
+			st.LbRules = lbRules
+			for i := range st.EndPoints {
+				cfgStateEp(&st.EndPoints[i])
+			}
+			for i := range st.FwRules {
+				st.FwRules[i].Opts.Counter = ""
+			}
+			for i := range st.BFD {
+				st.BFD[i].State = ""
+			}
+		}
+		st.Version++
+	}
+	return nil
+}
+
+// cfgStateLoad - Load the config state document from path. If there is none,
+// config saved by "loxicmd save" is converted. It returns nil if there is no
+// saved config at all
+func cfgStateLoad(path string) (*cmn.ConfigState, error) {
+	var st *cmn.ConfigState
+
+	buf, err := os.ReadFile(filepath.Join(path, CfgStoreFile))
+	if err == nil {
+		st = new(cmn.ConfigState)
+		if err := json.Unmarshal(buf, st); err != nil {
+			return nil, err
+		}
+	} else if errors.Is(err, os.ErrNotExist) {
+		st, err = cfgStateLoadLegacy(path)
+		if err != nil || st == nil {
+			return nil, err
+		}
+		tk.LogIt(tk.LogInfo, "config store - converting loxicmd config in %s\n", path)
+	} else {
+		return nil, err
+	}
+
+	if err := cfgStateMigrate(st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// cfgStateWrite - Write the config state document to path. The document is
+// replaced atomically so that a crash never leaves a partial document behind
+func cfgStateWrite(path string, st *cmn.ConfigState) error {
+	buf, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(path, "."+CfgStoreFile+".*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	_, err = f.Write(buf)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(path, CfgStoreFile))
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// cfgStateLbRule - Remove the operational state from a lb rule
+func cfgStateLbRule(lm *cmn.LbRuleMod) {
+	lm.Serv.RejectedConns = 0
+	lm.Serv.SrcRangeDrops = ""
+	var eps []cmn.LbEndPointArg
+	for _, ep := range lm.Eps {
+		// End-points which are being drained are already on their way out
+		if ep.State == "draining" {
+			continue
+		}
+		eps = append(eps, cmn.LbEndPointArg{EpIP: ep.EpIP, EpPort: ep.EpPort, Weight: ep.Weight})
+	}
+	lm.Eps = eps
+}
+
+// cfgStateEp - Remove the operational state from an end-point
+func cfgStateEp(em *cmn.EndPointMod) {
+	em.MinDelay = ""
+	em.AvgDelay = ""
+	em.MaxDelay = ""
+	em.CurrState = ""
+}
+
+// state - Get the running config. mh.mtx needs to be held by the caller
+func (cs *CfgStoreH) state() *cmn.ConfigState {
+	st := &cmn.ConfigState{Version: cmn.ConfigStateVersion}
+
+	if mh.zr != nil {
+		R := mh.zr.Rules
+		st.Generation = R.ConfigGen()
+
+		eps, _ := R.GetEpHosts()
+		for i := range eps {
+			if !R.epHostAPIAdded(eps[i].Name) {
+				continue
+			}
+			cfgStateEp(&eps[i])
+			st.EndPoints = append(st.EndPoints, eps[i])
+		}
+		sort.Slice(st.EndPoints, func(i, j int) bool {
+			return st.EndPoints[i].Name < st.EndPoints[j].Name
+		})
+
+		for _, rule := range R.tables[RtLB].eMap {
+			if rule.fwSnatOwned() {
+				continue
+			}
+			lm, err := rule.natLbRuleMod()
+			if err != nil {
+				continue
+			}
+			cfgStateLbRule(&lm)
+			st.LbRules = append(st.LbRules, lm)
+		}
+		sort.Slice(st.LbRules, func(i, j int) bool {
+			return cfgTxnLbName(st.LbRules[i].Serv) < cfgTxnLbName(st.LbRules[j].Serv)
+		})
+
+		// Rules which have expired but are not yet reaped are left out
+		now := time.Now()
+		for _, rule := range R.tables[RtFw].eMap {
+			if rule.fwLbOwned() || rule.fwExpired(now) {
+				continue
+			}
+			st.FwRules = append(st.FwRules, rule.fwRuleMod())
+		}
+		sort.Slice(st.FwRules, func(i, j int) bool {
+			return st.FwRules[i].Rule.Pref > st.FwRules[j].Rule.Pref ||
+				(st.FwRules[i].Rule.Pref == st.FwRules[j].Rule.Pref &&
+					fmt.Sprint(st.FwRules[i].Rule) < fmt.Sprint(st.FwRules[j].Rule))
+		})
+
+		st.Sessions, _ = mh.zr.Sess.SessGet()
+		sort.Slice(st.Sessions, func(i, j int) bool {
+			return st.Sessions[i].Ident < st.Sessions[j].Ident
+		})
+		st.UlCls, _ = mh.zr.Sess.SessUlclGet()
+		sort.SliceStable(st.UlCls, func(i, j int) bool {
+			return st.UlCls[i].Ident < st.UlCls[j].Ident
+		})
+
+		st.Policers, _ = mh.zr.Pols.PolGetAll()
+		sort.Slice(st.Policers, func(i, j int) bool {
+			return st.Policers[i].Ident < st.Policers[j].Ident
+		})
+		mirrs, _ := mh.zr.Mirrs.MirrGet()
+		for _, m := range mirrs {
+			st.Mirrors = append(st.Mirrors, cmn.MirrMod{Ident: m.Ident, Info: m.Info, Target: m.Target})
+		}
+		sort.Slice(st.Mirrors, func(i, j int) bool {
+			return st.Mirrors[i].Ident < st.Mirrors[j].Ident
+		})
+
+		st.IPPools, _ = mh.zr.Ipam.IPPoolGet()
+		st.IPSets, _ = mh.zr.IPSets.IPSetGet()
+		for i := range st.IPSets {
+			st.IPSets[i].Refs = 0
+		}
+	}
+
+	if mh.fwLog != nil && mh.fwLog.configured() {
+		fc := mh.fwLog.ConfigGet()
+		fc.Logged = 0
+		fc.Dropped = 0
+		fc.Sampled = 0
+		st.FwLog = &fc
+	}
+
+	if mh.zn != nil {
+		for name, zone := range mh.zn.ZoneMap {
+			if policy := zone.Rules.FwDefaultPolicy(); policy != cmn.FwPolicyAccept {
+				st.FwPolicies = append(st.FwPolicies, cmn.FwPolicyMod{Zone: name, Policy: policy})
+			}
+		}
+		sort.Slice(st.FwPolicies, func(i, j int) bool {
+			return st.FwPolicies[i].Zone < st.FwPolicies[j].Zone
+		})
+	}
+
+	if mh.has != nil {
+		if mh.has.SpawnKa && mh.has.Bs != nil {
+			st.BFD, _ = mh.has.CIBFDSessionGet()
+			for i := range st.BFD {
+				st.BFD[i].State = ""
+			}
+		}
+		hs, _ := mh.has.CIStateGet()
+		for _, h := range hs {
+			if h.State != "NOT_DEFINED" {
+				st.ClusterState = append(st.ClusterState, h)
+			}
+		}
+		sort.Slice(st.ClusterState, func(i, j int) bool {
+			return st.ClusterState[i].Instance < st.ClusterState[j].Instance
+		})
+	}
+
+	cs.mtx.Lock()
+	st.BGPGlobal = cs.bgp.BGPGlobal
+	st.BGPNeighs = append(st.BGPNeighs, cs.bgp.BGPNeighs...)
+	st.BGPDefinedSets = append(st.BGPDefinedSets, cs.bgp.BGPDefinedSets...)
+	st.BGPPolicies = append(st.BGPPolicies, cs.bgp.BGPPolicies...)
+	st.BGPPolicyApply = append(st.BGPPolicyApply, cs.bgp.BGPPolicyApply...)
+	cs.mtx.Unlock()
+
+	return st
+}
+
+// Save - Write the running config to the config path
+func (cs *CfgStoreH) Save() error {
+	if cs == nil || cs.path == "" {
+		return nil
+	}
+	cs.wMtx.Lock()
+	defer cs.wMtx.Unlock()
+
+	mh.mtx.RLock()
+	st := cs.state()
+	mh.mtx.RUnlock()
+
+	if err := cfgStateWrite(cs.path, st); err != nil {
+		tk.LogIt(tk.LogError, "config store - save failed: %s\n", err)
+		return err
+	}
+	tk.LogIt(tk.LogDebug, "config store - saved generation %d\n", st.Generation)
+	return nil
+}
+
+// saveDirty - Save the running config if it changed since the last save
+func (cs *CfgStoreH) saveDirty() {
+	cs.mtx.Lock()
+	cs.pending = false
+	dirty := cs.dirty
+	cs.dirty = false
+	cs.mtx.Unlock()
+
+	if dirty {
+		cs.Save()
+	}
+}
+
+// Changed - Note that the running config has changed. The config is saved
+// after CfgStoreSaveDelay so that a burst of changes is saved only once.
+// Nothing is saved until the saved config has been restored
+func (cs *CfgStoreH) Changed() {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	cs.dirty = true
+	if !cs.restored || cs.pending || cs.path == "" {
+		return
+	}
+	cs.pending = true
+	time.AfterFunc(CfgStoreSaveDelay, cs.saveDirty)
+}
+
+// BootBFD - Get the BFD sessions of the saved config
+func (cs *CfgStoreH) BootBFD() []cmn.BFDMod {
+	if cs == nil || cs.boot == nil {
+		return nil
+	}
+	return cs.boot.BFD
+}
+
+// Restore - Apply the saved config. It is done only once after start and
+// saving the running config is enabled only after that
+func (cs *CfgStoreH) Restore(na *NetAPIStruct) (int, error) {
+	if cs == nil {
+		return 0, nil
+	}
+	cs.mtx.Lock()
+	if cs.restored {
+		cs.mtx.Unlock()
+		return 0, nil
+	}
+	cs.mtx.Unlock()
+
+	st := cs.boot
+	failed := 0
+	if st != nil && !na.BgpPeerMode {
+		for i := range st.EndPoints {
+			if _, err := na.NetEpHostAdd(&st.EndPoints[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - end-point %s restore failed: %s\n", st.EndPoints[i].HostName, err)
+				failed++
+			}
+		}
+		// VIPs are allocated before lb rules come up so that none of them
+		// is handed out to another service in between
+		for i := range st.IPPools {
+			pm := st.IPPools[i]
+			if _, err := na.NetIPPoolAdd(&pm); err != nil {
+				tk.LogIt(tk.LogError, "config store - ippool %s restore failed: %s\n", pm.Name, err)
+				failed++
+				continue
+			}
+			for j := range pm.Allocs {
+				am := pm.Allocs[j]
+				am.Pool = pm.Name
+				if _, err := na.NetVIPAlloc(&am); err != nil {
+					tk.LogIt(tk.LogError, "config store - vip %s:%s restore failed: %s\n", pm.Name, am.Service, err)
+					failed++
+				}
+			}
+		}
+		for i := range st.LbRules {
+			if _, err := na.NetLbRuleAdd(&st.LbRules[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - %s restore failed: %s\n", cfgTxnLbName(st.LbRules[i].Serv), err)
+				failed++
+			}
+		}
+		for i := range st.Sessions {
+			if _, err := na.NetSessionAdd(&st.Sessions[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - session %s restore failed: %s\n", st.Sessions[i].Ident, err)
+				failed++
+			}
+		}
+		for i := range st.UlCls {
+			if _, err := na.NetSessionUlClAdd(&st.UlCls[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - ulcl %s restore failed: %s\n", st.UlCls[i].Ident, err)
+				failed++
+			}
+		}
+		for i := range st.IPSets {
+			if _, err := na.NetIPSetAdd(&st.IPSets[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - ipset %s restore failed: %s\n", st.IPSets[i].Name, err)
+				failed++
+			}
+		}
+		now := time.Now()
+		for i := range st.FwRules {
+			// Rules which expired while loxilb was down are dropped quietly
+			if exp, err := time.Parse(time.RFC3339, st.FwRules[i].Opts.Expiry); err == nil && !now.Before(exp) {
+				tk.LogIt(tk.LogDebug, "config store - fw rule %v expired at %s\n", st.FwRules[i].Rule, st.FwRules[i].Opts.Expiry)
+				continue
+			}
+			if _, err := na.NetFwRuleAdd(&st.FwRules[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - fw rule %v restore failed: %s\n", st.FwRules[i].Rule, err)
+				failed++
+			}
+		}
+		for i := range st.FwPolicies {
+			if _, err := na.NetFwPolicySet(&st.FwPolicies[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - fw policy %s restore failed: %s\n", st.FwPolicies[i].Zone, err)
+				failed++
+			}
+		}
+		if st.FwLog != nil {
+			if _, err := na.NetFwLogConfigSet(st.FwLog); err != nil {
+				tk.LogIt(tk.LogError, "config store - fw log restore failed: %s\n", err)
+				failed++
+			}
+		}
+		for i := range st.Policers {
+			if _, err := na.NetPolicerAdd(&st.Policers[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - policer %s restore failed: %s\n", st.Policers[i].Ident, err)
+				failed++
+			}
+		}
+		for i := range st.Mirrors {
+			if _, err := na.NetMirrorAdd(&st.Mirrors[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - mirror %s restore failed: %s\n", st.Mirrors[i].Ident, err)
+				failed++
+			}
+		}
+		for i := range st.ClusterState {
+			if _, err := na.NetCIStateMod(&st.ClusterState[i]); err != nil {
+				tk.LogIt(tk.LogError, "config store - cluster %s restore failed: %s\n", st.ClusterState[i].Instance, err)
+				failed++
+			}
+		}
+
+		mh.mtx.Lock()
+		if mh.zr != nil && st.Generation > mh.zr.Rules.cfgGen {
+			mh.zr.Rules.cfgGen = st.Generation
+		}
+		mh.mtx.Unlock()
+	}
+
+	// VIPs of lb rules which are in a pool but were never allocated from it
+	// are taken so that they are not handed out again
+	if !na.BgpPeerMode {
+		mh.mtx.Lock()
+		if mh.zr != nil {
+			mh.zr.Ipam.LbRulesSync("")
+		}
+		mh.mtx.Unlock()
+	}
+
+	// Keep the saved config around if it could not be restored fully as the
+	// next save would not have what failed
+	if failed > 0 && cs.path != "" {
+		bak := filepath.Join(cs.path, CfgStoreFile+".bak")
+		buf, _ := json.MarshalIndent(st, "", "  ")
+		if err := os.WriteFile(bak, buf, 0644); err == nil {
+			tk.LogIt(tk.LogError, "config store - %d objects not restored, saved config kept in %s\n", failed, bak)
+		}
+	}
+
+	cs.mtx.Lock()
+	cs.restored = true
+	cs.mtx.Unlock()
+	cs.Changed()
+
+	tk.LogIt(tk.LogInfo, "config store - restore done\n")
+	if failed > 0 {
+		return RuleArgsErr, fmt.Errorf("config-restore error (%d objects)", failed)
+	}
+	return 0, nil
+}
+
+// BgpRestore - Apply the saved goBGP config. It is done whenever loxilb
+// connects to goBGP as goBGP does not keep any config across restarts
+func (cs *CfgStoreH) BgpRestore(gbh *GoBgpH) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	st := cmn.ConfigState{BGPGlobal: cs.bgp.BGPGlobal}
+	st.BGPNeighs = append(st.BGPNeighs, cs.bgp.BGPNeighs...)
+	st.BGPDefinedSets = append(st.BGPDefinedSets, cs.bgp.BGPDefinedSets...)
+	st.BGPPolicies = append(st.BGPPolicies, cs.bgp.BGPPolicies...)
+	st.BGPPolicyApply = append(st.BGPPolicyApply, cs.bgp.BGPPolicyApply...)
+	cs.mtx.Unlock()
+
+	if st.BGPGlobal != nil {
+		if _, err := gbh.BGPGlobalConfigAdd(*st.BGPGlobal); err != nil {
+			tk.LogIt(tk.LogError, "config store - bgp global config restore failed: %s\n", err)
+		}
+	}
+	for _, nm := range st.BGPNeighs {
+		if _, err := gbh.BGPNeighMod(true, nm.Addr, nm.RemoteAS, uint32(nm.RemotePort), nm.MultiHop); err != nil {
+			tk.LogIt(tk.LogError, "config store - bgp neighbor %s restore failed: %s\n", nm.Addr, err)
+		}
+	}
+	for _, ds := range st.BGPDefinedSets {
+		if _, err := gbh.AddPolicyDefinedSets(ds); err != nil {
+			tk.LogIt(tk.LogError, "config store - bgp defined set %s restore failed: %s\n", ds.Name, err)
+		}
+	}
+	for _, pd := range st.BGPPolicies {
+		if _, err := gbh.AddPolicyDefinitions(pd.Name, pd.Statement); err != nil {
+			tk.LogIt(tk.LogError, "config store - bgp policy %s restore failed: %s\n", pd.Name, err)
+		}
+	}
+	for _, pa := range st.BGPPolicyApply {
+		if _, err := gbh.BGPApplyPolicyToNeighbor("add", pa.NeighIPAddress, pa.PolicyType, pa.Polices, pa.RouteAction); err != nil {
+			tk.LogIt(tk.LogError, "config store - bgp policy apply to %s restore failed: %s\n", pa.NeighIPAddress, err)
+		}
+	}
+}
+
+// BgpGlobalSet - Note the goBGP global config
+func (cs *CfgStoreH) BgpGlobalSet(gc cmn.GoBGPGlobalConfig) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	cs.bgp.BGPGlobal = &gc
+	cs.mtx.Unlock()
+	cs.Changed()
+}
+
+// BgpNeighMod - Note the addition or deletion of a goBGP neighbor
+func (cs *CfgStoreH) BgpNeighMod(add bool, nm cmn.GoBGPNeighMod) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	var neighs []cmn.GoBGPNeighMod
+	for _, n := range cs.bgp.BGPNeighs {
+		if !n.Addr.Equal(nm.Addr) {
+			neighs = append(neighs, n)
+		}
+	}
+	if add {
+		neighs = append(neighs, nm)
+	}
+	cs.bgp.BGPNeighs = neighs
+	cs.mtx.Unlock()
+	cs.Changed()
+}
+
+// BgpDefinedSetMod - Note the addition or deletion of a goBGP policy defined set
+func (cs *CfgStoreH) BgpDefinedSetMod(add bool, ds cmn.GoBGPPolicyDefinedSetMod) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	var sets []cmn.GoBGPPolicyDefinedSetMod
+	for _, d := range cs.bgp.BGPDefinedSets {
+		if d.Name != ds.Name || d.DefinedTypeString != ds.DefinedTypeString {
+			sets = append(sets, d)
+		}
+	}
+	if add {
+		sets = append(sets, ds)
+	}
+	cs.bgp.BGPDefinedSets = sets
+	cs.mtx.Unlock()
+	cs.Changed()
+}
+
+// BgpPolicyMod - Note the addition or deletion of a goBGP policy definition
+func (cs *CfgStoreH) BgpPolicyMod(add bool, pd cmn.GoBGPPolicyDefinitionsMod) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	var pols []cmn.GoBGPPolicyDefinitionsMod
+	for _, p := range cs.bgp.BGPPolicies {
+		if p.Name != pd.Name {
+			pols = append(pols, p)
+		}
+	}
+	if add {
+		pols = append(pols, pd)
+	}
+	cs.bgp.BGPPolicies = pols
+	cs.mtx.Unlock()
+	cs.Changed()
+}
+
+// BgpPolicyApplyMod - Note the policies applied to or removed from a goBGP neighbor
+func (cs *CfgStoreH) BgpPolicyApplyMod(add bool, pa cmn.GoBGPPolicyApply) {
+	if cs == nil {
+		return
+	}
+	cs.mtx.Lock()
+	var applies []cmn.GoBGPPolicyApply
+	for _, a := range cs.bgp.BGPPolicyApply {
+		if a.NeighIPAddress != pa.NeighIPAddress || a.PolicyType != pa.PolicyType {
+			applies = append(applies, a)
+			continue
+		}
+		var pols []string
+		for _, p := range a.Polices {
+			keep := true
+			for _, np := range pa.Polices {
+				if p == np {
+					keep = false
+				}
+			}
+			if keep {
+				pols = append(pols, p)
+			}
+		}
+		if add {
+			pa.Polices = append(pols, pa.Polices...)
+		} else if len(pols) > 0 {
+			a.Polices = pols
+			applies = append(applies, a)
+		}
+	}
+	if add {
+		applies = append(applies, pa)
+	}
+	cs.bgp.BGPPolicyApply = applies
+	cs.mtx.Unlock()
+	cs.Changed()
+}
@@ -0,0 +1,567 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// constants
+const (
+	// CfgTxnDpTimeout - time to wait for the datapath to carry out a transaction
+	CfgTxnDpTimeout = 10 * time.Second
+)
+
+// cfgTxnOp - change done to an object by a config transaction
+type cfgTxnOp uint8
+
+// config transaction ops
+const (
+	cfgTxnAdd cfgTxnOp = iota + 1
+	cfgTxnUpdate
+	cfgTxnDelete
+)
+
+// cfgTxnEnt - a single change of a config transaction. Only one of lb, fw
+// or ep is set as per the kind of object being changed
+type cfgTxnEnt struct {
+	op     cfgTxnOp
+	name   string
+	lb     *cmn.LbRuleMod
+	fw     *cmn.FwRuleMod
+	ep     *cmn.EndPointMod
+	prevLb cmn.LbRuleMod
+	prevFw cmn.FwRuleMod
+	prevEp epHostOpts
+	rule   *ruleEnt
+}
+
+// cfgTxnPlan - ordered list of changes to be done by a config transaction
+type cfgTxnPlan struct {
+	ents []*cfgTxnEnt
+	keys map[string]struct{}
+}
+
+// cfgTxnLbName - name of a lb rule as used in config transaction results
+func cfgTxnLbName(serv cmn.LbServiceArg) string {
+	pMin, pMax := lbServPorts(serv)
+	name := fmt.Sprintf("lb:%s:%d", net.ParseIP(serv.ServIP).String(), pMin)
+	if pMax != pMin {
+		name += fmt.Sprintf("-%d", pMax)
+	}
+	name += "/" + serv.Proto
+	if serv.BlockNum != 0 {
+		name += fmt.Sprintf(":block-%d", serv.BlockNum)
+	}
+	if serv.HostUrl != "" {
+		name += ":" + serv.HostUrl
+	}
+	return name
+}
+
+// lbServCfg - Get the service arguments of a lb rule which can be changed
+// on an existing rule, with defaults filled in the same way as a rule add
+func lbServCfg(serv cmn.LbServiceArg) cmn.LbServiceArg {
+	cfg := cmn.LbServiceArg{Sel: serv.Sel, Mode: serv.Mode,
+		ProbeType: serv.ProbeType, ProbePort: serv.ProbePort, ProbeReq: serv.ProbeReq,
+		ProbeResp: serv.ProbeResp, ProbeTimeout: serv.ProbeTimeout, ProbeRetries: serv.ProbeRetries,
+		ProbeActRetries: serv.ProbeActRetries, ProbeJitter: serv.ProbeJitter, ProbeInterval: serv.ProbeInterval,
+		ProbeMethod: serv.ProbeMethod, ProbeHost: serv.ProbeHost, ProbeRespRegex: serv.ProbeRespRegex,
+		OdFailRatio: serv.OdFailRatio, OdEjectTime: serv.OdEjectTime, OdMaxEjectPct: serv.OdMaxEjectPct,
+		SlowStart: serv.SlowStart, SlowStartFloor: serv.SlowStartFloor, SlowStartCurve: serv.SlowStartCurve,
+		DrainTimeout: serv.DrainTimeout, MaxConns: serv.MaxConns, MaxConnsPerEp: serv.MaxConnsPerEp,
+		NewConnRate: serv.NewConnRate}
+
+	if len(serv.ProbeHeaders) > 0 {
+		cfg.ProbeHeaders = serv.ProbeHeaders
+	}
+	if len(serv.ProbeRespCodes) > 0 {
+		cfg.ProbeRespCodes = serv.ProbeRespCodes
+	}
+	for _, sr := range serv.SrcRanges {
+		if _, srNet, err := net.ParseCIDR(sr); err == nil {
+			cfg.SrcRanges = append(cfg.SrcRanges, srNet.String())
+		}
+	}
+	sort.Strings(cfg.SrcRanges)
+
+	if cfg.OdFailRatio != 0 {
+		if cfg.OdEjectTime == 0 {
+			cfg.OdEjectTime = DflOdEjectTime
+		}
+		if cfg.OdMaxEjectPct == 0 {
+			cfg.OdMaxEjectPct = DflOdMaxEjectPct
+		}
+	}
+	if cfg.SlowStart != 0 {
+		if cfg.SlowStartFloor == 0 {
+			cfg.SlowStartFloor = DflSlowStartFloor
+		}
+		if cfg.SlowStartCurve == "" {
+			cfg.SlowStartCurve = SlowStartLinear
+		}
+	}
+	if serv.Sel == cmn.LbSelRrPersist {
+		cfg.PersistTimeout = serv.PersistTimeout
+		if cfg.PersistTimeout == 0 || cfg.PersistTimeout > 24*60*60 {
+			cfg.PersistTimeout = DefaultPersistTimeOut
+		}
+	}
+	return cfg
+}
+
+// lbRuleModChanged - Check if applying the lb rule arguments want on an
+// existing rule with arguments cur would change it
+func lbRuleModChanged(cur, want cmn.LbRuleMod) bool {
+	if !reflect.DeepEqual(lbServCfg(cur.Serv), lbServCfg(want.Serv)) {
+		return true
+	}
+
+	var cSecIPs, wSecIPs []string
+	for _, sip := range cur.SecIPs {
+		cSecIPs = append(cSecIPs, net.ParseIP(sip.SecIP).String())
+	}
+	for _, sip := range want.SecIPs {
+		wSecIPs = append(wSecIPs, net.ParseIP(sip.SecIP).String())
+	}
+	sort.Strings(cSecIPs)
+	sort.Strings(wSecIPs)
+	if !reflect.DeepEqual(cSecIPs, wSecIPs) {
+		return true
+	}
+
+	// End-points which are being drained are already on their way out
+	cEps := make(map[string]uint8)
+	for _, ep := range cur.Eps {
+		if ep.State != "draining" {
+			cEps[fmt.Sprintf("%s:%d", net.ParseIP(ep.EpIP).String(), ep.EpPort)] = ep.Weight
+		}
+	}
+	wEps := make(map[string]uint8)
+	for _, ep := range want.Eps {
+		wEps[fmt.Sprintf("%s:%d", net.ParseIP(ep.EpIP).String(), ep.EpPort)] = ep.Weight
+	}
+	return !reflect.DeepEqual(cEps, wEps)
+}
+
+// fwOptsCfg - Get firewall rule options in the form they are kept by an existing rule.
+// A ttl is kept as the expiry it leads to, so it always makes for a change
+func fwOptsCfg(opts cmn.FwOptArg) cmn.FwOptArg {
+	cfg := cmn.FwOptArg{Record: opts.Record, Mark: opts.Mark}
+	if opts.TTL != 0 {
+		cfg.Expiry = time.Now().Add(time.Duration(opts.TTL) * time.Second).UTC().Format(time.RFC3339)
+	} else if expiry, err := time.Parse(time.RFC3339, opts.Expiry); err == nil {
+		cfg.Expiry = expiry.UTC().Format(time.RFC3339)
+	} else {
+		cfg.Expiry = opts.Expiry
+	}
+	cfg.Schedule = strings.Join(strings.Fields(opts.Schedule), " ")
+	if opts.Allow {
+		cfg.Allow = true
+	} else if opts.Drop {
+		cfg.Drop = true
+	} else if opts.Rdr {
+		cfg.Rdr = true
+		cfg.RdrPort = opts.RdrPort
+	} else if opts.Trap {
+		cfg.Trap = true
+	} else if opts.DoSnat {
+		cfg.DoSnat = true
+		cfg.ToIP = opts.ToIP
+		cfg.ToPort = opts.ToPort
+		cfg.Mark = 0
+	} else {
+		cfg.Drop = true
+	}
+	return cfg
+}
+
+// fwSnatOwned - Check if a lb rule was created for the snat of a firewall rule.
+// Such rules come and go along with the firewall rule itself
+func (r *ruleEnt) fwSnatOwned() bool {
+	return r.act.actType == RtActSnat && r.tuples.pref&0x1000 != 0
+}
+
+// addKey - Add the key of an object to the plan. It returns false if the
+// object is already a part of the plan
+func (p *cfgTxnPlan) addKey(key string) bool {
+	if _, found := p.keys[key]; found {
+		return false
+	}
+	p.keys[key] = struct{}{}
+	return true
+}
+
+// result - Get the changes of the plan as a config transaction result
+func (p *cfgTxnPlan) result() cmn.ConfigTxnResult {
+	var res cmn.ConfigTxnResult
+	for _, ent := range p.ents {
+		switch ent.op {
+		case cfgTxnAdd:
+			res.Added = append(res.Added, ent.name)
+		case cfgTxnUpdate:
+			res.Updated = append(res.Updated, ent.name)
+		case cfgTxnDelete:
+			res.Deleted = append(res.Deleted, ent.name)
+		}
+	}
+	return res
+}
+
+// cfgTxnMkPlan - Validate a config transaction and work out the changes it needs
+// to do to the current config. Nothing is applied to the tables at this stage
+func (R *RuleH) cfgTxnMkPlan(txn *cmn.ConfigTxnMod) (*cfgTxnPlan, int, error) {
+	plan := &cfgTxnPlan{keys: make(map[string]struct{})}
+
+	// End-points come first as lb rules may use them
+	for i := range txn.EndPoints {
+		em := &txn.EndPoints[i]
+		args := epHostOptsFromMod(em)
+		if _, err := validateEPHostOpts(em.HostName, args); err != nil {
+			return nil, RuleArgsErr, err
+		}
+		key := em.Name
+		if key == "" {
+			key = makeEPKey(em.HostName, em.ProbeType, em.ProbePort)
+		}
+		if !plan.addKey("ep:" + key) {
+			return nil, RuleArgsErr, errors.New("txn-duplicate-ep error")
+		}
+		ent := &cfgTxnEnt{op: cfgTxnAdd, name: "ep:" + key, ep: em}
+		R.epMx.RLock()
+		if ep := R.epMap[key]; ep != nil {
+			ent.op = cfgTxnUpdate
+			ent.prevEp = ep.opts
+			args.probeActivated = args.probeType != HostProbeNone
+			args.currProbeDuration = ep.opts.currProbeDuration
+			args.probeRespRe = ep.opts.probeRespRe
+			if reflect.DeepEqual(args, ep.opts) {
+				ent = nil
+			}
+		}
+		R.epMx.RUnlock()
+		if ent != nil {
+			plan.ents = append(plan.ents, ent)
+		}
+	}
+
+	// Deletes are done before adds so that VIPs and port ranges are freed up
+	for i := range txn.LbRulesDel {
+		lm := &txn.LbRulesDel[i]
+		rule := R.GetNatLbRuleByServArgs(lm.Serv)
+		if rule == nil {
+			return nil, RuleNotExistsErr, errors.New("txn-lbrule not-exists error")
+		}
+		name := cfgTxnLbName(lm.Serv)
+		if !plan.addKey(name) {
+			return nil, RuleArgsErr, errors.New("txn-duplicate-lbrule error")
+		}
+		prev, err := rule.natLbRuleMod()
+		if err != nil {
+			return nil, RuleArgsErr, err
+		}
+		plan.ents = append(plan.ents, &cfgTxnEnt{op: cfgTxnDelete, name: name, lb: lm, prevLb: prev, rule: rule})
+	}
+
+	for i := range txn.LbRules {
+		lm := &txn.LbRules[i]
+		if lm.Serv.Oper != cmn.LBOPAdd {
+			return nil, RuleArgsErr, errors.New("txn-lbrule-oper error")
+		}
+		if _, ret, err := natLbRuleArgs(lm.Serv, lm.SecIPs, lm.Eps); err != nil {
+			return nil, ret, err
+		}
+		name := cfgTxnLbName(lm.Serv)
+		if !plan.addKey(name) {
+			return nil, RuleArgsErr, errors.New("txn-duplicate-lbrule error")
+		}
+		ent := &cfgTxnEnt{op: cfgTxnAdd, name: name, lb: lm}
+		if rule := R.GetNatLbRuleByServArgs(lm.Serv); rule != nil {
+			prev, err := rule.natLbRuleMod()
+			if err != nil {
+				return nil, RuleArgsErr, err
+			}
+			if !lbRuleModChanged(prev, *lm) {
+				continue
+			}
+			ent.op = cfgTxnUpdate
+			ent.prevLb = prev
+		}
+		plan.ents = append(plan.ents, ent)
+	}
+
+	for i := range txn.FwRulesDel {
+		fm := &txn.FwRulesDel[i]
+		rt, err := fwRuleTuples(fm.Rule)
+		if err != nil {
+			return nil, RuleTupleErr, err
+		}
+		rule := R.tables[RtFw].eMap[rt.ruleKey()]
+		if rule == nil {
+			return nil, RuleNotExistsErr, errors.New("txn-fwrule not-exists error")
+		}
+		name := "fw:" + rt.String()
+		if !plan.addKey(name) {
+			return nil, RuleArgsErr, errors.New("txn-duplicate-fwrule error")
+		}
+		plan.ents = append(plan.ents, &cfgTxnEnt{op: cfgTxnDelete, name: name, fw: fm, prevFw: rule.fwRuleMod(), rule: rule})
+	}
+
+	for i := range txn.FwRules {
+		fm := &txn.FwRules[i]
+		rt, err := fwRuleTuples(fm.Rule)
+		if err != nil {
+			return nil, RuleTupleErr, err
+		}
+		if fm.Opts.DoSnat && net.ParseIP(fm.Opts.ToIP) == nil {
+			return nil, RuleArgsErr, errors.New("malformed-args error")
+		}
+		name := "fw:" + rt.String()
+		if !plan.addKey(name) {
+			return nil, RuleArgsErr, errors.New("txn-duplicate-fwrule error")
+		}
+		ent := &cfgTxnEnt{op: cfgTxnAdd, name: name, fw: fm}
+		if rule := R.tables[RtFw].eMap[rt.ruleKey()]; rule != nil {
+			prev := rule.fwRuleMod()
+			if reflect.DeepEqual(fwOptsCfg(prev.Opts), fwOptsCfg(fm.Opts)) {
+				continue
+			}
+			ent.op = cfgTxnUpdate
+			ent.prevFw = prev
+		}
+		plan.ents = append(plan.ents, ent)
+	}
+
+	return plan, 0, nil
+}
+
+// cfgTxnApply - Apply a single change of a config transaction
+func (R *RuleH) cfgTxnApply(ent *cfgTxnEnt) (int, error) {
+	switch {
+	case ent.ep != nil:
+		return R.AddEPHost(true, ent.ep.HostName, ent.ep.Name, epHostOptsFromMod(ent.ep))
+	case ent.lb != nil:
+		if ent.op == cfgTxnDelete {
+			return R.DeleteNatLbRule(ent.lb.Serv)
+		}
+		ret, err := R.AddNatLbRule(ent.lb.Serv, ent.lb.SecIPs, ent.lb.Eps)
+		if err != nil {
+			return ret, err
+		}
+		ent.rule = R.GetNatLbRuleByServArgs(ent.lb.Serv)
+		return 0, nil
+	case ent.fw != nil:
+		if ent.op != cfgTxnAdd {
+			if ret, err := R.DeleteFwRule(ent.fw.Rule); err != nil {
+				return ret, err
+			}
+		}
+		if ent.op == cfgTxnDelete {
+			return 0, nil
+		}
+		ret, err := R.AddFwRule(ent.fw.Rule, ent.fw.Opts)
+		if err != nil {
+			return ret, err
+		}
+		if rt, err := fwRuleTuples(ent.fw.Rule); err == nil {
+			ent.rule = R.tables[RtFw].eMap[rt.ruleKey()]
+		}
+		return 0, nil
+	}
+	return RuleArgsErr, errors.New("txn-args error")
+}
+
+// cfgTxnUndo - Revert a change of a config transaction which was applied
+func (R *RuleH) cfgTxnUndo(ent *cfgTxnEnt) error {
+	var err error
+
+	switch {
+	case ent.ep != nil:
+		if ent.op == cfgTxnAdd {
+			_, err = R.DeleteEPHost(true, ent.ep.Name, ent.ep.HostName, ent.ep.ProbeType, ent.ep.ProbePort)
+		} else {
+			_, err = R.AddEPHost(true, ent.ep.HostName, ent.ep.Name, ent.prevEp)
+		}
+	case ent.lb != nil:
+		if ent.op != cfgTxnAdd {
+			prev := ent.prevLb
+			prev.Serv.Oper = cmn.LBOPAdd
+			_, err = R.AddNatLbRule(prev.Serv, prev.SecIPs, prev.Eps)
+		} else {
+			_, err = R.DeleteNatLbRule(ent.lb.Serv)
+		}
+	case ent.fw != nil:
+		if ent.op != cfgTxnDelete {
+			_, err = R.DeleteFwRule(ent.fw.Rule)
+		}
+		if err == nil && ent.op != cfgTxnAdd {
+			_, err = R.AddFwRule(ent.prevFw.Rule, ent.prevFw.Opts)
+		}
+	}
+	return err
+}
+
+// cfgTxnBgp - Advertise or withdraw VIPs of lb rules changed by a config transaction
+func cfgTxnBgp(plan *cfgTxnPlan) {
+	if mh.bgp == nil {
+		return
+	}
+	for _, ent := range plan.ents {
+		if ent.lb == nil || !ent.lb.Serv.Bgp {
+			continue
+		}
+		ips := []string{ent.lb.Serv.ServIP}
+		for _, sip := range ent.lb.SecIPs {
+			ips = append(ips, sip.SecIP)
+		}
+		if ent.op == cfgTxnDelete {
+			mh.bgp.DelBGPRule("default", ips)
+		} else {
+			mh.bgp.AddBGPRule("default", ips)
+		}
+	}
+}
+
+// ConfigGen - Get the current config generation
+func (R *RuleH) ConfigGen() uint64 {
+	return R.cfgGen
+}
+
+// ConfigGenBump - Move to the next config generation after a committed change
+func (R *RuleH) ConfigGenBump() uint64 {
+	R.cfgGen++
+	return R.cfgGen
+}
+
+// ConfigTxnCommit - Validate and apply a bulk configuration transaction.
+// All objects of the transaction are validated before anything is applied.
+// If any change fails, either while being applied or in the datapath, all
+// changes done so far are reverted. On success, it will return the new
+// config generation and the changes done, else appropriate return code and
+// error string will be set
+func (R *RuleH) ConfigTxnCommit(txn *cmn.ConfigTxnMod) (cmn.ConfigTxnResult, int, error) {
+	var res cmn.ConfigTxnResult
+
+	if txn.Generation != 0 && txn.Generation != R.cfgGen {
+		return res, RuleCfgGenErr, errors.New("txn-generation mismatch error")
+	}
+
+	plan, ret, err := R.cfgTxnMkPlan(txn)
+	if err != nil {
+		return res, ret, err
+	}
+
+	var applied []*cfgTxnEnt
+	for _, ent := range plan.ents {
+		ret, err = R.cfgTxnApply(ent)
+		if err != nil {
+			if ent.op == cfgTxnUpdate && ret == RuleExistsErr && ent.lb != nil {
+				// Nothing to be changed in the end
+				err = nil
+				continue
+			}
+			tk.LogIt(tk.LogError, "config txn - %s failed: %s\n", ent.name, err)
+			break
+		}
+		applied = append(applied, ent)
+	}
+
+	if err == nil {
+		if !mh.dp.DpWorkSync(CfgTxnDpTimeout) {
+			ret, err = RuleAllocErr, errors.New("txn-dp timeout error")
+		}
+		for _, ent := range applied {
+			if err != nil {
+				break
+			}
+			if ent.rule != nil && ent.rule.sync != 0 {
+				tk.LogIt(tk.LogError, "config txn - %s dp failed\n", ent.name)
+				ret, err = RuleAllocErr, errors.New("txn-dp error")
+			}
+		}
+	}
+
+	if err != nil {
+		for i := len(applied) - 1; i >= 0; i-- {
+			if uErr := R.cfgTxnUndo(applied[i]); uErr != nil {
+				tk.LogIt(tk.LogError, "config txn - %s rollback failed: %s\n", applied[i].name, uErr)
+			}
+		}
+		return res, ret, err
+	}
+
+	plan.ents = applied
+	cfgTxnBgp(plan)
+
+	res = plan.result()
+	res.Generation = R.ConfigGenBump()
+
+	tk.LogIt(tk.LogDebug, "config txn committed - generation %d (%d changes)\n", res.Generation, len(applied))
+
+	return res, 0, nil
+}
+
+// ReconcileNatLbRules - Make the lb rules match the complete set of lb rules
+// given. Rules which are not a part of the set are deleted (except the ones
+// owned by firewall snat rules), new ones are added and the rest are updated
+// only if they differ. Unchanged rules are left as-is along with their active
+// connections. With dryRun, the changes which would be done are returned
+// without applying them. Otherwise it is committed as a config transaction
+func (R *RuleH) ReconcileNatLbRules(lbRules []cmn.LbRuleMod, dryRun bool) (cmn.ConfigTxnResult, int, error) {
+	txn := cmn.ConfigTxnMod{LbRules: lbRules}
+
+	want := make(map[string]struct{})
+	for _, lm := range lbRules {
+		want[cfgTxnLbName(lm.Serv)] = struct{}{}
+	}
+	for _, rule := range R.tables[RtLB].eMap {
+		if rule.fwSnatOwned() {
+			continue
+		}
+		lm, err := rule.natLbRuleMod()
+		if err != nil {
+			return cmn.ConfigTxnResult{}, RuleArgsErr, err
+		}
+		if _, found := want[cfgTxnLbName(lm.Serv)]; !found {
+			txn.LbRulesDel = append(txn.LbRulesDel, lm)
+		}
+	}
+	sort.SliceStable(txn.LbRulesDel, func(i, j int) bool {
+		return cfgTxnLbName(txn.LbRulesDel[i].Serv) < cfgTxnLbName(txn.LbRulesDel[j].Serv)
+	})
+
+	if !dryRun {
+		return R.ConfigTxnCommit(&txn)
+	}
+
+	plan, ret, err := R.cfgTxnMkPlan(&txn)
+	if err != nil {
+		return cmn.ConfigTxnResult{}, ret, err
+	}
+	res := plan.result()
+	res.Generation = R.cfgGen
+	return res, 0, nil
+}
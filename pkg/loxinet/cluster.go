@@ -18,12 +18,10 @@ package loxinet
 
 import (
 	"errors"
-	nlp "github.com/loxilb-io/loxilb/api/loxinlp"
 	cmn "github.com/loxilb-io/loxilb/common"
 	bfd "github.com/loxilb-io/loxilb/pkg/proto"
 	tk "github.com/loxilb-io/loxilib"
 	"net"
-	"os"
 	"time"
 )
 
@@ -112,8 +110,14 @@ func (h *CIStateH) CITicker() {
 func (ci *CIStateH) CISpawn() {
 	bs := bfd.StructNew(3784)
 	ci.Bs = bs
-	if _, err := os.Stat("/etc/loxilb/BFDconfig.txt"); !errors.Is(err, os.ErrNotExist) {
-		nlp.ApplyBFDConfig()
+	if bfds := mh.cfgStore.BootBFD(); len(bfds) > 0 {
+		mh.mtx.Lock()
+		for _, bm := range bfds {
+			if _, err := ci.CIBFDSessionAdd(bm); err != nil {
+				tk.LogIt(tk.LogError, "KA - BFD remote %s restore failed: %s\n", bm.RemoteIP.String(), err)
+			}
+		}
+		mh.mtx.Unlock()
 		return
 	}
 
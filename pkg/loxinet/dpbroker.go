@@ -41,6 +41,8 @@ const (
 	MapNameULCL = "ULCL"
 	MapNameIpol = "IPOL"
 	MapNameFw4  = "FW4"
+	// MapNameNatRej - stats of new connections rejected due to nat-lb rule limits
+	MapNameNatRej = "NATREJ"
 )
 
 // error codes
@@ -182,10 +184,12 @@ type StatDpWorkQ struct {
 	DropPackets *uint64
 }
 
-// TableDpWorkQ - work queue entry for map related operation
+// TableDpWorkQ - work queue entry for map related operation. Rules, if set,
+// limits ct map entries got to the ones of these lb rules
 type TableDpWorkQ struct {
-	Work DpWorkT
-	Name string
+	Work  DpWorkT
+	Name  string
+	Rules map[uint32]bool
 }
 
 // PolDpWorkQ - work queue entry for policer related operation
@@ -222,23 +226,41 @@ const (
 
 // FwDpWorkQ - work queue entry for fw related operation
 type FwDpWorkQ struct {
-	Work     DpWorkT
-	Status   *DpStatusT
-	ZoneNum  int
-	SrcIP    net.IPNet
-	DstIP    net.IPNet
-	L4SrcMin uint16
-	L4SrcMax uint16
-	L4DstMin uint16
-	L4DstMax uint16
-	Port     uint16
-	Pref     uint16
-	Proto    uint8
-	Mark     int
-	FwType   FwOpT
-	FwVal1   uint16
-	FwVal2   uint32
-	FwRecord bool
+	Work      DpWorkT
+	Status    *DpStatusT
+	ZoneNum   int
+	SrcIP     net.IPNet
+	DstIP     net.IPNet
+	SrcSet    string
+	SrcSetIPs []net.IPNet
+	DstSet    string
+	DstSetIPs []net.IPNet
+	L4SrcMin  uint16
+	L4SrcMax  uint16
+	L4DstMin  uint16
+	L4DstMax  uint16
+	Port      uint16
+	Pref      uint16
+	Proto     uint8
+	Mark      int
+	FwType    FwOpT
+	FwVal1    uint16
+	FwVal2    uint32
+	FwRecord  bool
+}
+
+// FwLogDpInfo - a hit on a firewall entry marked record as reported by datapath
+type FwLogDpInfo struct {
+	Time   time.Time
+	Mark   int
+	Pref   uint16
+	FwType FwOpT
+	OsPort int
+	Proto  string
+	SIP    net.IP
+	DIP    net.IP
+	Sport  uint16
+	Dport  uint16
 }
 
 // NatT - type of NAT
@@ -265,6 +287,7 @@ const (
 	EpRRPersist
 	EpLeastConn
 	EpN2
+	EpMaglev
 )
 
 // NatEP - a nat end-point
@@ -276,6 +299,43 @@ type NatEP struct {
 	InActive bool
 }
 
+// NatEpStatMark - stats mark of an end-point of a nat rule
+func NatEpStatMark(ruleNum uint32, epIdx int) uint32 {
+	return (ruleNum&0xfff)<<4 | uint32(epIdx)&0xf
+}
+
+// NatDpPortExpand - Expand a nat work entry for a port range in one entry per
+// service port. End-points with zero port preserve the service port while others
+// are mapped as per the offset of the service port in the range. A range with more
+// than MaxLbPortRange ports is not expanded and nil is returned
+func NatDpPortExpand(w *NatDpWorkQ) []*NatDpWorkQ {
+	if w.L4PortMax <= w.L4Port {
+		return []*NatDpWorkQ{w}
+	}
+	if int(w.L4PortMax-w.L4Port) >= MaxLbPortRange {
+		return nil
+	}
+
+	var nws []*NatDpWorkQ
+	for port := int(w.L4Port); port <= int(w.L4PortMax); port++ {
+		nw := new(NatDpWorkQ)
+		*nw = *w
+		nw.L4Port = uint16(port)
+		nw.L4PortMax = 0
+		nw.endPoints = make([]NatEP, len(w.endPoints))
+		for i, ep := range w.endPoints {
+			if ep.XPort == 0 {
+				ep.XPort = uint16(port)
+			} else {
+				ep.XPort += uint16(port) - w.L4Port
+			}
+			nw.endPoints[i] = ep
+		}
+		nws = append(nws, nw)
+	}
+	return nws
+}
+
 // NatDpWorkQ - work queue entry for nat related operation
 type NatDpWorkQ struct {
 	Work      DpWorkT
@@ -283,6 +343,7 @@ type NatDpWorkQ struct {
 	ZoneNum   int
 	ServiceIP net.IP
 	L4Port    uint16
+	L4PortMax uint16
 	BlockNum  uint16
 	DsrMode   bool
 	CsumDis   bool
@@ -293,7 +354,11 @@ type NatDpWorkQ struct {
 	NatType   NatT
 	EpSel     NatSel
 	InActTo   uint64
+	MaxConns  uint32
+	MaxConnEp uint32
+	ConnRate  uint32
 	PersistTo uint64
+	MaglevTbl []uint16
 	endPoints []NatEP
 	secIP     []net.IP
 }
@@ -371,6 +436,12 @@ type SockVIPDpWorkQ struct {
 	Status *DpStatusT
 }
 
+// DpSyncWorkQ - work queue entry which marks the point till which all
+// queued work has been carried out
+type DpSyncWorkQ struct {
+	Done chan struct{}
+}
+
 // DpSyncOpT - Sync Operation type
 type DpSyncOpT uint8
 
@@ -813,6 +884,8 @@ func DpWorkSingle(dp *DpH, m interface{}) DpRetT {
 		ret = dp.DpWorkOnPeerOp(mq)
 	case *SockVIPDpWorkQ:
 		ret = dp.DpWorkOnSockVIP(mq)
+	case *DpSyncWorkQ:
+		close(mq.Done)
 	default:
 		tk.LogIt(tk.LogError, "unexpected type %T\n", mq)
 		ret = DpWqUnkErr
@@ -820,6 +893,20 @@ func DpWorkSingle(dp *DpH, m interface{}) DpRetT {
 	return ret
 }
 
+// DpWorkSync - wait till all work queued so far is carried out by the DP worker.
+// It returns false if this does not happen within the given timeout
+func (dp *DpH) DpWorkSync(timeout time.Duration) bool {
+	sWq := &DpSyncWorkQ{Done: make(chan struct{})}
+	dp.ToDpCh <- sWq
+
+	select {
+	case <-sWq.Done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
 // DpWorker - DP worker routine listening on a channel
 func DpWorker(dp *DpH, f chan int, ch chan interface{}) {
 	// Stack trace logger
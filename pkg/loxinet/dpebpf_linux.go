@@ -105,6 +105,8 @@ const (
 	blkCtiMaxLen         = 8192
 	mapNotifierChLen     = 8096
 	mapNotifierWorkers   = 1
+	fwRecIntf            = "llb0"
+	fwRecMaxPktLen       = 9216
 )
 
 // ebpf table related defines in go
@@ -166,6 +168,47 @@ type DpEbpfH struct {
 	ctMap   map[string]*DpCtInfo
 }
 
+// dpFwRecRead - read packets recorded by eBPF dp off fwRecIntf and report
+// them as hits on firewall rules marked record
+func dpFwRecRead() {
+	var ifi *net.Interface
+	var err error
+
+	for {
+		if ifi, err = net.InterfaceByName(fwRecIntf); err == nil {
+			break
+		}
+		time.Sleep(dpEbpfLinuxTiVal * time.Second)
+	}
+
+	proto := int(tk.Htons(syscall.ETH_P_ALL))
+	fd, err := syscall.Socket(syscall.AF_PACKET, syscall.SOCK_RAW, proto)
+	if err != nil {
+		tk.LogIt(tk.LogError, "fw record - %s socket failed (%s)\n", fwRecIntf, err)
+		return
+	}
+	defer syscall.Close(fd)
+
+	err = syscall.Bind(fd, &syscall.SockaddrLinklayer{Protocol: uint16(proto), Ifindex: ifi.Index})
+	if err != nil {
+		tk.LogIt(tk.LogError, "fw record - %s bind failed (%s)\n", fwRecIntf, err)
+		return
+	}
+
+	buf := make([]byte, fwRecMaxPktLen)
+	for {
+		n, _, err := syscall.Recvfrom(fd, buf, 0)
+		if err != nil {
+			if err == syscall.EINTR {
+				continue
+			}
+			tk.LogIt(tk.LogError, "fw record - %s read failed (%s)\n", fwRecIntf, err)
+			return
+		}
+		FwLogRecPkt(0, buf[:n])
+	}
+}
+
 // dpEbpfTicker - this ticker routine runs every DpEbpfLinuxTiVal seconds
 func dpEbpfTicker() {
 
@@ -344,6 +387,7 @@ func DpEbpfInit(clusterEn, rssEn, egrHooks, localSockPolicy, sockMapEn bool, nod
 	ne.nID = uint((C.LLB_CT_MAP_ENTRIES / C.LLB_MAX_LB_NODES) * nodeNum)
 
 	go dpEbpfTicker()
+	go dpFwRecRead()
 	for i := 0; i < mapNotifierWorkers; i++ {
 		go dpMapNotifierWorker(ne.ToFinCh[i], ne.ToMapCh)
 	}
@@ -1013,6 +1057,12 @@ func DpNatLbRuleMod(w *NatDpWorkQ) int {
 		dat.ca.oaux = 1
 	}
 
+	/* End-points are limited to LLB_MAX_NXFRMS in eBPF DP */
+	if len(w.endPoints) > C.LLB_MAX_NXFRMS {
+		tk.LogIt(tk.LogError, "[DP] LB rule %s add[NOK] - too many end-points(%d)\n", w.ServiceIP.String(), len(w.endPoints))
+		return EbpfErrNat4Add
+	}
+
 	nxfa := (*nxfrmAct)(unsafe.Pointer(&dat.nxfrms[0]))
 
 	for _, k := range w.endPoints {
@@ -1082,7 +1132,21 @@ func DpNatLbRuleMod(w *NatDpWorkQ) int {
 
 // DpNatLbRuleAdd - routine to work on a ebpf nat-lb add request
 func (e *DpEbpfH) DpNatLbRuleAdd(w *NatDpWorkQ) int {
-	ec := DpNatLbRuleMod(w)
+	nws := NatDpPortExpand(w)
+	ec := 0
+	if len(nws) == 0 {
+		ec = EbpfErrNat4Add
+	}
+	for i, nw := range nws {
+		if ec = DpNatLbRuleMod(nw); ec != 0 {
+			// Roll back the ports of the range which are already added
+			for _, aw := range nws[:i] {
+				aw.Work = DpRemove
+				DpNatLbRuleMod(aw)
+			}
+			break
+		}
+	}
 	if ec != 0 {
 		*w.Status = DpCreateErr
 	} else {
@@ -1093,7 +1157,13 @@ func (e *DpEbpfH) DpNatLbRuleAdd(w *NatDpWorkQ) int {
 
 // DpNatLbRuleDel - routine to work on a ebpf nat-lb delete request
 func (e *DpEbpfH) DpNatLbRuleDel(w *NatDpWorkQ) int {
-	return DpNatLbRuleMod(w)
+	ec := 0
+	for _, nw := range NatDpPortExpand(w) {
+		if ret := DpNatLbRuleMod(nw); ret != 0 {
+			ec = ret
+		}
+	}
+	return ec
 }
 
 // DpStat - routine to work on a ebpf map statistics request
@@ -1512,7 +1582,9 @@ func (e *DpEbpfH) DpTableGet(w *TableDpWorkQ) (DpRetT, error) {
 
 			act = &tact.ctd
 
-			if act.dir == C.CT_DIR_IN || act.dir == C.CT_DIR_OUT {
+			// Only entries of the asked for lb rules are converted if so asked
+			if (act.dir == C.CT_DIR_IN || act.dir == C.CT_DIR_OUT) &&
+				(w.Rules == nil || w.Rules[uint32(act.rid)]) {
 				var b, p uint64
 				goCt4Ent := new(DpCtInfo)
 				goCt4Ent.convDPCt2GoObjFixup(ctKey, act, true)
@@ -1533,6 +1605,9 @@ func (e *DpEbpfH) DpTableGet(w *TableDpWorkQ) (DpRetT, error) {
 		proxyCtInfo = nil
 		C.llb_trigger_get_proxy_entries()
 		for e, proxyCt := range proxyCtInfo {
+			if w.Rules != nil && !w.Rules[proxyCt.RuleID] {
+				continue
+			}
 			ePCT := ctMap[proxyCt.Key()]
 			if ePCT != nil {
 				if e > 0 {
@@ -1733,6 +1808,12 @@ func (e *DpEbpfH) DpFwRuleMod(w *FwDpWorkQ) int {
 
 	C.memset(unsafe.Pointer(fwe), 0, C.sizeof_struct_dp_fwv4_ent)
 
+	/* IP sets are expanded into an entry per member before reaching eBPF DP */
+	if w.Work == DpCreate && (w.SrcSet != "" || w.DstSet != "") {
+		tk.LogIt(tk.LogError, "[DP] FW rule %d add[NOK] - ip sets not supported\n", w.Mark)
+		return EbpfErrFwAdd
+	}
+
 	if len(w.DstIP.IP) != 0 {
 		fwe.k.dest.val = C.uint(tk.Ntohl(tk.IPtonl(w.DstIP.IP)))
 		fwe.k.dest.valid = C.uint(tk.Ntohl(tk.IPtonl(net.IP(w.DstIP.Mask))))
@@ -1954,7 +2035,7 @@ func dpCTMapNotifierWorker(cti *DpCtInfo) {
 			return
 		}
 		cti.ServiceIP = r.tuples.l3Dst.addr.IP
-		cti.L4ServPort = r.tuples.l4Dst.val
+		cti.L4ServPort, _ = r.tuples.l4DstPorts()
 		cti.BlockNum = r.tuples.pref
 		cti.CI = r.ci
 		if r.tuples.l4Prot.val == 6 {
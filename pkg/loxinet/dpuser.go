@@ -0,0 +1,1325 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements the interface DpHookInterface
+// The implementation is a pure-go userspace reference datapath. All tables are
+// kept in memory and packets fed in as byte slices are evaluated against them.
+// It does not need any special privileges and is mainly meant for testing
+
+// error codes
+const (
+	UserDpErrBase = iota - 60000
+	UserDpErrPortPropAdd
+	UserDpErrPortPropDel
+	UserDpErrL2AddrAdd
+	UserDpErrL2AddrDel
+	UserDpErrTmacAdd
+	UserDpErrTmacDel
+	UserDpErrNhAdd
+	UserDpErrNhDel
+	UserDpErrRtAdd
+	UserDpErrRtDel
+	UserDpErrNatAdd
+	UserDpErrNatDel
+	UserDpErrSessAdd
+	UserDpErrSessDel
+	UserDpErrPolAdd
+	UserDpErrPolDel
+	UserDpErrMirrAdd
+	UserDpErrMirrDel
+	UserDpErrFwAdd
+	UserDpErrFwDel
+	UserDpErrCtAdd
+	UserDpErrCtDel
+	UserDpErrSockVIPAdd
+	UserDpErrSockVIPDel
+	UserDpErrPktParse
+	UserDpErrWqUnk
+)
+
+// constants
+const (
+	userDpEthHdrLen   = 14
+	userDpVlanHdrLen  = 4
+	userDpIP6HdrLen   = 40
+	userDpIP6FragHdr  = 44
+	userDpEthTypeIPv4 = 0x0800
+	userDpEthTypeIPv6 = 0x86dd
+	userDpEthTypeVlan = 0x8100
+)
+
+// UserDpActT - verdict of the userspace datapath for a packet
+type UserDpActT uint8
+
+// userspace datapath verdicts
+const (
+	UserDpActDrop UserDpActT = iota + 1
+	UserDpActFwd
+	UserDpActTrap
+	UserDpActRdr
+)
+
+// UserDpPktResult - result of a packet evaluated by the userspace datapath
+type UserDpPktResult struct {
+	Act     UserDpActT
+	Pkt     []byte
+	OutPort int
+	NhNum   int
+	FwMark  int
+	NatMark int
+	EpIdx   int
+}
+
+type userDpRate struct {
+	tokens float64
+	last   time.Time
+}
+
+type userDpStat struct {
+	packets     uint64
+	bytes       uint64
+	dropPackets uint64
+}
+
+type userDpPkt struct {
+	buf      []byte
+	l3Off    int
+	l4Off    int
+	vlan     int
+	v6       bool
+	proto    uint8
+	sip      net.IP
+	dip      net.IP
+	sport    uint16
+	dport    uint16
+	tcpFlag  uint8
+	icmpType uint8
+	icmpCode uint8
+	l3Len    uint16
+	frag     bool
+}
+
+type userDpCt struct {
+	info   *DpCtInfo
+	rev    bool
+	natKey string
+	epIdx  int
+	xIP    net.IP
+	xPort  uint16
+	rIP    net.IP
+	vIP    net.IP
+	vPort  uint16
+	cIP    net.IP
+	cPort  uint16
+	ito    time.Duration
+}
+
+// DpUserH - context container
+type DpUserH struct {
+	mtx      sync.RWMutex
+	xhMtx    sync.Mutex
+	ports    map[string]*PortDpWorkQ
+	l2Addrs  map[string]*L2AddrDpWorkQ
+	rtMacs   map[string]*RouterMacDpWorkQ
+	nhs      map[int]*NextHopDpWorkQ
+	routes   map[string]*RouteDpWorkQ
+	nats     map[string]*NatDpWorkQ
+	fws      map[int]*FwDpWorkQ
+	ulcls    map[string]*UlClDpWorkQ
+	pols     map[int]*PolDpWorkQ
+	mirrs    map[int]*MirrDpWorkQ
+	sockVIPs map[string]*SockVIPDpWorkQ
+	ctMap    map[string]*userDpCt
+	stats    map[string]*userDpStat
+	rrIdx    map[string]int
+	connRate map[int]*userDpRate
+}
+
+// DpUserInit - initialize the userspace dp subsystem
+func DpUserInit() *DpUserH {
+	ne := new(DpUserH)
+	ne.ports = make(map[string]*PortDpWorkQ)
+	ne.l2Addrs = make(map[string]*L2AddrDpWorkQ)
+	ne.rtMacs = make(map[string]*RouterMacDpWorkQ)
+	ne.nhs = make(map[int]*NextHopDpWorkQ)
+	ne.routes = make(map[string]*RouteDpWorkQ)
+	ne.nats = make(map[string]*NatDpWorkQ)
+	ne.fws = make(map[int]*FwDpWorkQ)
+	ne.ulcls = make(map[string]*UlClDpWorkQ)
+	ne.pols = make(map[int]*PolDpWorkQ)
+	ne.mirrs = make(map[int]*MirrDpWorkQ)
+	ne.sockVIPs = make(map[string]*SockVIPDpWorkQ)
+	ne.ctMap = make(map[string]*userDpCt)
+	ne.stats = make(map[string]*userDpStat)
+	ne.rrIdx = make(map[string]int)
+	ne.connRate = make(map[int]*userDpRate)
+
+	tk.LogIt(tk.LogInfo, "userspace dp init\n")
+
+	return ne
+}
+
+// DpEbpfUnInit - uninitialize the userspace dp subsystem
+func (e *DpUserH) DpEbpfUnInit() {
+	tk.LogIt(tk.LogInfo, "userspace dp uninit\n")
+}
+
+func setUserDpStatus(status *DpStatusT, ec int, errStatus DpStatusT) int {
+	if status != nil {
+		if ec != 0 {
+			*status = errStatus
+		} else {
+			*status = 0
+		}
+	}
+	return ec
+}
+
+func userDpPortKey(osPortNum int, vlan int) string {
+	return fmt.Sprintf("%d:%d", osPortNum, vlan)
+}
+
+func userDpL2Key(addr [6]uint8, bd int) string {
+	return fmt.Sprintf("%s:%d", net.HardwareAddr(addr[:]).String(), bd)
+}
+
+func userDpRtKey(zone int, dst net.IPNet) string {
+	return fmt.Sprintf("%d:%s", zone, dst.String())
+}
+
+func userDpNatKey(zone int, serviceIP net.IP, port uint16, proto uint8, block uint16) string {
+	return fmt.Sprintf("%d:%s:%d:%d:%d", zone, serviceIP.String(), port, proto, block)
+}
+
+func userDpSnatKey(mark uint16) string {
+	return fmt.Sprintf("snat:%d", mark)
+}
+
+func userDpStatKey(name string, mark uint32) string {
+	return fmt.Sprintf("%s:%d", name, mark)
+}
+
+// DpPortPropAdd - routine to work on a userspace dp port add request
+func (e *DpUserH) DpPortPropAdd(w *PortDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.ports[userDpPortKey(w.OsPortNum, w.IngVlan)] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpPortPropDel - routine to work on a userspace dp port delete request
+func (e *DpUserH) DpPortPropDel(w *PortDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.ports, userDpPortKey(w.OsPortNum, w.IngVlan))
+	return 0
+}
+
+// DpL2AddrAdd - routine to work on a userspace dp l2 addr add request
+func (e *DpUserH) DpL2AddrAdd(w *L2AddrDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.l2Addrs[userDpL2Key(w.L2Addr, w.BD)] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpL2AddrDel - routine to work on a userspace dp l2 addr delete request
+func (e *DpUserH) DpL2AddrDel(w *L2AddrDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.l2Addrs, userDpL2Key(w.L2Addr, w.BD))
+	return 0
+}
+
+// DpRouterMacAdd - routine to work on a userspace dp rt-mac add request
+func (e *DpUserH) DpRouterMacAdd(w *RouterMacDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.rtMacs[userDpL2Key(w.L2Addr, w.PortNum)] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpRouterMacDel - routine to work on a userspace dp rt-mac delete request
+func (e *DpUserH) DpRouterMacDel(w *RouterMacDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.rtMacs, userDpL2Key(w.L2Addr, w.PortNum))
+	return 0
+}
+
+// DpNextHopAdd - routine to work on a userspace dp nexthop add request
+func (e *DpUserH) DpNextHopAdd(w *NextHopDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.nhs[w.NextHopNum] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpNextHopDel - routine to work on a userspace dp nexthop delete request
+func (e *DpUserH) DpNextHopDel(w *NextHopDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.nhs, w.NextHopNum)
+	return 0
+}
+
+// DpRouteAdd - routine to work on a userspace dp route add request
+func (e *DpUserH) DpRouteAdd(w *RouteDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.routes[userDpRtKey(w.ZoneNum, w.Dst)] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpRouteDel - routine to work on a userspace dp route delete request
+func (e *DpUserH) DpRouteDel(w *RouteDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.routes, userDpRtKey(w.ZoneNum, w.Dst))
+	if w.RtMark > 0 {
+		delete(e.stats, userDpStatKey(MapNameRt4, uint32(w.RtMark)))
+	}
+	return 0
+}
+
+func (e *DpUserH) natKeyOf(w *NatDpWorkQ) string {
+	if w.NatType == DpSnat {
+		return userDpSnatKey(w.BlockNum | 0x1000)
+	}
+	return userDpNatKey(w.ZoneNum, w.ServiceIP, w.L4Port, w.Proto, w.BlockNum)
+}
+
+// DpNatLbRuleAdd - routine to work on a userspace dp nat-lb add request
+func (e *DpUserH) DpNatLbRuleAdd(w *NatDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if w.NatType != DpSnat && w.NatType != DpDnat &&
+		w.NatType != DpFullNat && w.NatType != DpFullProxy {
+		return setUserDpStatus(w.Status, UserDpErrNatAdd, DpCreateErr)
+	}
+
+	pws := NatDpPortExpand(w)
+	if len(pws) == 0 {
+		return setUserDpStatus(w.Status, UserDpErrNatAdd, DpCreateErr)
+	}
+	for _, pw := range pws {
+		nw := new(NatDpWorkQ)
+		*nw = *pw
+		nw.endPoints = append([]NatEP(nil), pw.endPoints...)
+		nw.secIP = append([]net.IP(nil), pw.secIP...)
+		e.nats[e.natKeyOf(nw)] = nw
+	}
+
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpNatLbRuleDel - routine to work on a userspace dp nat-lb delete request
+func (e *DpUserH) DpNatLbRuleDel(w *NatDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.connRate, w.Mark)
+	delete(e.stats, userDpStatKey(MapNameNatRej, uint32(w.Mark)))
+	for _, pw := range NatDpPortExpand(w) {
+		natKey := e.natKeyOf(pw)
+		delete(e.nats, natKey)
+		delete(e.rrIdx, natKey)
+		for ctKey, ct := range e.ctMap {
+			if ct.natKey == natKey {
+				delete(e.ctMap, ctKey)
+			}
+		}
+	}
+	return 0
+}
+
+// DpFwRuleAdd - routine to work on a userspace dp fw add request
+func (e *DpUserH) DpFwRuleAdd(w *FwDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.fws[w.Mark] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpFwRuleDel - routine to work on a userspace dp fw delete request
+func (e *DpUserH) DpFwRuleDel(w *FwDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.fws, w.Mark)
+	delete(e.stats, userDpStatKey(MapNameFw4, uint32(w.Mark)))
+	return 0
+}
+
+// DpUlClAdd - routine to work on a userspace dp ulcl filter add request
+func (e *DpUserH) DpUlClAdd(w *UlClDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.ulcls[fmt.Sprintf("%d:%s", w.Zone, w.MDip.String())] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpUlClDel - routine to work on a userspace dp ulcl filter delete request
+func (e *DpUserH) DpUlClDel(w *UlClDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.ulcls, fmt.Sprintf("%d:%s", w.Zone, w.MDip.String()))
+	return 0
+}
+
+// DpPolAdd - routine to work on a userspace dp policer add request
+func (e *DpUserH) DpPolAdd(w *PolDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.pols[w.Mark] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpPolDel - routine to work on a userspace dp policer delete request
+func (e *DpUserH) DpPolDel(w *PolDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.pols, w.Mark)
+	delete(e.stats, userDpStatKey(MapNameIpol, uint32(w.Mark)))
+	return 0
+}
+
+// DpMirrAdd - routine to work on a userspace dp mirror add request
+func (e *DpUserH) DpMirrAdd(w *MirrDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.mirrs[w.Mark] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpMirrDel - routine to work on a userspace dp mirror delete request
+func (e *DpUserH) DpMirrDel(w *MirrDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.mirrs, w.Mark)
+	return 0
+}
+
+// DpSockVIPAdd - routine to work on a userspace dp local VIP-port rewrite add request
+func (e *DpUserH) DpSockVIPAdd(w *SockVIPDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.sockVIPs[fmt.Sprintf("%s:%d", w.VIP.String(), w.Port)] = w
+	return setUserDpStatus(w.Status, 0, DpCreateErr)
+}
+
+// DpSockVIPDel - routine to work on a userspace dp local VIP-port rewrite delete request
+func (e *DpUserH) DpSockVIPDel(w *SockVIPDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	delete(e.sockVIPs, fmt.Sprintf("%s:%d", w.VIP.String(), w.Port))
+	return 0
+}
+
+// DpStat - routine to work on a userspace dp statistics request
+func (e *DpUserH) DpStat(w *StatDpWorkQ) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	switch w.Name {
+	case MapNameNat4, MapNameBD, MapNameRxBD, MapNameTxBD, MapNameRt4,
+		MapNameULCL, MapNameIpol, MapNameFw4, MapNameNatRej:
+	default:
+		return UserDpErrWqUnk
+	}
+
+	sKey := userDpStatKey(w.Name, w.Mark)
+	if w.Work == DpStatsGet || w.Work == DpStatsGetImm {
+		st := e.stats[sKey]
+		if st == nil {
+			return 0
+		}
+		if w.Packets != nil {
+			*w.Packets = st.packets
+		}
+		if w.Bytes != nil {
+			*w.Bytes = st.bytes
+		}
+		if w.DropPackets != nil {
+			*w.DropPackets = st.dropPackets
+		}
+	} else if w.Work == DpStatsClr {
+		delete(e.stats, sKey)
+	}
+
+	return 0
+}
+
+// DpTableGet - routine to work on a userspace dp table get request
+func (e *DpUserH) DpTableGet(w *TableDpWorkQ) (DpRetT, error) {
+	if w.Work != DpMapGet || w.Name != MapNameCt4 {
+		return UserDpErrWqUnk, errors.New("unknown work type")
+	}
+
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	ctMap := make(map[string]*DpCtInfo)
+	for _, ct := range e.ctMap {
+		if ct.rev || (w.Rules != nil && !w.Rules[ct.info.RuleID]) {
+			continue
+		}
+		cti := new(DpCtInfo)
+		*cti = *ct.info
+		ctMap[cti.Key()] = cti
+	}
+
+	return ctMap, nil
+}
+
+// DpCtAdd - routine to work on a userspace dp ct add request
+func (e *DpUserH) DpCtAdd(w *DpCtInfo) int {
+	if w.CState != "est" {
+		return UserDpErrCtAdd
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	cti := new(DpCtInfo)
+	*cti = *w
+	cti.NTs = time.Now()
+	cti.LTs = cti.NTs
+	e.ctMap[cti.Key()] = &userDpCt{info: cti, ito: LbDefaultInactiveTimeout * time.Second}
+
+	return 0
+}
+
+// DpCtDel - routine to work on a userspace dp ct delete request
+func (e *DpUserH) DpCtDel(w *DpCtInfo) int {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.ctMap[w.Key()] == nil {
+		tk.LogIt(tk.LogDebug, "ctInfo-key (%v) not present\n", w.Key())
+		return 0
+	}
+	delete(e.ctMap, w.Key())
+
+	return 0
+}
+
+// DpCtGetAsync - routine to work on a userspace dp ct get async request
+// Conntrack entries of userspace dp are never synced with peers
+func (e *DpUserH) DpCtGetAsync() {
+}
+
+// DpGetLock - routine to take underlying DP lock
+func (e *DpUserH) DpGetLock() {
+	e.xhMtx.Lock()
+}
+
+// DpRelLock - routine to release underlying DP lock
+func (e *DpUserH) DpRelLock() {
+	e.xhMtx.Unlock()
+}
+
+// DpTableGC - Work on table garbage collection
+func (e *DpUserH) DpTableGC() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for key, ct := range e.ctMap {
+		if ct.ito != 0 && time.Since(ct.info.LTs) > ct.ito {
+			delete(e.ctMap, key)
+		}
+	}
+}
+
+// DpUserNatGet - get a copy of the nat-lb rule programmed in userspace dp
+func (e *DpUserH) DpUserNatGet(zone int, serviceIP net.IP, port uint16, proto uint8, block uint16) *NatDpWorkQ {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	w := e.nats[userDpNatKey(zone, serviceIP, port, proto, block)]
+	if w == nil {
+		return nil
+	}
+	nw := new(NatDpWorkQ)
+	*nw = *w
+	nw.endPoints = append([]NatEP(nil), w.endPoints...)
+	return nw
+}
+
+// DpUserFwGet - get a copy of the fw rule programmed in userspace dp
+func (e *DpUserH) DpUserFwGet(mark int) *FwDpWorkQ {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	w := e.fws[mark]
+	if w == nil {
+		return nil
+	}
+	nw := new(FwDpWorkQ)
+	*nw = *w
+	return nw
+}
+
+func (e *DpUserH) statAdd(name string, mark uint32, bytes int, drop bool) {
+	sKey := userDpStatKey(name, mark)
+	st := e.stats[sKey]
+	if st == nil {
+		st = new(userDpStat)
+		e.stats[sKey] = st
+	}
+	st.packets++
+	st.bytes += uint64(bytes)
+	if drop {
+		st.dropPackets++
+	}
+}
+
+func parseUserDpPkt(buf []byte) (*userDpPkt, error) {
+	p := new(userDpPkt)
+	p.buf = buf
+
+	if len(buf) < userDpEthHdrLen {
+		return nil, errors.New("short packet")
+	}
+	off := 12
+	ethType := binary.BigEndian.Uint16(buf[off:])
+	off += 2
+	if ethType == userDpEthTypeVlan {
+		if len(buf) < userDpEthHdrLen+userDpVlanHdrLen {
+			return nil, errors.New("short vlan packet")
+		}
+		p.vlan = int(binary.BigEndian.Uint16(buf[off:]) & 0xfff)
+		ethType = binary.BigEndian.Uint16(buf[off+2:])
+		off += userDpVlanHdrLen
+	}
+	p.l3Off = off
+
+	switch ethType {
+	case userDpEthTypeIPv4:
+		if len(buf) < off+20 || buf[off]>>4 != 4 {
+			return nil, errors.New("malformed ipv4 packet")
+		}
+		ihl := int(buf[off]&0xf) * 4
+		if ihl < 20 || len(buf) < off+ihl {
+			return nil, errors.New("malformed ipv4 header")
+		}
+		p.proto = buf[off+9]
+		p.sip = net.IP(append([]byte(nil), buf[off+12:off+16]...))
+		p.dip = net.IP(append([]byte(nil), buf[off+16:off+20]...))
+		p.l4Off = off + ihl
+		p.l3Len = binary.BigEndian.Uint16(buf[off+2:])
+		p.frag = binary.BigEndian.Uint16(buf[off+6:])&0x3fff != 0
+		if binary.BigEndian.Uint16(buf[off+6:])&0x1fff != 0 {
+			// Non-first fragments carry no l4 header
+			return p, nil
+		}
+	case userDpEthTypeIPv6:
+		if len(buf) < off+userDpIP6HdrLen || buf[off]>>4 != 6 {
+			return nil, errors.New("malformed ipv6 packet")
+		}
+		p.v6 = true
+		p.proto = buf[off+6]
+		p.sip = net.IP(append([]byte(nil), buf[off+8:off+24]...))
+		p.dip = net.IP(append([]byte(nil), buf[off+24:off+40]...))
+		p.l4Off = off + userDpIP6HdrLen
+		p.l3Len = binary.BigEndian.Uint16(buf[off+4:]) + userDpIP6HdrLen
+		p.frag = p.proto == userDpIP6FragHdr
+	default:
+		return nil, errors.New("unsupported ethertype")
+	}
+
+	switch p.proto {
+	case 6, 17, 132:
+		if len(buf) < p.l4Off+4 {
+			return nil, errors.New("malformed l4 header")
+		}
+		p.sport = binary.BigEndian.Uint16(buf[p.l4Off:])
+		p.dport = binary.BigEndian.Uint16(buf[p.l4Off+2:])
+		if p.proto == 6 {
+			if len(buf) < p.l4Off+20 {
+				return nil, errors.New("malformed tcp header")
+			}
+			p.tcpFlag = buf[p.l4Off+13]
+		}
+	case 1, 58:
+		if len(buf) < p.l4Off+4 {
+			return nil, errors.New("malformed icmp header")
+		}
+		p.icmpType = buf[p.l4Off]
+		p.icmpCode = buf[p.l4Off+1]
+	}
+
+	return p, nil
+}
+
+func userDpCsum(sum uint32, b []byte) uint32 {
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	return sum
+}
+
+func userDpCsumFold(sum uint32) uint16 {
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
+
+// userDpCsumAdjust - incrementally update a checksum for a field changing
+// from old to new as per RFC 1624
+func userDpCsumAdjust(csum uint16, old, new []byte) uint16 {
+	sum := uint32(^csum)
+	for i := 0; i+1 < len(old); i += 2 {
+		sum += uint32(^binary.BigEndian.Uint16(old[i:]))
+	}
+	return userDpCsumFold(userDpCsum(sum, new))
+}
+
+// sameFamily - check if the given addresses belong to the address family of the packet
+func (p *userDpPkt) sameFamily(ips ...net.IP) bool {
+	for _, ip := range ips {
+		if ip == nil || ip.IsUnspecified() {
+			continue
+		}
+		if (ip.To4() == nil) != p.v6 {
+			return false
+		}
+	}
+	return true
+}
+
+// rewrite - rewrite addresses and ports of a parsed packet and fix checksums
+// Translation between address families is not supported by the reference datapath
+func (p *userDpPkt) rewrite(sip net.IP, sport uint16, dip net.IP, dport uint16) error {
+	if !p.sameFamily(sip, dip) {
+		return errors.New("nat between address families not supported")
+	}
+
+	buf := p.buf
+	osip, odip := p.sip, p.dip
+	if !p.v6 {
+		copy(buf[p.l3Off+12:p.l3Off+16], sip.To4())
+		copy(buf[p.l3Off+16:p.l3Off+20], dip.To4())
+		ihl := p.l4Off - p.l3Off
+		buf[p.l3Off+10] = 0
+		buf[p.l3Off+11] = 0
+		binary.BigEndian.PutUint16(buf[p.l3Off+10:], userDpCsumFold(userDpCsum(0, buf[p.l3Off:p.l3Off+ihl])))
+	} else {
+		copy(buf[p.l3Off+8:p.l3Off+24], sip.To16())
+		copy(buf[p.l3Off+24:p.l3Off+40], dip.To16())
+	}
+	p.sip, p.dip = sip, dip
+
+	if p.proto != 6 && p.proto != 17 && p.proto != 132 {
+		return nil
+	}
+
+	// SCTP uses crc32c which is not recomputed by the reference datapath
+	csumOff := 0
+	if p.proto == 6 {
+		csumOff = 16
+	} else if p.proto == 17 {
+		csumOff = 6
+	}
+
+	// Fragments carry only a part of the l4 payload and only the first one
+	// has the l4 header. So ports are never rewritten in fragments and the
+	// checksum of the first one is only adjusted for the new addresses
+	if p.frag {
+		if sport != p.sport || dport != p.dport {
+			return errors.New("port rewrite of ip fragments not supported")
+		}
+		if csumOff == 0 || binary.BigEndian.Uint16(buf[p.l3Off+6:])&0x1fff != 0 ||
+			len(buf) < p.l4Off+csumOff+2 {
+			return nil
+		}
+		csum := binary.BigEndian.Uint16(buf[p.l4Off+csumOff:])
+		if p.proto == 17 && csum == 0 {
+			return nil
+		}
+		if !p.v6 {
+			csum = userDpCsumAdjust(csum, osip.To4(), sip.To4())
+			csum = userDpCsumAdjust(csum, odip.To4(), dip.To4())
+		} else {
+			csum = userDpCsumAdjust(csum, osip.To16(), sip.To16())
+			csum = userDpCsumAdjust(csum, odip.To16(), dip.To16())
+		}
+		binary.BigEndian.PutUint16(buf[p.l4Off+csumOff:], csum)
+		return nil
+	}
+
+	binary.BigEndian.PutUint16(buf[p.l4Off:], sport)
+	binary.BigEndian.PutUint16(buf[p.l4Off+2:], dport)
+	p.sport, p.dport = sport, dport
+
+	if csumOff == 0 {
+		return nil
+	}
+
+	// The l4 segment ends where the l3 packet does, before any link padding
+	l3End := p.l3Off + int(p.l3Len)
+	if l3End > len(buf) || l3End < p.l4Off+csumOff+2 {
+		return errors.New("malformed l4 length")
+	}
+	l4 := buf[p.l4Off:l3End]
+	l4[csumOff] = 0
+	l4[csumOff+1] = 0
+	var sum uint32
+	if !p.v6 {
+		sum = userDpCsum(sum, sip.To4())
+		sum = userDpCsum(sum, dip.To4())
+	} else {
+		sum = userDpCsum(sum, sip.To16())
+		sum = userDpCsum(sum, dip.To16())
+	}
+	sum += uint32(p.proto)
+	sum += uint32(len(l4))
+	sum = userDpCsum(sum, l4)
+	binary.BigEndian.PutUint16(l4[csumOff:], userDpCsumFold(sum))
+	return nil
+}
+
+func userDpProtoStr(proto uint8) string {
+	switch proto {
+	case 1:
+		return "icmp"
+	case 58:
+		return "icmp6"
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	case 132:
+		return "sctp"
+	}
+	return fmt.Sprintf("%d", proto)
+}
+
+func userDpCtKey(sip, dip net.IP, sport, dport uint16, proto uint8) string {
+	return fmt.Sprintf("%s%s%d%d%s", dip.String(), sip.String(), dport, sport, userDpProtoStr(proto))
+}
+
+// userDpIPSetMatch - check if ip is in any member of an ip set
+func userDpIPSetMatch(set []net.IPNet, ip net.IP) bool {
+	for i := range set {
+		if set[i].Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func userDpFwMatch(w *FwDpWorkQ, zone int, port int, p *userDpPkt) bool {
+	if w.ZoneNum != 0 && w.ZoneNum != zone {
+		return false
+	}
+	if w.Port != 0 && int(w.Port) != port {
+		return false
+	}
+	if w.Proto != 0 && w.Proto != p.proto {
+		return false
+	}
+	if len(w.SrcIP.IP) != 0 && !w.SrcIP.Contains(p.sip) {
+		return false
+	}
+	if len(w.DstIP.IP) != 0 && !w.DstIP.Contains(p.dip) {
+		return false
+	}
+	if w.SrcSet != "" && !userDpIPSetMatch(w.SrcSetIPs, p.sip) {
+		return false
+	}
+	if w.DstSet != "" && !userDpIPSetMatch(w.DstSetIPs, p.dip) {
+		return false
+	}
+	if w.L4SrcMin != 0 || w.L4SrcMax != 0 {
+		if p.sport < w.L4SrcMin || p.sport > w.L4SrcMax {
+			return false
+		}
+	}
+	if w.L4DstMin != 0 || w.L4DstMax != 0 {
+		if p.dport < w.L4DstMin || p.dport > w.L4DstMax {
+			return false
+		}
+	}
+	return true
+}
+
+// fwLookup - find the matching firewall entry with highest preference. The
+// default policy entry of a zone is matched only if no other entry matches
+func (e *DpUserH) fwLookup(zone int, port int, p *userDpPkt) *FwDpWorkQ {
+	var fws []*FwDpWorkQ
+	for _, w := range e.fws {
+		fws = append(fws, w)
+	}
+	sort.SliceStable(fws, func(i, j int) bool {
+		if (fws[i].Mark == RtFwDefaultMark) != (fws[j].Mark == RtFwDefaultMark) {
+			return fws[j].Mark == RtFwDefaultMark
+		}
+		if fws[i].Pref == fws[j].Pref {
+			return fws[i].Mark < fws[j].Mark
+		}
+		return fws[i].Pref > fws[j].Pref
+	})
+	for _, w := range fws {
+		if userDpFwMatch(w, zone, port, p) {
+			return w
+		}
+	}
+	return nil
+}
+
+// userDpFlowHash - hash of the 5-tuple of a flow
+func userDpFlowHash(p *userDpPkt) uint32 {
+	h := fnv.New32a()
+	h.Write(p.sip)
+	h.Write(p.dip)
+	binary.Write(h, binary.BigEndian, p.sport)
+	binary.Write(h, binary.BigEndian, p.dport)
+	h.Write([]byte{p.proto})
+	return h.Sum32()
+}
+
+// ruleConns - get the number of open connections of a nat-lb rule per end-point
+func (e *DpUserH) ruleConns(w *NatDpWorkQ) (int, map[int]int) {
+	total := 0
+	conns := make(map[int]int)
+	for _, ct := range e.ctMap {
+		if ct.rev || ct.natKey == "" || ct.info.RuleID != uint32(w.Mark) || ct.info.CState == "closed" {
+			continue
+		}
+		conns[ct.epIdx]++
+		total++
+	}
+	return total, conns
+}
+
+// connAdmit - check connection limit and new connection rate of a nat-lb rule
+// for a new flow
+func (e *DpUserH) connAdmit(w *NatDpWorkQ) bool {
+	if w.NatType == DpSnat {
+		return true
+	}
+
+	if w.MaxConns != 0 {
+		if total, _ := e.ruleConns(w); total >= int(w.MaxConns) {
+			return false
+		}
+	}
+
+	if w.ConnRate != 0 {
+		now := time.Now()
+		rl := e.connRate[w.Mark]
+		if rl == nil {
+			rl = &userDpRate{tokens: float64(w.ConnRate), last: now}
+			e.connRate[w.Mark] = rl
+		}
+		rl.tokens += now.Sub(rl.last).Seconds() * float64(w.ConnRate)
+		if rl.tokens > float64(w.ConnRate) {
+			rl.tokens = float64(w.ConnRate)
+		}
+		rl.last = now
+		if rl.tokens < 1 {
+			return false
+		}
+		rl.tokens--
+	}
+	return true
+}
+
+// selectEP - select an end-point of a nat-lb rule for a new flow. End-points
+// which are at their connection limit are skipped
+func (e *DpUserH) selectEP(natKey string, w *NatDpWorkQ, p *userDpPkt) int {
+	var active []int
+	var conns map[int]int
+	if w.MaxConnEp != 0 && w.NatType != DpSnat {
+		_, conns = e.ruleConns(w)
+	}
+	epOK := func(i int) bool {
+		return !w.endPoints[i].InActive && (conns == nil || conns[i] < int(w.MaxConnEp))
+	}
+
+	for i := range w.endPoints {
+		if epOK(i) {
+			active = append(active, i)
+		}
+	}
+	if len(active) == 0 {
+		return -1
+	}
+
+	switch w.EpSel {
+	case EpHash:
+		return active[int(userDpFlowHash(p)%uint32(len(active)))]
+	case EpMaglev:
+		fh := userDpFlowHash(p)
+		if len(w.MaglevTbl) > 0 {
+			idx := int(w.MaglevTbl[fh%uint32(len(w.MaglevTbl))])
+			if idx < len(w.endPoints) && epOK(idx) {
+				return idx
+			}
+		}
+		return active[int(fh%uint32(len(active)))]
+	case EpRRPersist:
+		h := fnv.New32a()
+		h.Write(p.sip)
+		return active[int(h.Sum32()%uint32(len(active)))]
+	case EpLeastConn:
+		sel := active[0]
+		least := -1
+		for _, idx := range active {
+			n := 0
+			for _, ct := range e.ctMap {
+				if !ct.rev && ct.natKey == natKey && ct.epIdx == idx {
+					n++
+				}
+			}
+			if least < 0 || n < least {
+				least = n
+				sel = idx
+			}
+		}
+		return sel
+	default:
+		rr := e.rrIdx[natKey] % len(active)
+		e.rrIdx[natKey] = rr + 1
+		return active[rr]
+	}
+}
+
+// natLookup - find the nat-lb rule for a new flow
+func (e *DpUserH) natLookup(zone int, p *userDpPkt) (string, *NatDpWorkQ) {
+	key := userDpNatKey(zone, p.dip, p.dport, p.proto, 0)
+	if w := e.nats[key]; w != nil {
+		return key, w
+	}
+	// Any-port service
+	key = userDpNatKey(zone, p.dip, 0, p.proto, 0)
+	if w := e.nats[key]; w != nil && w.NatType != DpSnat {
+		return key, w
+	}
+	for key, w := range e.nats {
+		if w.NatType == DpSnat || w.ZoneNum != zone || w.Proto != p.proto || w.L4Port != p.dport {
+			continue
+		}
+		if w.ServiceIP.Equal(p.dip) {
+			return key, w
+		}
+		for _, sip := range w.secIP {
+			if sip.Equal(p.dip) {
+				return key, w
+			}
+		}
+	}
+	return "", nil
+}
+
+// rtLookup - longest prefix match route lookup
+func (e *DpUserH) rtLookup(zone int, dip net.IP) *RouteDpWorkQ {
+	var best *RouteDpWorkQ
+	bestLen := -1
+	for _, w := range e.routes {
+		if w.ZoneNum != zone || !w.Dst.Contains(dip) {
+			continue
+		}
+		if (w.Dst.IP.To4() == nil) != (dip.To4() == nil) {
+			continue
+		}
+		plen, _ := w.Dst.Mask.Size()
+		if plen > bestLen {
+			best = w
+			bestLen = plen
+		}
+	}
+	return best
+}
+
+// ctCreate - create forward and reverse conntrack entries of a nat flow
+func (e *DpUserH) ctCreate(natKey string, w *NatDpWorkQ, epIdx int, p *userDpPkt) *userDpCt {
+	ep := w.endPoints[epIdx]
+	now := time.Now()
+	ito := time.Duration(w.InActTo) * time.Second
+
+	state := "est"
+	if p.proto == 6 {
+		state = "sync-sent"
+	} else if p.proto == 17 {
+		state = "udp-uni"
+	} else if p.proto == 1 || p.proto == 58 {
+		state = "req-sent"
+	}
+
+	nmode := "dnat"
+	if w.DsrMode {
+		nmode = "ddsr"
+	}
+	cAct := fmt.Sprintf("%s-%s:%d:w%d", nmode, ep.XIP.String(), ep.XPort, ep.Weight)
+	if ep.RIP != nil && !ep.RIP.IsUnspecified() {
+		cAct = fmt.Sprintf("fdnat-%s,%s:%d:w%d", ep.RIP.String(), ep.XIP.String(), ep.XPort, ep.Weight)
+	}
+
+	info := &DpCtInfo{DIP: p.dip, SIP: p.sip, Dport: p.dport, Sport: p.sport,
+		Proto: userDpProtoStr(p.proto), CState: state, CAct: cAct, LTs: now, NTs: now,
+		ServiceIP: w.ServiceIP, ServProto: userDpProtoStr(w.Proto), L4ServPort: w.L4Port,
+		BlockNum: w.BlockNum, RuleID: uint32(w.Mark)}
+
+	fct := &userDpCt{info: info, natKey: natKey, epIdx: epIdx, xIP: ep.XIP, xPort: ep.XPort,
+		rIP: ep.RIP, vIP: p.dip, vPort: p.dport, cIP: p.sip, cPort: p.sport, ito: ito}
+	if ep.XPort == 0 {
+		fct.xPort = p.dport
+	}
+	e.ctMap[userDpCtKey(p.sip, p.dip, p.sport, p.dport, p.proto)] = fct
+
+	// Reverse direction as seen from the end-point
+	rct := new(userDpCt)
+	*rct = *fct
+	rct.rev = true
+	rSIP := p.sip
+	if fct.rIP != nil && !fct.rIP.IsUnspecified() {
+		rSIP = fct.rIP
+	}
+	e.ctMap[userDpCtKey(fct.xIP, rSIP, fct.xPort, p.sport, p.proto)] = rct
+
+	return fct
+}
+
+// ctTrack - update state of a conntrack entry on seeing a packet
+func (ct *userDpCt) ctTrack(p *userDpPkt) {
+	ct.info.LTs = time.Now()
+	if p.proto == 6 {
+		switch {
+		case p.tcpFlag&0x04 != 0:
+			// Reset before the connection got established
+			if ct.info.CState == "sync-sent" || ct.info.CState == "sync-ack" {
+				ct.info.CState = "h/e"
+			} else {
+				ct.info.CState = "closed"
+			}
+		case p.tcpFlag&0x01 != 0:
+			ct.info.CState = "fini"
+		case p.tcpFlag&0x12 == 0x12:
+			ct.info.CState = "sync-ack"
+		case p.tcpFlag&0x10 != 0 && ct.info.CState != "fini":
+			ct.info.CState = "est"
+		}
+	} else if p.proto == 17 && ct.rev {
+		ct.info.CState = "udp-est"
+	} else if (p.proto == 1 || p.proto == 58) && ct.rev {
+		ct.info.CState = "bidir"
+	}
+}
+
+// DpUserPktIn - evaluate a packet received on a given os port against the
+// programmed tables of userspace dp. The packet byte slice is modified in
+// place if a nat translation is applied
+func (e *DpUserH) DpUserPktIn(osPortNum int, pkt []byte) (UserDpPktResult, error) {
+	var res UserDpPktResult
+
+	p, err := parseUserDpPkt(pkt)
+	if err != nil {
+		return UserDpPktResult{Act: UserDpActDrop}, err
+	}
+
+	// Hits are logged only once e.mtx is released
+	var hit *FwLogDpInfo
+	defer func() {
+		if hit != nil {
+			FwLogHit(hit)
+		}
+	}()
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	res.Pkt = pkt
+	res.EpIdx = -1
+
+	pw := e.ports[userDpPortKey(osPortNum, p.vlan)]
+	if pw == nil {
+		res.Act = UserDpActTrap
+		return res, nil
+	}
+	zone := pw.SetZoneNum
+	port := pw.PortNum
+
+	ctKey := userDpCtKey(p.sip, p.dip, p.sport, p.dport, p.proto)
+	ct := e.ctMap[ctKey]
+	if ct != nil && ct.natKey != "" {
+		ct.ctTrack(p)
+		if !ct.rev {
+			ct.info.Packets++
+			ct.info.Bytes += uint64(len(pkt))
+			sip := p.sip
+			if ct.rIP != nil && !ct.rIP.IsUnspecified() {
+				sip = ct.rIP
+			}
+			err = p.rewrite(sip, p.sport, ct.xIP, ct.xPort)
+		} else {
+			err = p.rewrite(ct.vIP, ct.vPort, ct.cIP, ct.cPort)
+		}
+		if err != nil {
+			res.Act = UserDpActDrop
+			return res, err
+		}
+		res.NatMark = int(ct.info.RuleID)
+		res.EpIdx = ct.epIdx
+		e.statAdd(MapNameNat4, NatEpStatMark(ct.info.RuleID, ct.epIdx), len(pkt), false)
+	} else {
+		fw := e.fwLookup(zone, port, p)
+		if fw != nil {
+			res.FwMark = fw.Mark
+			e.statAdd(MapNameFw4, uint32(fw.Mark), len(pkt), fw.FwType == DpFwDrop)
+			if fw.FwRecord {
+				hit = &FwLogDpInfo{Time: time.Now(), Mark: fw.Mark, Pref: fw.Pref, FwType: fw.FwType,
+					OsPort: osPortNum, Proto: userDpProtoStr(p.proto), SIP: p.sip, DIP: p.dip,
+					Sport: p.sport, Dport: p.dport}
+			}
+			switch fw.FwType {
+			case DpFwDrop:
+				res.Act = UserDpActDrop
+				return res, nil
+			case DpFwTrap:
+				res.Act = UserDpActTrap
+				return res, nil
+			case DpFwRdr:
+				res.Act = UserDpActRdr
+				res.OutPort = int(fw.FwVal1)
+				return res, nil
+			}
+		}
+
+		natKey, nw := e.natLookup(zone, p)
+		if nw == nil && fw != nil && fw.FwVal2&0x1000 != 0 {
+			natKey = userDpSnatKey(uint16(fw.FwVal2))
+			nw = e.nats[natKey]
+		}
+		if nw != nil {
+			if !e.connAdmit(nw) {
+				e.statAdd(MapNameNatRej, uint32(nw.Mark), len(pkt), true)
+				res.Act = UserDpActDrop
+				return res, nil
+			}
+			epIdx := e.selectEP(natKey, nw, p)
+			if epIdx < 0 {
+				if nw.MaxConnEp != 0 {
+					e.statAdd(MapNameNatRej, uint32(nw.Mark), len(pkt), true)
+				}
+				res.Act = UserDpActDrop
+				return res, nil
+			}
+			if nw.NatType == DpSnat {
+				ep := nw.endPoints[epIdx]
+				sport := p.sport
+				if ep.XPort != 0 {
+					sport = ep.XPort
+				}
+				err = p.rewrite(ep.XIP, sport, p.dip, p.dport)
+			} else {
+				ep := nw.endPoints[epIdx]
+				if !p.sameFamily(ep.XIP, ep.RIP) {
+					res.Act = UserDpActDrop
+					return res, errors.New("nat between address families not supported")
+				}
+				nct := e.ctCreate(natKey, nw, epIdx, p)
+				nct.info.Packets++
+				nct.info.Bytes += uint64(len(pkt))
+				sip := p.sip
+				if nct.rIP != nil && !nct.rIP.IsUnspecified() {
+					sip = nct.rIP
+				}
+				err = p.rewrite(sip, p.sport, nct.xIP, nct.xPort)
+			}
+			if err != nil {
+				res.Act = UserDpActDrop
+				return res, err
+			}
+			res.NatMark = nw.Mark
+			res.EpIdx = epIdx
+			e.statAdd(MapNameNat4, NatEpStatMark(uint32(nw.Mark), epIdx), len(pkt), false)
+		}
+	}
+
+	rt := e.rtLookup(zone, p.dip)
+	if rt == nil || rt.NMax <= 0 {
+		res.Act = UserDpActTrap
+		return res, nil
+	}
+	if rt.RtMark > 0 {
+		e.statAdd(MapNameRt4, uint32(rt.RtMark), len(pkt), false)
+	}
+
+	h := fnv.New32a()
+	h.Write(p.sip)
+	h.Write(p.dip)
+	nMax := rt.NMax
+	if nMax > len(rt.NMark) {
+		nMax = len(rt.NMark)
+	}
+	nh := e.nhs[rt.NMark[int(h.Sum32()%uint32(nMax))]]
+	if nh == nil || !nh.Resolved {
+		res.Act = UserDpActTrap
+		return res, nil
+	}
+	res.NhNum = nh.NextHopNum
+
+	copy(pkt[0:6], nh.DstAddr[:])
+	copy(pkt[6:12], nh.SrcAddr[:])
+	l2 := e.l2Addrs[userDpL2Key(nh.DstAddr, nh.BD)]
+	if l2 != nil {
+		res.OutPort = l2.PortNum
+	}
+	res.Act = UserDpActFwd
+
+	return res, nil
+}
@@ -0,0 +1,513 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/syslog"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// error codes
+const (
+	FwLogErrBase = iota - 106000
+	FwLogArgsErr
+	FwLogOutputErr
+	FwLogSubExistsErr
+	FwLogSubNoExistErr
+	FwLogDpErr
+)
+
+// constants
+const (
+	FwLogQueueLen      = 4096
+	FwLogDefMaxSize    = 100
+	FwLogDefMaxBackups = 5
+	FwLogMaxBackups    = 100
+	FwLogSyslogTag     = "loxilb-fw"
+)
+
+// fwLogFile - a JSON lines log file which is rotated once it grows beyond
+// maxSize. Rotated files are kept as path.1 (newest) to path.maxBackups
+type fwLogFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	f          *os.File
+	size       int64
+}
+
+// fwLogFileOpen - open a log file for appending records
+func fwLogFileOpen(path string, maxSizeMB uint32, maxBackups uint32) (*fwLogFile, error) {
+	lf := new(fwLogFile)
+	lf.path = path
+	lf.maxSize = int64(maxSizeMB) << 20
+	lf.maxBackups = int(maxBackups)
+	if err := lf.open(); err != nil {
+		return nil, err
+	}
+	return lf, nil
+}
+
+func (lf *fwLogFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(lf.path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(lf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	lf.f = f
+	lf.size = st.Size()
+	return nil
+}
+
+// rotate - move each rotated file one place up dropping the oldest one and
+// start over with an empty log file
+func (lf *fwLogFile) rotate() error {
+	lf.f.Close()
+	for i := lf.maxBackups - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", lf.path, i), fmt.Sprintf("%s.%d", lf.path, i+1))
+	}
+	if err := os.Rename(lf.path, lf.path+".1"); err != nil {
+		return err
+	}
+	return lf.open()
+}
+
+func (lf *fwLogFile) write(line []byte) error {
+	if lf.f == nil {
+		return errors.New("fwlog-file closed")
+	}
+	if lf.size > 0 && lf.size+int64(len(line)) > lf.maxSize {
+		if err := lf.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := lf.f.Write(line)
+	lf.size += int64(n)
+	return err
+}
+
+func (lf *fwLogFile) close() {
+	if lf.f != nil {
+		lf.f.Close()
+		lf.f = nil
+	}
+}
+
+// fwLogSyslogOpen - connect to a syslog server given as "udp://host:port",
+// "tcp://host:port" or "local"
+func fwLogSyslogOpen(spec string) (*syslog.Writer, error) {
+	var network, raddr string
+
+	if spec != "local" {
+		var found bool
+		network, raddr, found = strings.Cut(spec, "://")
+		if !found || (network != "udp" && network != "tcp") || raddr == "" {
+			return nil, errors.New("fwlog-syslog args error")
+		}
+	}
+	return syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_LOCAL0, FwLogSyslogTag)
+}
+
+// fwLogRule - what a log record needs of a firewall rule marked record
+type fwLogRule struct {
+	name   string
+	pref   uint16
+	fwType FwOpT
+	w      *FwDpWorkQ
+}
+
+// FwLogH - context container
+type FwLogH struct {
+	mtx     sync.Mutex
+	cfg     cmn.FwLogConfig
+	file    *fwLogFile
+	sysl    *syslog.Writer
+	subs    map[chan cmn.FwLogRecord]struct{}
+	hitCh   chan *FwLogDpInfo
+	on      atomic.Bool
+	seen    uint64
+	rlSec   int64
+	rlCnt   uint32
+	logged  atomic.Uint64
+	dropped atomic.Uint64
+	sampled atomic.Uint64
+	rmtx    sync.RWMutex
+	rules   map[int]fwLogRule
+}
+
+// FwLogInit - Initialize the firewall hit log subsystem
+func FwLogInit() *FwLogH {
+	fl := new(FwLogH)
+	fl.subs = make(map[chan cmn.FwLogRecord]struct{})
+	fl.rules = make(map[int]fwLogRule)
+	fl.hitCh = make(chan *FwLogDpInfo, FwLogQueueLen)
+	go fl.run()
+	return fl
+}
+
+// FwLogHit - Report a hit on a firewall entry marked record. It is called by
+// datapath and never blocks. Hits are dropped if logging can't keep up
+func FwLogHit(hit *FwLogDpInfo) {
+	fl := mh.fwLog
+	if fl == nil || !fl.on.Load() {
+		return
+	}
+	select {
+	case fl.hitCh <- hit:
+	default:
+		fl.dropped.Add(1)
+	}
+}
+
+// FwLogRecPkt - Report a packet recorded by a datapath which reports hits as
+// copies of the packets only (eBPF dp). The hit is given to the firewall rule
+// marked record which the packet matches, as datapath would look it up
+func FwLogRecPkt(osPortNum int, pkt []byte) {
+	fl := mh.fwLog
+	if fl == nil || !fl.on.Load() {
+		return
+	}
+	p, err := parseUserDpPkt(pkt)
+	if err != nil {
+		return
+	}
+	mark, lr, found := fl.ruleMatch(p)
+	if !found {
+		return
+	}
+	FwLogHit(&FwLogDpInfo{Time: time.Now(), Mark: mark, Pref: lr.pref, FwType: lr.fwType,
+		OsPort: osPortNum, Proto: userDpProtoStr(p.proto),
+		SIP: append(net.IP(nil), p.sip...), DIP: append(net.IP(nil), p.dip...),
+		Sport: p.sport, Dport: p.dport})
+}
+
+// ruleMatch - Get the firewall rule marked record a packet matches. Zone and
+// port of the packet are not known and are taken to match
+func (fl *FwLogH) ruleMatch(p *userDpPkt) (int, fwLogRule, bool) {
+	var bestMark int
+	var best fwLogRule
+	found := false
+
+	fl.rmtx.RLock()
+	defer fl.rmtx.RUnlock()
+
+	for mark, lr := range fl.rules {
+		if lr.w == nil || !userDpFwMatch(lr.w, lr.w.ZoneNum, int(lr.w.Port), p) {
+			continue
+		}
+		if !found || lr.pref > best.pref || (lr.pref == best.pref && mark < bestMark) {
+			bestMark, best, found = mark, lr, true
+		}
+	}
+	return bestMark, best, found
+}
+
+// ruleSet - Add or update a firewall rule marked record in the index used
+// to name the rule of a hit
+func (fl *FwLogH) ruleSet(mark int, lr fwLogRule) {
+	if fl == nil {
+		return
+	}
+	fl.rmtx.Lock()
+	fl.rules[mark] = lr
+	fl.rmtx.Unlock()
+}
+
+// ruleUnset - Remove a firewall rule from the index
+func (fl *FwLogH) ruleUnset(mark int) {
+	if fl == nil {
+		return
+	}
+	fl.rmtx.Lock()
+	delete(fl.rules, mark)
+	fl.rmtx.Unlock()
+}
+
+// ruleGet - Get a firewall rule marked record from the index
+func (fl *FwLogH) ruleGet(mark int) (fwLogRule, bool) {
+	fl.rmtx.RLock()
+	defer fl.rmtx.RUnlock()
+
+	lr, found := fl.rules[mark]
+	return lr, found
+}
+
+// enable - Turn logging of hits on only if there is any output for records.
+// fl.mtx is held by caller
+func (fl *FwLogH) enable() {
+	fl.on.Store(fl.file != nil || fl.sysl != nil || len(fl.subs) > 0)
+}
+
+// ConfigSet - Set sampling, rate limit and outputs of firewall hit logging.
+// New outputs are opened before the old ones are closed so that a bad config
+// leaves the current one in place
+func (fl *FwLogH) ConfigSet(cfg cmn.FwLogConfig) (int, error) {
+	var file *fwLogFile
+	var sysl *syslog.Writer
+	var err error
+
+	// Hits on record rules are passed up only by userspace dp as of now
+	if mh.dpEbpf != nil {
+		return FwLogDpErr, errors.New("fwlog error: not supported by dp")
+	}
+
+	if cfg.File != "" {
+		if !filepath.IsAbs(cfg.File) {
+			return FwLogArgsErr, errors.New("fwlog-file path error")
+		}
+		if cfg.FileMaxSize == 0 {
+			cfg.FileMaxSize = FwLogDefMaxSize
+		}
+		if cfg.FileMaxBackups == 0 {
+			cfg.FileMaxBackups = FwLogDefMaxBackups
+		}
+		if cfg.FileMaxBackups > FwLogMaxBackups {
+			return FwLogArgsErr, errors.New("fwlog-file backups error")
+		}
+	} else {
+		cfg.FileMaxSize = 0
+		cfg.FileMaxBackups = 0
+	}
+	cfg.Logged = 0
+	cfg.Dropped = 0
+	cfg.Sampled = 0
+
+	if cfg.File != "" {
+		file, err = fwLogFileOpen(cfg.File, cfg.FileMaxSize, cfg.FileMaxBackups)
+		if err != nil {
+			return FwLogOutputErr, fmt.Errorf("fwlog-file error (%s)", err)
+		}
+	}
+	if cfg.Syslog != "" {
+		sysl, err = fwLogSyslogOpen(cfg.Syslog)
+		if err != nil {
+			if file != nil {
+				file.close()
+			}
+			return FwLogOutputErr, fmt.Errorf("fwlog-syslog error (%s)", err)
+		}
+	}
+
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	if fl.file != nil {
+		fl.file.close()
+	}
+	if fl.sysl != nil {
+		fl.sysl.Close()
+	}
+	fl.cfg = cfg
+	fl.file = file
+	fl.sysl = sysl
+	fl.seen = 0
+	fl.rlCnt = 0
+	fl.enable()
+
+	tk.LogIt(tk.LogInfo, "fwlog - config sample %d rate %d file \"%s\" syslog \"%s\"\n",
+		cfg.Sample, cfg.RateLimit, cfg.File, cfg.Syslog)
+	return 0, nil
+}
+
+// ConfigGet - Get the config of firewall hit logging along with its counters
+func (fl *FwLogH) ConfigGet() cmn.FwLogConfig {
+	fl.mtx.Lock()
+	cfg := fl.cfg
+	fl.mtx.Unlock()
+
+	cfg.Logged = fl.logged.Load()
+	cfg.Dropped = fl.dropped.Load()
+	cfg.Sampled = fl.sampled.Load()
+	return cfg
+}
+
+// configured - Check if firewall hit logging has been set up at all
+func (fl *FwLogH) configured() bool {
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	return fl.cfg != cmn.FwLogConfig{}
+}
+
+// Sub - Subscribe a channel to firewall hit log records
+func (fl *FwLogH) Sub(ch chan cmn.FwLogRecord) (int, error) {
+	if mh.dpEbpf != nil {
+		return FwLogDpErr, errors.New("fwlog error: not supported by dp")
+	}
+
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	if _, found := fl.subs[ch]; found {
+		return FwLogSubExistsErr, errors.New("fwlog-sub exists error")
+	}
+	fl.subs[ch] = struct{}{}
+	fl.enable()
+	return 0, nil
+}
+
+// UnSub - Unsubscribe a channel from firewall hit log records
+func (fl *FwLogH) UnSub(ch chan cmn.FwLogRecord) (int, error) {
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	if _, found := fl.subs[ch]; !found {
+		return FwLogSubNoExistErr, errors.New("fwlog-sub not found error")
+	}
+	delete(fl.subs, ch)
+	fl.enable()
+	return 0, nil
+}
+
+// admit - Apply sampling and rate limit to a hit. Hits skipped by sampling
+// are counted apart from the ones dropped by rate limit
+func (fl *FwLogH) admit(t time.Time) bool {
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	fl.seen++
+	if fl.cfg.Sample > 1 && fl.seen%uint64(fl.cfg.Sample) != 0 {
+		fl.sampled.Add(1)
+		return false
+	}
+	if fl.cfg.RateLimit != 0 {
+		if sec := t.Unix(); sec != fl.rlSec {
+			fl.rlSec = sec
+			fl.rlCnt = 0
+		}
+		if fl.rlCnt >= fl.cfg.RateLimit {
+			fl.dropped.Add(1)
+			return false
+		}
+		fl.rlCnt++
+	}
+	return true
+}
+
+// fwLogAction - Name of the action taken by datapath for a hit
+func fwLogAction(fwType FwOpT) string {
+	switch fwType {
+	case DpFwFwd:
+		return "allow"
+	case DpFwDrop:
+		return "drop"
+	case DpFwRdr:
+		return "redirect"
+	case DpFwTrap:
+		return "trap"
+	}
+	return "unknown"
+}
+
+// record - Make a log record of a hit. The rule and the port are looked
+// up as they are now, which could differ from when the hit happened
+func (fl *FwLogH) record(hit *FwLogDpInfo) cmn.FwLogRecord {
+	rec := cmn.FwLogRecord{
+		Time:    hit.Time,
+		RuleID:  uint32(hit.Mark),
+		Pref:    hit.Pref,
+		Proto:   hit.Proto,
+		SrcIP:   hit.SIP.String(),
+		DstIP:   hit.DIP.String(),
+		SrcPort: hit.Sport,
+		DstPort: hit.Dport,
+		Action:  fwLogAction(hit.FwType),
+	}
+
+	if lr, found := fl.ruleGet(hit.Mark); found {
+		rec.Rule = lr.name
+	}
+
+	mh.mtx.RLock()
+	defer mh.mtx.RUnlock()
+
+	if mh.zr == nil {
+		return rec
+	}
+	if port := mh.zr.Ports.PortFindByOSID(hit.OsPort); port != nil {
+		rec.Port = port.Name
+	}
+	return rec
+}
+
+// emit - Write a record to all outputs without waiting on slow subscribers
+func (fl *FwLogH) emit(rec *cmn.FwLogRecord) {
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+
+	fl.mtx.Lock()
+	defer fl.mtx.Unlock()
+
+	if fl.file != nil {
+		if err := fl.file.write(append(buf, '\n')); err != nil {
+			tk.LogIt(tk.LogError, "fwlog - %s write failed (%s)\n", fl.cfg.File, err)
+		}
+	}
+	if fl.sysl != nil {
+		if rec.Action == "drop" {
+			err = fl.sysl.Warning(string(buf))
+		} else {
+			err = fl.sysl.Info(string(buf))
+		}
+		if err != nil {
+			tk.LogIt(tk.LogDebug, "fwlog - syslog %s write failed (%s)\n", fl.cfg.Syslog, err)
+		}
+	}
+	for ch := range fl.subs {
+		select {
+		case ch <- *rec:
+		default:
+		}
+	}
+	fl.logged.Add(1)
+}
+
+// run - Log hits sent by datapath
+func (fl *FwLogH) run() {
+	for hit := range fl.hitCh {
+		if !fl.on.Load() {
+			fl.dropped.Add(1)
+			continue
+		}
+		if !fl.admit(hit.Time) {
+			continue
+		}
+		rec := fl.record(hit)
+		fl.emit(&rec)
+	}
+}
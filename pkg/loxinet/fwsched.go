@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// constants
+const (
+	FwSchedMinWindow = time.Minute
+	FwSchedMaxWindow = 7 * 24 * time.Hour
+)
+
+// fwSchedule - recurring active window of a firewall rule. A window starts
+// whenever the cron-like fields match and stays open for window duration
+type fwSchedule struct {
+	spec   string
+	min    uint64
+	hour   uint64
+	dom    uint64
+	mon    uint64
+	dow    uint64
+	domAny bool
+	dowAny bool
+	window time.Duration
+}
+
+// fwCronFieldParse - parse a cron field into a bitmap of allowed values.
+// A field is a comma-separated list of *, n, a-b with an optional /step
+func fwCronFieldParse(field string, lo, hi int) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(field, ",") {
+		var err error
+		step := 1
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, errors.New("fwrule-schedule error")
+			}
+		}
+
+		first, last := lo, hi
+		if rng != "*" {
+			fs, ls, isRange := strings.Cut(rng, "-")
+			first, err = strconv.Atoi(fs)
+			if err != nil {
+				return 0, errors.New("fwrule-schedule error")
+			}
+			last = first
+			if isRange {
+				last, err = strconv.Atoi(ls)
+				if err != nil {
+					return 0, errors.New("fwrule-schedule error")
+				}
+			} else if hasStep {
+				last = hi
+			}
+		}
+		if first < lo || last > hi || first > last {
+			return 0, errors.New("fwrule-schedule error")
+		}
+
+		for v := first; v <= last; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// fwScheduleParse - parse a schedule given as "min hour dom mon dow window"
+// e.g "0 9 * * 1-5 8h" is active from 9am to 5pm on weekdays
+func fwScheduleParse(spec string) (*fwSchedule, error) {
+	var err error
+
+	fields := strings.Fields(spec)
+	if len(fields) != 6 {
+		return nil, errors.New("fwrule-schedule error")
+	}
+
+	s := new(fwSchedule)
+	s.spec = strings.Join(fields, " ")
+	if s.min, err = fwCronFieldParse(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hour, err = fwCronFieldParse(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.dom, err = fwCronFieldParse(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.mon, err = fwCronFieldParse(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if s.dow, err = fwCronFieldParse(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	// Both 0 and 7 stand for sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domAny = fields[2] == "*"
+	s.dowAny = fields[4] == "*"
+
+	s.window, err = time.ParseDuration(fields[5])
+	if err != nil || s.window < FwSchedMinWindow || s.window > FwSchedMaxWindow {
+		return nil, errors.New("fwrule-schedule window error")
+	}
+
+	return s, nil
+}
+
+// dayMatch - check if windows can start on the day of the given time. As in
+// cron, when both day of month and day of week are restricted either one can match
+func (s *fwSchedule) dayMatch(t time.Time) bool {
+	if s.mon&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	domOk := s.dom&(1<<uint(t.Day())) != 0
+	dowOk := s.dow&(1<<uint(t.Weekday())) != 0
+	if !s.domAny && !s.dowAny {
+		return domOk || dowOk
+	}
+	return domOk && dowOk
+}
+
+// fwCronFieldPrev - get the highest value set in a cron field bitmap which
+// is not above n or -1 if there is none
+func fwCronFieldPrev(field uint64, n int) int {
+	for ; n >= 0; n-- {
+		if field&(1<<uint(n)) != 0 {
+			return n
+		}
+	}
+	return -1
+}
+
+// lastStart - get the start of the latest window which opened at or before
+// the given time. Windows are no longer than FwSchedMaxWindow so days before
+// that need not be looked at
+func (s *fwSchedule) lastStart(t time.Time) (time.Time, bool) {
+	y, m, d := t.Date()
+	hour, min := t.Hour(), t.Minute()
+
+	for day := 0; day <= int(FwSchedMaxWindow/(24*time.Hour)); day++ {
+		date := time.Date(y, m, d-day, 0, 0, 0, 0, t.Location())
+		if s.dayMatch(date) {
+			for h := fwCronFieldPrev(s.hour, hour); h >= 0; h = fwCronFieldPrev(s.hour, h-1) {
+				// Earlier hours of the day can start at any minute
+				minLast := 59
+				if h == hour {
+					minLast = min
+				}
+				if mi := fwCronFieldPrev(s.min, minLast); mi >= 0 {
+					return time.Date(date.Year(), date.Month(), date.Day(), h, mi, 0, 0, t.Location()), true
+				}
+			}
+		}
+		hour, min = 23, 59
+	}
+	return time.Time{}, false
+}
+
+// active - check if the given time falls in any window of the schedule
+func (s *fwSchedule) active(t time.Time) bool {
+	start, ok := s.lastStart(t)
+	return ok && t.Sub(start) < s.window
+}
@@ -811,6 +811,7 @@ func (gbh *GoBgpH) processBgpEvent(e goBgpEvent) {
 		gbh.conn = e.conn
 		gbh.state = BGPConnected
 		gbh.initBgpClient()
+		go mh.cfgStore.BgpRestore(gbh)
 	case bgpRtRecvd:
 		gbh.processRouteSingle(&e.Data, bgp.BGP_ADD_PATH_RECEIVE)
 	}
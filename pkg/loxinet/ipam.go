@@ -0,0 +1,494 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"sort"
+
+	"github.com/loxilb-io/loxilb/api/ippool"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// error codes
+const (
+	IpamErrBase = iota - 104000
+	IpamArgsErr
+	IpamExistsErr
+	IpamNoExistErr
+	IpamOverlapErr
+	IpamInUseErr
+	IpamAllocErr
+)
+
+// constants
+const (
+	IpamXSyncProto = "ipam"
+	IpamSyncQLen   = 1024
+)
+
+// ipam cluster sync operations
+const (
+	ipamSyncPoolAdd = "pool-add"
+	ipamSyncPoolDel = "pool-del"
+	ipamSyncAlloc   = "alloc"
+	ipamSyncRelease = "release"
+)
+
+// IpamPoolEnt - an ip pool entry
+type IpamPoolEnt struct {
+	Name  string
+	CIDRs []*net.IPNet
+	Pool  *ippool.IPPool
+}
+
+// IpamH - context container
+type IpamH struct {
+	PoolMap map[string]*IpamPoolEnt
+	Zone    *Zone
+	syncCh  chan DpCtInfo
+}
+
+// IpamInit - Initialize the ipam subsystem
+func IpamInit(zone *Zone) *IpamH {
+	var nIh = new(IpamH)
+	nIh.PoolMap = make(map[string]*IpamPoolEnt)
+	nIh.Zone = zone
+	nIh.syncCh = make(chan DpCtInfo, IpamSyncQLen)
+	go nIh.xsyncWorker()
+	return nIh
+}
+
+// ipamNetOverlap - check if two subnets overlap
+func ipamNetOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// IPPoolAdd - add an ip pool with an IPv4 and/or an IPv6 subnet. VIPs of
+// existing lb rules which fall in the pool are marked as allocated
+func (I *IpamH) IPPoolAdd(pm cmn.IPPoolMod) (int, error) {
+	ret, err := I.ipPoolAdd(pm)
+	if err != nil {
+		return ret, err
+	}
+
+	I.xsyncSend(ipamSyncPoolAdd, cmn.IPPoolMod{Name: pm.Name, CIDRs: pm.CIDRs, Reserved: pm.Reserved})
+	I.LbRulesSync(pm.Name)
+
+	return 0, nil
+}
+
+// ipPoolAdd - add an ip pool without syncing it to the cluster
+func (I *IpamH) ipPoolAdd(pm cmn.IPPoolMod) (int, error) {
+	var v4CIDR, v6CIDR string
+	var nets []*net.IPNet
+
+	if pm.Name == "" || len(pm.CIDRs) == 0 || len(pm.CIDRs) > 2 {
+		return IpamArgsErr, errors.New("ippool-args error")
+	}
+
+	if _, found := I.PoolMap[pm.Name]; found {
+		return IpamExistsErr, errors.New("ippool-exists error")
+	}
+
+	for _, cidr := range pm.CIDRs {
+		ip, ipn, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return IpamArgsErr, errors.New("ippool-cidr error")
+		}
+		if ip.To4() != nil {
+			if v4CIDR != "" {
+				return IpamArgsErr, errors.New("ippool-cidr dup-family error")
+			}
+			v4CIDR = cidr
+		} else {
+			if v6CIDR != "" {
+				return IpamArgsErr, errors.New("ippool-cidr dup-family error")
+			}
+			v6CIDR = cidr
+		}
+		for _, pe := range I.PoolMap {
+			for _, n := range pe.CIDRs {
+				if ipamNetOverlap(n, ipn) {
+					return IpamOverlapErr, errors.New("ippool-cidr overlap error")
+				}
+			}
+		}
+		nets = append(nets, ipn)
+	}
+
+	pool, err := ippool.NewDualStackIPPool(v4CIDR, v6CIDR)
+	if err != nil {
+		return IpamArgsErr, errors.New("ippool-cidr error")
+	}
+
+	for _, ip := range pm.Reserved {
+		if err := pool.ReserveIP(ip); err != nil {
+			return IpamArgsErr, errors.New("ippool-reserved error")
+		}
+	}
+
+	I.PoolMap[pm.Name] = &IpamPoolEnt{Name: pm.Name, CIDRs: nets, Pool: pool}
+
+	tk.LogIt(tk.LogDebug, "ippool added - %s:%v\n", pm.Name, pm.CIDRs)
+
+	return 0, nil
+}
+
+// IPPoolDelete - delete an ip pool. A pool with allocations can't be deleted
+func (I *IpamH) IPPoolDelete(name string) (int, error) {
+	ret, err := I.ipPoolDelete(name)
+	if err != nil {
+		return ret, err
+	}
+
+	I.xsyncSend(ipamSyncPoolDel, cmn.IPPoolMod{Name: name})
+
+	return 0, nil
+}
+
+// ipPoolDelete - delete an ip pool without syncing it to the cluster
+func (I *IpamH) ipPoolDelete(name string) (int, error) {
+	pe, found := I.PoolMap[name]
+	if !found {
+		return IpamNoExistErr, errors.New("no such ippool")
+	}
+
+	if len(pe.Pool.GetOwners()) != 0 {
+		return IpamInUseErr, errors.New("ippool-inuse error")
+	}
+
+	delete(I.PoolMap, name)
+
+	tk.LogIt(tk.LogDebug, "ippool deleted - %s\n", name)
+
+	return 0, nil
+}
+
+// IPPoolGet - get all ip pools along with their allocations
+func (I *IpamH) IPPoolGet() ([]cmn.IPPoolMod, error) {
+	var res []cmn.IPPoolMod
+
+	for _, pe := range I.PoolMap {
+		pm := cmn.IPPoolMod{Name: pe.Name, CIDRs: pe.Pool.GetCIDRs(), Reserved: pe.Pool.GetReserved()}
+		for svc, ips := range pe.Pool.GetOwners() {
+			pm.Allocs = append(pm.Allocs, cmn.VIPAllocMod{Pool: pe.Name, Service: svc,
+				Family: ipamFamily(ips), IPs: ips})
+		}
+		sort.Slice(pm.Allocs, func(i, j int) bool { return pm.Allocs[i].Service < pm.Allocs[j].Service })
+		res = append(res, pm)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res, nil
+}
+
+// ipamFamily - get address family of a list of ips
+func ipamFamily(ips []string) string {
+	v4, v6 := false, false
+	for _, ip := range ips {
+		if net.ParseIP(ip).To4() != nil {
+			v4 = true
+		} else {
+			v6 = true
+		}
+	}
+	if v4 && v6 {
+		return cmn.VIPFamilyDual
+	} else if v6 {
+		return cmn.VIPFamilyIPv6
+	}
+	return cmn.VIPFamilyIPv4
+}
+
+// VIPAlloc - allocate VIPs for a service from an ip pool. Specific VIPs can
+// be asked for, otherwise new ones of the asked family are allocated. A
+// service which already holds VIPs of the family gets the same ones back
+func (I *IpamH) VIPAlloc(am cmn.VIPAllocMod) (cmn.VIPAllocMod, int, error) {
+	pe, found := I.PoolMap[am.Pool]
+	if !found {
+		return am, IpamNoExistErr, errors.New("no such ippool")
+	}
+
+	if am.Service == "" {
+		return am, IpamArgsErr, errors.New("vip-service error")
+	}
+
+	pool := pe.Pool
+	held := make(map[string]bool)
+	for _, ip := range pool.GetOwners()[am.Service] {
+		held[ip] = true
+	}
+
+	var newIPs []string
+	rollback := func() {
+		for _, ip := range newIPs {
+			pool.RetrieveIP(ip)
+		}
+	}
+
+	if len(am.IPs) != 0 {
+		for _, ip := range am.IPs {
+			if err := pool.AssignIP(am.Service, ip); err != nil {
+				rollback()
+				return am, IpamAllocErr, errors.New("vip-" + ip + " alloc error")
+			}
+			if !held[net.ParseIP(ip).String()] {
+				newIPs = append(newIPs, ip)
+			}
+		}
+	} else {
+		var families []bool
+		switch am.Family {
+		case "":
+			families = []bool{pool.IPv4Generator == nil}
+		case cmn.VIPFamilyIPv4:
+			families = []bool{false}
+		case cmn.VIPFamilyIPv6:
+			families = []bool{true}
+		case cmn.VIPFamilyDual:
+			families = []bool{false, true}
+		default:
+			return am, IpamArgsErr, errors.New("vip-family error")
+		}
+		for _, ipv6 := range families {
+			if (ipv6 && pool.IPv6Generator == nil) || (!ipv6 && pool.IPv4Generator == nil) {
+				rollback()
+				return am, IpamArgsErr, errors.New("vip-family error")
+			}
+			ip := pool.AssignNewIPOwner(am.Service, ipv6)
+			if ip == nil {
+				rollback()
+				return am, IpamAllocErr, errors.New("ippool-exhausted error")
+			}
+			if !held[ip.String()] {
+				newIPs = append(newIPs, ip.String())
+			}
+		}
+	}
+
+	ips := pool.GetOwners()[am.Service]
+	tk.LogIt(tk.LogDebug, "vip allocated - %s:%s:%v\n", am.Pool, am.Service, ips)
+
+	if len(newIPs) != 0 {
+		I.xsyncSend(ipamSyncAlloc, cmn.VIPAllocMod{Pool: am.Pool, Service: am.Service, IPs: newIPs})
+	}
+
+	return cmn.VIPAllocMod{Pool: am.Pool, Service: am.Service, Family: ipamFamily(ips), IPs: ips}, 0, nil
+}
+
+// VIPRelease - release VIPs of a service to its ip pool. If no VIPs are
+// given, all VIPs held by the service are released
+func (I *IpamH) VIPRelease(am cmn.VIPAllocMod) (int, error) {
+	pe, found := I.PoolMap[am.Pool]
+	if !found {
+		return IpamNoExistErr, errors.New("no such ippool")
+	}
+
+	held := pe.Pool.GetOwners()[am.Service]
+	if len(held) == 0 {
+		return IpamNoExistErr, errors.New("no such vip")
+	}
+
+	if len(am.IPs) == 0 {
+		pe.Pool.RetrieveOwner(am.Service)
+		tk.LogIt(tk.LogDebug, "vip released - %s:%s:%v\n", am.Pool, am.Service, held)
+		I.xsyncSend(ipamSyncRelease, cmn.VIPAllocMod{Pool: am.Pool, Service: am.Service, IPs: held})
+		return 0, nil
+	}
+
+	for _, ip := range am.IPs {
+		nIP := net.ParseIP(ip)
+		if nIP == nil {
+			return IpamArgsErr, errors.New("vip-" + ip + " error")
+		}
+		idx := sort.SearchStrings(held, nIP.String())
+		if idx >= len(held) || held[idx] != nIP.String() {
+			return IpamNoExistErr, errors.New("no such vip " + ip)
+		}
+	}
+	for _, ip := range am.IPs {
+		pe.Pool.RetrieveIP(ip)
+	}
+
+	tk.LogIt(tk.LogDebug, "vip released - %s:%s:%v\n", am.Pool, am.Service, am.IPs)
+	I.xsyncSend(ipamSyncRelease, cmn.VIPAllocMod{Pool: am.Pool, Service: am.Service, IPs: am.IPs})
+
+	return 0, nil
+}
+
+// ipamLbOwner - owner of the VIP of an lb rule in an ip pool
+func ipamLbOwner(r *ruleEnt) string {
+	if r.name != "" {
+		return r.name
+	}
+	return "lb:" + r.tuples.l3Dst.addr.IP.String()
+}
+
+// LbRulesSync - mark VIPs of existing lb rules as allocated in a pool, or in
+// all pools if name is empty. A VIP which is already allocated is left alone
+func (I *IpamH) LbRulesSync(name string) {
+	if I.Zone == nil || I.Zone.Rules == nil {
+		return
+	}
+
+	for _, r := range I.Zone.Rules.tables[RtLB].eMap {
+		if r.fwSnatOwned() {
+			continue
+		}
+		vips := []net.IP{r.tuples.l3Dst.addr.IP}
+		for _, sip := range r.secIP {
+			vips = append(vips, sip.sIP)
+		}
+		for _, vip := range vips {
+			for _, pe := range I.PoolMap {
+				if name != "" && pe.Name != name {
+					continue
+				}
+				if _, owned := pe.Pool.GetOwner(vip.String()); owned {
+					continue
+				}
+				owner := ipamLbOwner(r)
+				if err := pe.Pool.AssignIP(owner, vip.String()); err != nil {
+					continue
+				}
+				tk.LogIt(tk.LogInfo, "vip %s of lb rule allocated to %s in ippool %s\n", vip.String(), owner, pe.Name)
+				I.xsyncSend(ipamSyncAlloc, cmn.VIPAllocMod{Pool: pe.Name, Service: owner, IPs: []string{vip.String()}})
+			}
+		}
+	}
+}
+
+// xsyncSend - queue an ipam change to be synced to cluster peers
+func (I *IpamH) xsyncSend(op string, v interface{}) {
+	if mh.dp == nil || mh.has == nil || len(mh.has.NodeMap) == 0 {
+		return
+	}
+
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	cti := DpCtInfo{Proto: IpamXSyncProto, CState: op, PKey: buf, Sport: uint16(mh.self)}
+	select {
+	case I.syncCh <- cti:
+	default:
+		tk.LogIt(tk.LogError, "ipam xsync queue full - %s dropped\n", op)
+	}
+}
+
+// xsyncWorker - send queued ipam changes to cluster peers. It runs without
+// mh.mtx so that peers can take theirs while handling the changes
+func (I *IpamH) xsyncWorker() {
+	for cti := range I.syncCh {
+		if ret := mh.dp.DpXsyncRPC(DpSyncBcast, &cti); ret != 0 {
+			tk.LogIt(tk.LogError, "ipam xsync %s failed\n", cti.CState)
+		}
+	}
+}
+
+// XSyncAll - queue all ip pools and allocations to be synced to cluster peers.
+// mh.mtx needs to be held by the caller
+func (I *IpamH) XSyncAll() {
+	pools, _ := I.IPPoolGet()
+	for _, pm := range pools {
+		I.xsyncSend(ipamSyncPoolAdd, cmn.IPPoolMod{Name: pm.Name, CIDRs: pm.CIDRs, Reserved: pm.Reserved})
+		for _, am := range pm.Allocs {
+			I.xsyncSend(ipamSyncAlloc, cmn.VIPAllocMod{Pool: am.Pool, Service: am.Service, IPs: am.IPs})
+		}
+	}
+}
+
+// XSyncRecv - apply an ipam change synced from a cluster peer. When a VIP is
+// held by different services on two nodes, both keep it with the service
+// whose name sorts first so that they agree. mh.mtx needs to be held by the
+// caller
+func (I *IpamH) XSyncRecv(cti *DpCtInfo) int {
+	var pm cmn.IPPoolMod
+	var am cmn.VIPAllocMod
+
+	switch cti.CState {
+	case ipamSyncPoolAdd, ipamSyncPoolDel:
+		if err := json.Unmarshal(cti.PKey, &pm); err != nil {
+			return IpamArgsErr
+		}
+	case ipamSyncAlloc, ipamSyncRelease:
+		if err := json.Unmarshal(cti.PKey, &am); err != nil {
+			return IpamArgsErr
+		}
+	default:
+		return IpamArgsErr
+	}
+
+	switch cti.CState {
+	case ipamSyncPoolAdd:
+		if _, found := I.PoolMap[pm.Name]; found {
+			return 0
+		}
+		if _, err := I.ipPoolAdd(pm); err != nil {
+			tk.LogIt(tk.LogError, "ipam xsync - ippool %s add failed: %s\n", pm.Name, err)
+			return IpamArgsErr
+		}
+		I.LbRulesSync(pm.Name)
+	case ipamSyncPoolDel:
+		if _, found := I.PoolMap[pm.Name]; !found {
+			return 0
+		}
+		if _, err := I.ipPoolDelete(pm.Name); err != nil {
+			tk.LogIt(tk.LogError, "ipam xsync - ippool %s delete failed: %s\n", pm.Name, err)
+			return IpamInUseErr
+		}
+	case ipamSyncAlloc:
+		pe, found := I.PoolMap[am.Pool]
+		if !found {
+			return IpamNoExistErr
+		}
+		for _, ip := range am.IPs {
+			if cur, owned := pe.Pool.GetOwner(ip); owned && cur != am.Service {
+				if cur < am.Service {
+					tk.LogIt(tk.LogError, "ipam xsync - vip %s of %s kept, peer has it for %s\n", ip, cur, am.Service)
+					// Let the peer know so that it gives up its allocation
+					I.xsyncSend(ipamSyncAlloc, cmn.VIPAllocMod{Pool: am.Pool, Service: cur, IPs: []string{ip}})
+					continue
+				}
+				tk.LogIt(tk.LogError, "ipam xsync - vip %s of %s moved to %s of peer\n", ip, cur, am.Service)
+				pe.Pool.RetrieveIP(ip)
+			}
+			if err := pe.Pool.AssignIP(am.Service, ip); err != nil {
+				tk.LogIt(tk.LogError, "ipam xsync - vip %s alloc failed: %s\n", ip, err)
+			}
+		}
+	case ipamSyncRelease:
+		pe, found := I.PoolMap[am.Pool]
+		if !found {
+			return 0
+		}
+		for _, ip := range am.IPs {
+			if cur, owned := pe.Pool.GetOwner(ip); owned && cur == am.Service {
+				pe.Pool.RetrieveIP(ip)
+			}
+		}
+	}
+
+	tk.LogIt(tk.LogDebug, "ipam xsync - %s %s applied\n", cti.CState, string(cti.PKey))
+
+	return 0
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"net"
+	"sort"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// error codes
+const (
+	IPSetErrBase = iota - 105000
+	IPSetArgsErr
+	IPSetExistsErr
+	IPSetNoExistErr
+	IPSetInUseErr
+)
+
+// constants
+const (
+	IPSetMaxCIDRs   = 64 * 1024
+	IPSetMaxFwsEbpf = 1024 // Max eBPF fw entries of a fw rule using ip sets
+)
+
+// IPSetEnt - a named ip set
+type IPSetEnt struct {
+	Name  string
+	CIDRs []net.IPNet
+}
+
+// IPSetH - context container
+type IPSetH struct {
+	SetMap map[string]*IPSetEnt
+	Zone   *Zone
+}
+
+// IPSetInit - Initialize the ip set subsystem
+func IPSetInit(zone *Zone) *IPSetH {
+	var nSh = new(IPSetH)
+	nSh.SetMap = make(map[string]*IPSetEnt)
+	nSh.Zone = zone
+	return nSh
+}
+
+// ipSetNameValid - check if name can be used for an ip set. A name has to
+// start with a letter so that it is never taken for an address
+func ipSetNameValid(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-' || c == '_'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ipSetCIDRs - parse members of an ip set. Duplicates are removed and the
+// members are kept sorted
+func ipSetCIDRs(cidrs []string) ([]net.IPNet, error) {
+	var nets []net.IPNet
+
+	if len(cidrs) > IPSetMaxCIDRs {
+		return nil, errors.New("ipset-size error")
+	}
+
+	seen := make(map[string]bool)
+	for _, cidr := range cidrs {
+		_, ipn, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.New("ipset-cidr error")
+		}
+		if seen[ipn.String()] {
+			continue
+		}
+		seen[ipn.String()] = true
+		nets = append(nets, *ipn)
+	}
+	sort.Slice(nets, func(i, j int) bool { return nets[i].String() < nets[j].String() })
+
+	return nets, nil
+}
+
+// fwRules - get firewall rules which use an ip set
+func (S *IPSetH) fwRules(name string) []*ruleEnt {
+	var rules []*ruleEnt
+
+	if S.Zone == nil || S.Zone.Rules == nil {
+		return nil
+	}
+	for _, r := range S.Zone.Rules.tables[RtFw].eMap {
+		if r.tuples.l3SrcSet.val == name || r.tuples.l3DstSet.val == name {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// ipSetV4Members - get IPv4 members of an ip set
+func ipSetV4Members(nets []net.IPNet) []net.IPNet {
+	var v4s []net.IPNet
+
+	for _, n := range nets {
+		if n.IP.To4() != nil {
+			v4s = append(v4s, n)
+		}
+	}
+	return v4s
+}
+
+// ebpfFws - get the number of eBPF fw entries a fw rule using sets srcSet
+// and/or dstSet has, with members nets taken for set name if given
+func (S *IPSetH) ebpfFws(srcSet, dstSet, name string, nets []net.IPNet) int {
+	n := 1
+
+	for _, set := range []string{srcSet, dstSet} {
+		if set == "" {
+			continue
+		}
+		members := nets
+		if set != name {
+			members, _ = S.IPSetFind(set)
+		}
+		n *= len(ipSetV4Members(members))
+	}
+	return n
+}
+
+// IPSetFind - get members of an ip set
+func (S *IPSetH) IPSetFind(name string) ([]net.IPNet, bool) {
+	se, found := S.SetMap[name]
+	if !found {
+		return nil, false
+	}
+	return se.CIDRs, true
+}
+
+// IPSetAdd - add a named ip set
+func (S *IPSetH) IPSetAdd(sm cmn.IPSetMod) (int, error) {
+	if !ipSetNameValid(sm.Name) {
+		return IPSetArgsErr, errors.New("ipset-name error")
+	}
+
+	if _, found := S.SetMap[sm.Name]; found {
+		return IPSetExistsErr, errors.New("ipset-exists error")
+	}
+
+	nets, err := ipSetCIDRs(sm.CIDRs)
+	if err != nil {
+		return IPSetArgsErr, err
+	}
+
+	S.SetMap[sm.Name] = &IPSetEnt{Name: sm.Name, CIDRs: nets}
+
+	tk.LogIt(tk.LogDebug, "ipset added - %s:%d\n", sm.Name, len(nets))
+
+	return 0, nil
+}
+
+// IPSetUpdate - replace all members of an ip set at once. Firewall rules
+// using the set are re-programmed in the datapath but are otherwise left as is
+func (S *IPSetH) IPSetUpdate(sm cmn.IPSetMod) (int, error) {
+	se, found := S.SetMap[sm.Name]
+	if !found {
+		return IPSetNoExistErr, errors.New("no such ipset")
+	}
+
+	nets, err := ipSetCIDRs(sm.CIDRs)
+	if err != nil {
+		return IPSetArgsErr, err
+	}
+
+	rules := S.fwRules(sm.Name)
+	if mh.dpEbpf != nil {
+		for _, r := range rules {
+			if S.ebpfFws(r.tuples.l3SrcSet.val, r.tuples.l3DstSet.val, sm.Name, nets) > IPSetMaxFwsEbpf {
+				return IPSetArgsErr, errors.New("ipset-size error: too many fw entries for ebpf dp")
+			}
+		}
+	}
+
+	se.CIDRs = nets
+
+	for _, r := range rules {
+		r.Fw2DP(DpCreate)
+	}
+
+	tk.LogIt(tk.LogDebug, "ipset updated - %s:%d (%d fw-rules)\n", sm.Name, len(nets), len(rules))
+
+	return 0, nil
+}
+
+// IPSetDelete - delete an ip set. A set used by firewall rules can't be deleted
+func (S *IPSetH) IPSetDelete(name string) (int, error) {
+	if _, found := S.SetMap[name]; !found {
+		return IPSetNoExistErr, errors.New("no such ipset")
+	}
+
+	if len(S.fwRules(name)) != 0 {
+		return IPSetInUseErr, errors.New("ipset-inuse error")
+	}
+
+	delete(S.SetMap, name)
+
+	tk.LogIt(tk.LogDebug, "ipset deleted - %s\n", name)
+
+	return 0, nil
+}
+
+// IPSetGet - get all ip sets
+func (S *IPSetH) IPSetGet() ([]cmn.IPSetMod, error) {
+	var res []cmn.IPSetMod
+
+	for _, se := range S.SetMap {
+		sm := cmn.IPSetMod{Name: se.Name, CIDRs: make([]string, 0, len(se.CIDRs))}
+		for _, ipn := range se.CIDRs {
+			sm.CIDRs = append(sm.CIDRs, ipn.String())
+		}
+		sm.Refs = len(S.fwRules(se.Name))
+		res = append(res, sm)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res, nil
+}
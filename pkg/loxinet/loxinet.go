@@ -60,6 +60,7 @@ const (
 
 type loxiNetH struct {
 	dpEbpf      *DpEbpfH
+	dpUser      *DpUserH
 	dp          *DpH
 	zn          *ZoneH
 	zr          *Zone
@@ -81,6 +82,10 @@ type loxiNetH struct {
 	sockMapEn   bool
 	disBPF      bool
 	pFile       *os.File
+	cfgStore    *CfgStoreH
+	fwLog       *FwLogH
+	execMx      sync.RWMutex
+	reapCh      chan struct{}
 }
 
 // NodeWalker - an implementation of node walker interface
@@ -96,7 +101,9 @@ func (mh *loxiNetH) ParamSet(param cmn.ParamMod) (int, error) {
 		mh.logger.LogItSetLevel(logLevel)
 	}
 
-	DpEbpfSetLogLevel(logLevel)
+	if mh.dpEbpf != nil {
+		DpEbpfSetLogLevel(logLevel)
+	}
 
 	return 0, nil
 }
@@ -130,6 +137,24 @@ func (mh *loxiNetH) ParamGet(param *cmn.ParamMod) (int, error) {
 	return 0, nil
 }
 
+// loxiNetReaper - this routine reaps exited children on SIGCHLD. Exec probes
+// wait on their own children, so reaping is held off while any of them runs
+// lest it steals their exit status
+func loxiNetReaper() {
+	for range mh.reapCh {
+		mh.execMx.Lock()
+		var ws syscall.WaitStatus
+		var ru syscall.Rusage
+		wpid := 1
+		try := 0
+		for wpid > 0 && try < 100 {
+			wpid, _ = syscall.Wait4(-1, &ws, syscall.WNOHANG, &ru)
+			try++
+		}
+		mh.execMx.Unlock()
+	}
+}
+
 // loxiNetTicker - this ticker routine runs every LOXINET_TIVAL seconds
 func loxiNetTicker(bgpPeerMode bool) {
 
@@ -149,13 +174,9 @@ func loxiNetTicker(bgpPeerMode bool) {
 			return
 		case sig := <-mh.sigCh:
 			if sig == syscall.SIGCHLD {
-				var ws syscall.WaitStatus
-				var ru syscall.Rusage
-				wpid := 1
-				try := 0
-				for wpid >= 0 && try < 100 {
-					wpid, _ = syscall.Wait4(-1, &ws, syscall.WNOHANG, &ru)
-					try++
+				select {
+				case mh.reapCh <- struct{}{}:
+				default:
 				}
 			} else if sig == syscall.SIGHUP {
 				tk.LogIt(tk.LogCritical, "SIGHUP received\n")
@@ -165,7 +186,7 @@ func loxiNetTicker(bgpPeerMode bool) {
 				// TODO - More subsystem cleanup TBD
 				mh.zr.Rules.RuleDestructAll()
 				if !bgpPeerMode {
-					mh.dpEbpf.DpEbpfUnInit()
+					mh.dp.DpHooks.DpEbpfUnInit()
 				}
 				apiserver.ApiServerShutOk()
 			}
@@ -204,7 +225,7 @@ func loxiNetInit() {
 
 	// It is important to make sure loxilb's eBPF filesystem
 	// is in place and mounted to make sure maps are pinned properly
-	if !opts.Opts.ProxyModeOnly {
+	if !opts.Opts.ProxyModeOnly && !opts.Opts.UserSpaceDp {
 		if !utils.FileExists(BpfFsCheckFile) {
 			if utils.FileExists(MkfsScript) {
 				RunCommand(MkfsScript, true)
@@ -224,6 +245,8 @@ func loxiNetInit() {
 	mh.sockMapEn = opts.Opts.SockMapSupport
 	mh.disBPF = opts.Opts.ProxyModeOnly
 	mh.sigCh = make(chan os.Signal, 5)
+	mh.reapCh = make(chan struct{}, 1)
+	go loxiNetReaper()
 	signal.Notify(mh.sigCh, os.Interrupt, syscall.SIGCHLD, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
 
 	// Check if profiling is enabled
@@ -240,6 +263,9 @@ func loxiNetInit() {
 			return
 		}
 	}
+	// Load the saved config. It is restored once the subsystems are up
+	mh.cfgStore = CfgStoreInit(opts.Opts.ConfigPath)
+
 	if opts.Opts.RPC == "netrpc" {
 		rpcMode = RPCTypeNetRPC
 	} else {
@@ -247,12 +273,22 @@ func loxiNetInit() {
 	}
 
 	if !opts.Opts.BgpPeerMode {
-		if mh.lSockPolicy {
-			RunCommand(MkMountCG2, false)
+		// Initialize the firewall hit log subsystem. It needs to be up
+		// before datapath starts reporting hits
+		mh.fwLog = FwLogInit()
+
+		if opts.Opts.UserSpaceDp {
+			// Initialize the userspace reference datapath subsystem
+			mh.dpUser = DpUserInit()
+			mh.dp = DpBrokerInit(mh.dpUser, rpcMode)
+		} else {
+			if mh.lSockPolicy {
+				RunCommand(MkMountCG2, false)
+			}
+			// Initialize the ebpf datapath subsystem
+			mh.dpEbpf = DpEbpfInit(clusterMode, mh.rssEn, mh.eHooks, mh.lSockPolicy, mh.sockMapEn, mh.self, mh.disBPF, -1)
+			mh.dp = DpBrokerInit(mh.dpEbpf, rpcMode)
 		}
-		// Initialize the ebpf datapath subsystem
-		mh.dpEbpf = DpEbpfInit(clusterMode, mh.rssEn, mh.eHooks, mh.lSockPolicy, mh.sockMapEn, mh.self, mh.disBPF, -1)
-		mh.dp = DpBrokerInit(mh.dpEbpf, rpcMode)
 
 		// Initialize the security zone subsystem
 		mh.zn = ZoneInit()
@@ -264,6 +300,9 @@ func loxiNetInit() {
 			tk.LogIt(tk.LogError, "root zone not found\n")
 			return
 		}
+		if _, err := mh.zr.Rules.SetFwDefaultPolicy(opts.Opts.FwDefaultPolicy); err != nil {
+			tk.LogIt(tk.LogError, "fw default policy %s not set\n", opts.Opts.FwDefaultPolicy)
+		}
 
 		// Initialize the clustering subsystem
 		mh.has = CIInit(kaArgs)
@@ -322,6 +361,12 @@ func loxiNetInit() {
 		// Spawn CI maintenance application
 		mh.has.CISpawn()
 	}
+	// The nlp subsystem restores the saved config after it has synced with
+	// the kernel. Otherwise, it needs to be done here
+	if opts.Opts.NoNlp || opts.Opts.BgpPeerMode {
+		mh.cfgStore.Restore(NetAPIInit(opts.Opts.BgpPeerMode))
+	}
+
 	// Initialize the loxinet global ticker(s)
 	mh.tDone = make(chan bool)
 	mh.ticker = time.NewTicker(LoxinetTiVal * time.Second)
@@ -17,9 +17,14 @@
 package loxinet
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"net"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	cmn "github.com/loxilb-io/loxilb/common"
 	opts "github.com/loxilb-io/loxilb/options"
@@ -34,6 +39,7 @@ func TestLoxinet(t *testing.T) {
 	opts.Opts.Prometheus = false
 	opts.Opts.K8sAPI = "none"
 	opts.Opts.ClusterNodes = "none"
+	opts.Opts.UserSpaceDp = true
 
 	fmt.Printf("LoxiLB Net Unit-Test \n")
 	loxiNetInit()
@@ -294,11 +300,996 @@ func TestLoxinet(t *testing.T) {
 		t.Errorf("failed to add nat lb rule for 10.10.10.1\n")
 	}
 
+	if mh.dpUser != nil {
+		var natW *NatDpWorkQ
+		for try := 0; try < 5 && natW == nil; try++ {
+			time.Sleep(1 * time.Second)
+			natW = mh.dpUser.DpUserNatGet(mh.zr.ZoneNum, net.ParseIP("10.10.10.1"), 2020, 6, 0)
+		}
+		if natW == nil || len(natW.endPoints) != 2 {
+			t.Errorf("nat lb rule for 10.10.10.1 not programmed in userspace dp\n")
+		} else {
+			pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 1), 40001, 2020)
+			res, err := mh.dpUser.DpUserPktIn(12, pkt)
+			if err != nil || res.EpIdx < 0 {
+				t.Errorf("userspace dp failed to select end-point for 10.10.10.1\n")
+			} else if !net.IP(res.Pkt[30:34]).Equal(net.IPv4(32, 32, 32, 1)) ||
+				binary.BigEndian.Uint16(res.Pkt[36:38]) != 5001 {
+				t.Errorf("userspace dp failed to dnat 10.10.10.1 to 32.32.32.1:5001\n")
+			}
+		}
+
+		p, _ := parseUserDpPkt(userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 1), 40002, 2020))
+		if p == nil || p.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.ParseIP("3ffe::1"), 5001) == nil {
+			t.Errorf("userspace dp rewrote ipv4 packet to ipv6 address\n")
+		}
+
+		pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 1), 40002, 2020)
+		padPkt := append(append([]byte(nil), pkt...), make([]byte, 6)...)
+		padPkt[59] = 0xff
+		p, _ = parseUserDpPkt(pkt)
+		pp, _ := parseUserDpPkt(padPkt)
+		if p == nil || pp == nil || p.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(32, 32, 32, 1), 5001) != nil ||
+			pp.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(32, 32, 32, 1), 5001) != nil ||
+			binary.BigEndian.Uint16(pkt[50:52]) != binary.BigEndian.Uint16(padPkt[50:52]) {
+			t.Errorf("userspace dp checksummed link padding of tcp packet\n")
+		}
+
+		fragPkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 1), 40002, 2020)
+		binary.BigEndian.PutUint16(fragPkt[20:], 0x2000)
+		p, _ = parseUserDpPkt(fragPkt)
+		if p == nil || p.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(32, 32, 32, 1), 5001) == nil {
+			t.Errorf("userspace dp rewrote ports of ip fragment\n")
+		}
+		fragPkt = userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 1), 40002, 2020)
+		p, _ = parseUserDpPkt(fragPkt)
+		p.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(10, 10, 10, 1), 2020)
+		binary.BigEndian.PutUint16(fragPkt[20:], 0x2000)
+		pkt = append([]byte(nil), fragPkt...)
+		p, _ = parseUserDpPkt(pkt)
+		pp, _ = parseUserDpPkt(fragPkt)
+		p.frag = false
+		if p.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(32, 32, 32, 1), 2020) != nil || pp == nil ||
+			pp.rewrite(net.IPv4(20, 20, 20, 1), 40002, net.IPv4(32, 32, 32, 1), 2020) != nil ||
+			binary.BigEndian.Uint16(fragPkt[50:52]) != binary.BigEndian.Uint16(pkt[50:52]) {
+			t.Errorf("userspace dp checksum of first ip fragment not adjusted\n")
+		}
+	}
+
 	_, err = mh.zr.Rules.DeleteNatLbRule(lbServ)
 	if err != nil {
 		t.Errorf("failed to delete nat lb rule for 10.10.10.1\n")
 	}
 
+	lbServ.ProbeType = HostProbeHTTP
+	lbServ.ProbePort = 8080
+	lbServ.ProbeRespCodes = []int{200, 204}
+	lbServ.ProbeRespRegex = "\"status\":\"(ok"
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.1 with bad http probe regex\n")
+	}
+
+	lbServ.ProbeRespRegex = "\"status\":\"ok\""
+	lbServ.ProbeHeaders = []string{"X-Probe:loxilb"}
+	lbServ.ProbeTimeout = 5
+	lbServ.ProbeInterval = 30
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.1 with http probe\n")
+	} else {
+		ep := mh.zr.Rules.epMap[makeEPKey("32.32.32.1", HostProbeConnectTCP, 5001)]
+		if ep == nil || ep.opts.probeDuration != 30 || ep.probeTimeout() != 5*time.Second {
+			t.Errorf("http probe interval or timeout of 10.10.10.1 end-points not set\n")
+		}
+
+		shServ := lbServ
+		shServ.ServPort = 2021
+		shServ.ProbeHost = "other.loxilb.io"
+		_, err = mh.zr.Rules.AddNatLbRule(shServ, nil, lbEps[:])
+		if err == nil {
+			t.Errorf("added nat lb rule for 10.10.10.1:2021 with conflicting http probe\n")
+			mh.zr.Rules.DeleteNatLbRule(shServ)
+		}
+		shServ.ProbeHost = lbServ.ProbeHost
+		_, err = mh.zr.Rules.AddNatLbRule(shServ, nil, lbEps[:])
+		if err != nil {
+			t.Errorf("failed to add nat lb rule for 10.10.10.1:2021 with same http probe\n")
+		}
+		_, err = mh.zr.Rules.DeleteNatLbRule(shServ)
+		if err != nil {
+			t.Errorf("failed to delete nat lb rule for 10.10.10.1:2021\n")
+		}
+	}
+	lbServ.ProbeTimeout = 30
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err == nil {
+		t.Errorf("updated nat lb rule for 10.10.10.1 with probe timeout not below interval\n")
+	}
+	lbServ.ProbeTimeout = 120
+	lbServ.ProbeInterval = 0
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err != nil {
+		t.Errorf("failed to update nat lb rule for 10.10.10.1 with probe timeout as interval\n")
+	} else {
+		ep := mh.zr.Rules.epMap[makeEPKey("32.32.32.1", HostProbeConnectTCP, 5001)]
+		if ep == nil || ep.opts.probeDuration != 120 || ep.probeTimeout() != DflHostProbeWait*time.Second {
+			t.Errorf("probe interval or timeout of 10.10.10.1 end-points not set from probe timeout\n")
+		}
+	}
+	lbServ.ProbeTimeout = 0
+
+	_, err = mh.zr.Rules.DeleteNatLbRule(lbServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.1 with http probe\n")
+	}
+
+	lbServ.ProbeType = HostProbeGRPC
+	lbServ.ProbePort = 0
+	lbServ.ProbeRespCodes = nil
+	lbServ.ProbeRespRegex = ""
+	lbServ.ProbeHeaders = nil
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.1 with grpc probe without port\n")
+	}
+
+	lbServ.ProbePort = 9090
+	lbServ.ProbeReq = "loxilb.Svc"
+	_, err = mh.zr.Rules.AddNatLbRule(lbServ, nil, lbEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.1 with grpc probe\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteNatLbRule(lbServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.1 with grpc probe\n")
+	}
+
+	odServ := cmn.LbServiceArg{ServIP: "10.10.10.2", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr,
+		OdFailRatio: 50, OdMaxEjectPct: 50}
+	odEps := []cmn.LbEndPointArg{
+		{
+			EpIP:   "32.32.32.1",
+			EpPort: 5001,
+			Weight: 1,
+		},
+		{
+			EpIP:   "32.32.32.2",
+			EpPort: 5001,
+			Weight: 1,
+		},
+		{
+			EpIP:   "32.32.32.3",
+			EpPort: 5001,
+			Weight: 1,
+		},
+	}
+	_, err = mh.zr.Rules.AddNatLbRule(odServ, nil, odEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.2 with outlier detection\n")
+	}
+
+	odRule := mh.zr.Rules.GetNatLbRuleByServArgs(odServ)
+	if odRule != nil {
+		odStats := map[string]*epCtStats{
+			"32.32.32.1:5001": {total: 10, fails: 8},
+			"32.32.32.2:5001": {total: 10, fails: 9},
+			"32.32.32.3:5001": {total: 10, fails: 0},
+		}
+		if !mh.zr.Rules.outlierDetect(odRule, odStats) {
+			t.Errorf("no outlier ejected for 10.10.10.2\n")
+		}
+		lbRules, _ := mh.zr.Rules.GetNatLbRule()
+		ejected := 0
+		for _, lbr := range lbRules {
+			if lbr.Serv.ServIP != "10.10.10.2" {
+				continue
+			}
+			for _, ep := range lbr.Eps {
+				if ep.State == "ejected" {
+					ejected++
+				}
+			}
+		}
+		if ejected != 1 {
+			t.Errorf("outliers ejected for 10.10.10.2 %d(expected 1)\n", ejected)
+		}
+
+		eps := odRule.act.action.(*ruleNatActs).endPoints
+		for i := range eps {
+			eps[i].odUntil = time.Now()
+		}
+		mh.zr.Rules.outlierDetect(odRule, nil)
+		for _, ep := range eps {
+			if ep.odEjected {
+				t.Errorf("outlier %s for 10.10.10.2 not brought back\n", ep.xIP.String())
+			}
+		}
+
+		odCts := make([]*DpCtInfo, 0)
+		for i, state := range []string{"sync-sent", "closed", "h/e"} {
+			odCts = append(odCts, &DpCtInfo{SIP: net.ParseIP("20.20.20.1"), DIP: net.ParseIP("10.10.10.2"),
+				Sport: uint16(40001 + i), Dport: 2020, Proto: "tcp", CState: state, CAct: "dnat-32.32.32.1:5001:w1"})
+		}
+		st := odRule.outlierWindowStats(odCts)["32.32.32.1:5001"]
+		if st == nil || st.total != 3 || st.fails != 1 {
+			t.Errorf("outlier window stats of 10.10.10.2 wrong %v(expected 3/1)\n", st)
+		}
+		odCts[0].CState = "h/e"
+		st = odRule.outlierWindowStats(odCts)["32.32.32.1:5001"]
+		if st == nil || st.total != 1 || st.fails != 1 {
+			t.Errorf("outlier window stats of 10.10.10.2 wrong %v(expected 1/1)\n", st)
+		}
+		if st = odRule.outlierWindowStats(odCts)["32.32.32.1:5001"]; st != nil {
+			t.Errorf("outlier window stats of 10.10.10.2 counted old failures %v\n", st)
+		}
+		synCt := &DpCtInfo{SIP: net.ParseIP("20.20.20.1"), DIP: net.ParseIP("10.10.10.2"),
+			Sport: 40004, Dport: 2020, Proto: "tcp", CState: "sync-sent", CAct: "dnat-32.32.32.1:5001:w1"}
+		odCts = append(odCts, synCt)
+		st = odRule.outlierWindowStats(odCts)["32.32.32.1:5001"]
+		if st == nil || st.total != 1 || st.fails != 0 {
+			t.Errorf("outlier window stats of 10.10.10.2 wrong %v(expected 1/0)\n", st)
+		}
+		seen := odRule.od.ctSeen[synCt.Key()]
+		seen.synTs = seen.synTs.Add(-OdSynSentAge * time.Second)
+		odRule.od.ctSeen[synCt.Key()] = seen
+		st = odRule.outlierWindowStats(odCts)["32.32.32.1:5001"]
+		if st == nil || st.total != 1 || st.fails != 1 {
+			t.Errorf("outlier window stats of 10.10.10.2 missed stale syn %v(expected 1/1)\n", st)
+		}
+	} else {
+		t.Errorf("failed to find nat lb rule for 10.10.10.2\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteNatLbRule(odServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.2\n")
+	}
+
+	ssServ := cmn.LbServiceArg{ServIP: "10.10.10.3", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr,
+		SlowStart: 100}
+	_, err = mh.zr.Rules.AddNatLbRule(ssServ, nil, odEps[:2])
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.3 with slow-start and rr selection\n")
+	}
+
+	ssServ.Sel = cmn.LbSelPrio
+	_, err = mh.zr.Rules.AddNatLbRule(ssServ, nil, odEps[:2])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.3 with slow-start\n")
+	}
+
+	ssServ.Oper = cmn.LBOPAttach
+	ssEps := []cmn.LbEndPointArg{{EpIP: "32.32.32.3", EpPort: 5001, Weight: 50}}
+	_, err = mh.zr.Rules.AddNatLbRule(ssServ, nil, ssEps)
+	if err != nil {
+		t.Errorf("failed to attach end-point to nat lb rule for 10.10.10.3\n")
+	}
+
+	ssRule := mh.zr.Rules.GetNatLbRuleByServArgs(ssServ)
+	if ssRule != nil {
+		eps := ssRule.act.action.(*ruleNatActs).endPoints
+		for i := range eps {
+			ep := &eps[i]
+			if ep.xIP.Equal(net.IPv4(32, 32, 32, 3)) {
+				if ep.ssStart.IsZero() || ep.currWeight() != 5 {
+					t.Errorf("end-point 32.32.32.3 of 10.10.10.3 not in slow-start (w%d)\n", ep.currWeight())
+				}
+				ep.ssStart = ep.ssStart.Add(-50 * time.Second)
+				if !ssRule.slowStartSync() || ep.currWeight() != 27 {
+					t.Errorf("end-point 32.32.32.3 of 10.10.10.3 slow-start weight %d(expected 27)\n", ep.currWeight())
+				}
+				ep.ssStart = ep.ssStart.Add(-50 * time.Second)
+				if !ssRule.slowStartSync() || !ep.ssStart.IsZero() || ep.currWeight() != 50 {
+					t.Errorf("end-point 32.32.32.3 of 10.10.10.3 slow-start not over\n")
+				}
+			} else if !ep.ssStart.IsZero() {
+				t.Errorf("end-point %s of 10.10.10.3 in slow-start\n", ep.xIP.String())
+			}
+		}
+	} else {
+		t.Errorf("failed to find nat lb rule for 10.10.10.3\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteNatLbRule(ssServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.3\n")
+	}
+
+	drServ := cmn.LbServiceArg{ServIP: "10.10.10.4", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr,
+		DrainTimeout: 60}
+	_, err = mh.zr.Rules.AddNatLbRule(drServ, nil, odEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.4 with drain timeout\n")
+	}
+
+	drServ.Oper = cmn.LBOPDetach
+	_, err = mh.zr.Rules.AddNatLbRule(drServ, nil, odEps[1:2])
+	if err != nil {
+		t.Errorf("failed to detach end-point from nat lb rule for 10.10.10.4\n")
+	}
+
+	drRule := mh.zr.Rules.GetNatLbRuleByServArgs(drServ)
+	if drRule != nil {
+		drStats := map[string]*epCtStats{"32.32.32.2:5001": {total: 3, active: 2}}
+		mh.zr.Rules.drainSync(drRule, drStats)
+		draining := 0
+		lbRules, _ := mh.zr.Rules.GetNatLbRule()
+		for _, lbr := range lbRules {
+			if lbr.Serv.ServIP != "10.10.10.4" {
+				continue
+			}
+			for _, ep := range lbr.Eps {
+				if ep.State == "draining" && ep.EpIP == "32.32.32.2" && ep.ActConns == 2 {
+					draining++
+				}
+			}
+		}
+		if draining != 1 {
+			t.Errorf("end-point 32.32.32.2 of 10.10.10.4 not draining\n")
+		}
+		if !mh.zr.Rules.drainSync(drRule, nil) || len(drRule.act.action.(*ruleNatActs).endPoints) != 2 {
+			t.Errorf("drained end-point 32.32.32.2 of 10.10.10.4 not removed\n")
+		}
+		pType, pPort := dflEPProbe(drRule.tuples.l4Prot.val, 5001)
+		mh.zr.Rules.epMx.RLock()
+		drHost := mh.zr.Rules.epMap[makeEPKey("32.32.32.2", pType, pPort)]
+		mh.zr.Rules.epMx.RUnlock()
+		if drHost != nil && drHost.ruleCount > 0 {
+			t.Errorf("drained end-point 32.32.32.2 of 10.10.10.4 still refers ep-host(%d)\n", drHost.ruleCount)
+		}
+	} else {
+		t.Errorf("failed to find nat lb rule for 10.10.10.4\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteNatLbRule(drServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.4\n")
+	}
+
+	var mgEps []NatEP
+	for i := 1; i <= 10; i++ {
+		mgEps = append(mgEps, NatEP{XIP: net.ParseIP(fmt.Sprintf("33.33.33.%d", i)), XPort: 8080, Weight: 1})
+	}
+	mgTbl := MaglevTable(mgEps)
+	mgEps[4].InActive = true
+	mgTbl1 := MaglevTable(mgEps)
+	if len(mgTbl) != MaglevTblSize || len(mgTbl1) != MaglevTblSize {
+		t.Errorf("maglev table size mismatch\n")
+	} else {
+		moved := 0
+		for i := range mgTbl {
+			if mgTbl[i] != 4 && mgTbl[i] != mgTbl1[i] {
+				moved++
+			}
+			if mgTbl1[i] == 4 {
+				t.Errorf("maglev table has inactive end-point\n")
+				break
+			}
+		}
+		if moved > MaglevTblSize/10 {
+			t.Errorf("maglev table remapped %d slots on end-point removal\n", moved)
+		}
+	}
+	mgRev := []NatEP{mgEps[9], mgEps[0], mgEps[1], mgEps[2], mgEps[3], mgEps[4], mgEps[5], mgEps[6], mgEps[7], mgEps[8]}
+	mgTbl2 := MaglevTable(mgRev)
+	for i := range mgTbl1 {
+		if !mgRev[mgTbl2[i]].XIP.Equal(mgEps[mgTbl1[i]].XIP) {
+			t.Errorf("maglev table depends on end-point order\n")
+			break
+		}
+	}
+
+	mgServ := cmn.LbServiceArg{ServIP: "10.10.10.5", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelMaglev}
+	_, err = mh.zr.Rules.AddNatLbRule(mgServ, nil, odEps[:])
+	if err != nil {
+		t.Errorf("failed to add maglev nat lb rule for 10.10.10.5\n")
+	}
+	lbRules, _ := mh.zr.Rules.GetNatLbRule()
+	for _, lbr := range lbRules {
+		if lbr.Serv.ServIP == "10.10.10.5" && lbr.Serv.Sel != cmn.LbSelMaglev {
+			t.Errorf("nat lb rule for 10.10.10.5 not using maglev selection\n")
+		}
+	}
+	_, err = mh.zr.Rules.DeleteNatLbRule(mgServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.5\n")
+	}
+
+	// eBPF dp gets end-point slots sampled off the maglev table
+	mgEps[4].InActive = false
+	mgSlots := MaglevSlotEPs(mgEps, mgTbl, MaxNatEndPoints)
+	mgEps[4].InActive = true
+	mgSlots1 := MaglevSlotEPs(mgEps, mgTbl1, MaxNatEndPoints)
+	if len(mgSlots) != MaxNatEndPoints || len(mgSlots1) != MaxNatEndPoints {
+		t.Errorf("maglev end-point slots size mismatch\n")
+	} else {
+		moved := 0
+		for i := range mgSlots1 {
+			if mgSlots1[i].InActive {
+				t.Errorf("maglev end-point slots have inactive end-point\n")
+				break
+			}
+			if !mgSlots[i].XIP.Equal(mgEps[4].XIP) && !mgSlots[i].XIP.Equal(mgSlots1[i].XIP) {
+				moved++
+			}
+		}
+		if moved > 2 {
+			t.Errorf("maglev end-point slots remapped %d slots on end-point removal\n", moved)
+		}
+	}
+
+	var grpEps []cmn.LbEndPointArg
+	for i := 1; i <= MaxNatEndPoints+1; i++ {
+		grpEps = append(grpEps, cmn.LbEndPointArg{EpIP: fmt.Sprintf("32.32.33.%d", i), EpPort: 5001, Weight: 1})
+	}
+	grpServ := cmn.LbServiceArg{ServIP: "10.10.10.6", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(grpServ, nil, grpEps)
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.6 with %d end-points\n", len(grpEps))
+	}
+
+	srServ := cmn.LbServiceArg{ServIP: "10.10.10.7", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr,
+		SrcRanges: []string{"20.20.20.0/24", "2001::/64"}}
+	_, err = mh.zr.Rules.AddNatLbRule(srServ, nil, odEps[:])
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.7 with ipv6 source range\n")
+	}
+	srServ.SrcRanges = []string{"20.20.20.0/24"}
+	_, err = mh.zr.Rules.AddNatLbRule(srServ, nil, odEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.7 with source ranges\n")
+	}
+	srFws := 0
+	for _, fw := range mh.zr.Rules.tables[RtFw].eMap {
+		if fw.fwLbOwned() && fw.tuples.l3Dst.addr.String() == "10.10.10.7/32" {
+			srFws++
+		}
+	}
+	if srFws != 24 {
+		t.Errorf("source range fw rules for 10.10.10.7 not added\n")
+	}
+	fwRules, _ := mh.zr.Rules.GetFwRule()
+	for _, fw := range fwRules {
+		if fw.Rule.DstIP == "10.10.10.7/32" {
+			t.Errorf("source range fw rules for 10.10.10.7 not hidden\n")
+			break
+		}
+	}
+	_, err = mh.zr.Rules.DeleteFwRule(cmn.FwRuleArg{SrcIP: "128.0.0.0/1", DstIP: "10.10.10.7/32",
+		DstPortMin: 2020, DstPortMax: 2020, Proto: 6, Pref: LbSrcRangeDropPref})
+	if err == nil {
+		t.Errorf("deleted source range fw rule for 10.10.10.7\n")
+	}
+	srServ1 := srServ
+	srServ1.BlockNum = 1
+	_, err = mh.zr.Rules.AddNatLbRule(srServ1, nil, odEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.7 block 1 with same source ranges\n")
+	}
+	srFws = 0
+	for _, fw := range mh.zr.Rules.tables[RtFw].eMap {
+		if fw.fwLbOwned() && fw.tuples.l3Dst.addr.String() == "10.10.10.7/32" {
+			srFws++
+		}
+	}
+	if srFws != 48 {
+		t.Errorf("source range fw rules for 10.10.10.7 block 1 not added on their own(%d)\n", srFws)
+	}
+	_, err = mh.zr.Rules.DeleteNatLbRule(srServ1)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.7 block 1\n")
+	}
+	if srRule := mh.zr.Rules.GetNatLbRuleByServArgs(srServ); srRule != nil {
+		for _, fw := range srRule.srcFws {
+			rt, _ := fwRuleTuples(fw.Rule)
+			rt.owner = srRule.srcRangeFwOwner()
+			if mh.zr.Rules.tables[RtFw].eMap[rt.ruleKey()] == nil {
+				t.Errorf("source range fw rule %s for 10.10.10.7 deleted with block 1\n", fw.Rule.SrcIP)
+				break
+			}
+		}
+	}
+	if mh.dpUser != nil {
+		time.Sleep(1 * time.Second)
+		pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 7), 42001, 2020)
+		res, err := mh.dpUser.DpUserPktIn(12, pkt)
+		if err != nil || res.Act == UserDpActDrop || res.EpIdx < 0 {
+			t.Errorf("userspace dp dropped allowed source 20.20.20.1 for 10.10.10.7\n")
+		}
+		pkt = userDpTestTCPSyn(net.IPv4(21, 21, 21, 1), net.IPv4(10, 10, 10, 7), 42001, 2020)
+		res, err = mh.dpUser.DpUserPktIn(12, pkt)
+		if err != nil || res.Act != UserDpActDrop {
+			t.Errorf("userspace dp allowed source 21.21.21.1 for 10.10.10.7\n")
+		}
+		srFw := cmn.FwRuleArg{SrcIP: "20.20.20.1/32", DstIP: "10.10.10.7/32", DstPortMin: 2020, DstPortMax: 2020,
+			Proto: 6, Pref: 100}
+		_, err = mh.zr.Rules.AddFwRule(srFw, cmn.FwOptArg{Drop: true})
+		if err != nil {
+			t.Errorf("failed to add fw rule dropping 20.20.20.1 for 10.10.10.7\n")
+		}
+		time.Sleep(1 * time.Second)
+		pkt = userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 7), 42002, 2020)
+		res, err = mh.dpUser.DpUserPktIn(12, pkt)
+		if err != nil || res.Act != UserDpActDrop {
+			t.Errorf("userspace dp allowed source 20.20.20.1 for 10.10.10.7 despite fw drop rule\n")
+		}
+		mh.zr.Rules.DeleteFwRule(srFw)
+		lbRules, _ = mh.zr.Rules.GetNatLbRule()
+		for _, lbr := range lbRules {
+			if lbr.Serv.ServIP == "10.10.10.7" && strings.HasPrefix(lbr.Serv.SrcRangeDrops, "0:") {
+				t.Errorf("source range drops for 10.10.10.7 not counted\n")
+			}
+		}
+	}
+	_, err = mh.zr.Rules.DeleteNatLbRule(srServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.7\n")
+	}
+	fwRules, _ = mh.zr.Rules.GetFwRule()
+	for _, fw := range fwRules {
+		if fw.Rule.DstIP == "10.10.10.7/32" {
+			t.Errorf("source range fw rules for 10.10.10.7 not deleted\n")
+			break
+		}
+	}
+
+	prEps := []cmn.LbEndPointArg{{EpIP: "32.32.32.1", EpPort: 6000, Weight: 1}}
+	prServ := cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 3000, ServPortMax: 3099, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(prServ, nil, prEps)
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.8 with port range\n")
+	}
+	if nws := NatDpPortExpand(&NatDpWorkQ{L4Port: 0, L4PortMax: 0xffff}); nws != nil {
+		t.Errorf("nat port range expanded to %d entries\n", len(nws))
+	}
+	if mh.dpEbpf == nil {
+		mh.dpEbpf = new(DpEbpfH)
+		prServ1 := cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 5000, ServPortMax: 5000 + MaxLbPortRangeEbpf,
+			Proto: "tcp", Sel: cmn.LbSelRr}
+		_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+		if err == nil {
+			t.Errorf("added nat lb rule for 10.10.10.8 with %d ports with ebpf dp\n", MaxLbPortRangeEbpf+1)
+		}
+		mh.dpEbpf = nil
+	}
+	prServ1 := cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 3050, ServPortMax: 3200, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.8 with overlapping port range\n")
+	}
+	prServ1 = cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 3010, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.8 with port in existing range\n")
+	}
+	prServ1 = cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 0, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+	if err == nil {
+		t.Errorf("added any-port nat lb rule for 10.10.10.8 with existing port range\n")
+		mh.zr.Rules.DeleteNatLbRule(prServ1)
+	}
+	prServ1 = cmn.LbServiceArg{ServIP: "10.10.10.8", ServPort: 4096, ServPortMask: 0xff0f, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+	if err == nil {
+		t.Errorf("added nat lb rule for 10.10.10.8 with non-prefix port mask\n")
+	}
+	prServ1.ServPortMask = 0xff00
+	_, err = mh.zr.Rules.AddNatLbRule(prServ1, nil, prEps)
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.8 with port mask\n")
+	}
+	lbRules, _ = mh.zr.Rules.GetNatLbRule()
+	prRanges := 0
+	for _, lbr := range lbRules {
+		if lbr.Serv.ServIP != "10.10.10.8" {
+			continue
+		}
+		if (lbr.Serv.ServPort == 3000 && lbr.Serv.ServPortMax == 3099) ||
+			(lbr.Serv.ServPort == 4096 && lbr.Serv.ServPortMax == 4351) {
+			prRanges++
+		}
+	}
+	if prRanges != 2 {
+		t.Errorf("port ranges of nat lb rules for 10.10.10.8 not found\n")
+	}
+	apServ := cmn.LbServiceArg{ServIP: "10.10.10.9", ServPort: 0, Proto: "tcp", Sel: cmn.LbSelRr}
+	_, err = mh.zr.Rules.AddNatLbRule(apServ, nil, []cmn.LbEndPointArg{{EpIP: "32.32.32.1", Weight: 1}})
+	if err != nil {
+		t.Errorf("failed to add any-port nat lb rule for 10.10.10.9\n")
+	}
+	if mh.dpUser != nil {
+		time.Sleep(1 * time.Second)
+		pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 8), 43001, 3005)
+		res, err := mh.dpUser.DpUserPktIn(12, pkt)
+		if err != nil || res.EpIdx < 0 || binary.BigEndian.Uint16(res.Pkt[36:38]) != 6005 {
+			t.Errorf("userspace dp failed to dnat 10.10.10.8:3005 to 32.32.32.1:6005\n")
+		}
+		pkt = userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 9), 43001, 7777)
+		res, err = mh.dpUser.DpUserPktIn(12, pkt)
+		if err != nil || res.EpIdx < 0 || binary.BigEndian.Uint16(res.Pkt[36:38]) != 7777 {
+			t.Errorf("userspace dp failed to dnat 10.10.10.9:7777 to 32.32.32.1:7777\n")
+		}
+	}
+	prServ1.ServPortMask = 0
+	for _, serv := range []cmn.LbServiceArg{prServ, prServ1, apServ} {
+		_, err = mh.zr.Rules.DeleteNatLbRule(serv)
+		if err != nil {
+			t.Errorf("failed to delete nat lb rule for %s:%d\n", serv.ServIP, serv.ServPort)
+		}
+	}
+
+	clServ := cmn.LbServiceArg{ServIP: "10.10.10.10", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr, MaxConnsPerEp: 1}
+	if userDp := mh.dpUser; userDp != nil {
+		mh.dpUser = nil
+		rtServ := clServ
+		rtServ.NewConnRate = 1
+		_, err = mh.zr.Rules.AddNatLbRule(rtServ, nil, odEps[:2])
+		if err == nil {
+			t.Errorf("added nat lb rule for 10.10.10.10 with connection rate without userspace dp\n")
+		}
+		mh.dpUser = userDp
+	}
+	_, err = mh.zr.Rules.AddNatLbRule(clServ, nil, odEps[:2])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 10.10.10.10 with connection limits\n")
+	}
+
+	// Without userspace dp, end-points at their cap are skipped by the control plane
+	if clRule := mh.zr.Rules.GetNatLbRuleByServArgs(clServ); clRule != nil {
+		userDp := mh.dpUser
+		mh.dpUser = nil
+		if !clRule.connCapPending() {
+			t.Errorf("connection caps of 10.10.10.10 not pending without userspace dp\n")
+		}
+		clStats := map[string]*epCtStats{"32.32.32.1:5001": {total: 2, active: 1}}
+		clEps := clRule.act.action.(*ruleNatActs).endPoints
+		if !mh.zr.Rules.connCapSync(clRule, clStats) || !clEps[0].connCapped || clEps[1].connCapped {
+			t.Errorf("end-point 32.32.32.1 of 10.10.10.10 at its cap not skipped\n")
+		}
+		clRule.limits.maxConns = 1
+		if !mh.zr.Rules.connCapSync(clRule, clStats) || !clEps[1].connCapped {
+			t.Errorf("end-points of 10.10.10.10 at its cap not skipped\n")
+		}
+		clRule.limits.maxConns = 0
+		if !mh.zr.Rules.connCapSync(clRule, nil) || clEps[0].connCapped || clEps[1].connCapped {
+			t.Errorf("end-points of 10.10.10.10 below cap still skipped\n")
+		}
+		mh.dpUser = userDp
+	}
+	if mh.dpUser != nil {
+		time.Sleep(1 * time.Second)
+		for sport := 0; sport < 3; sport++ {
+			pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 10), uint16(44001+sport), 2020)
+			res, err := mh.dpUser.DpUserPktIn(12, pkt)
+			if err != nil || (sport < 2 && res.EpIdx < 0) || (sport == 2 && res.Act != UserDpActDrop) {
+				t.Errorf("userspace dp did not limit connections per end-point for 10.10.10.10\n")
+			}
+		}
+		clServ.MaxConnsPerEp = 0
+		clServ.NewConnRate = 1
+		_, err = mh.zr.Rules.AddNatLbRule(clServ, nil, odEps[:2])
+		if err != nil {
+			t.Errorf("failed to update nat lb rule for 10.10.10.10 with connection rate\n")
+		}
+		time.Sleep(1 * time.Second)
+		for sport := 3; sport < 5; sport++ {
+			pkt := userDpTestTCPSyn(net.IPv4(20, 20, 20, 1), net.IPv4(10, 10, 10, 10), uint16(44001+sport), 2020)
+			res, err := mh.dpUser.DpUserPktIn(12, pkt)
+			if err != nil || (sport == 3 && res.EpIdx < 0) || (sport == 4 && res.Act != UserDpActDrop) {
+				t.Errorf("userspace dp did not limit connection rate for 10.10.10.10\n")
+			}
+		}
+		lbRules, _ = mh.zr.Rules.GetNatLbRule()
+		for _, lbr := range lbRules {
+			if lbr.Serv.ServIP == "10.10.10.10" && (lbr.Serv.RejectedConns != 2 || lbr.Serv.NewConnRate != 1) {
+				t.Errorf("rejected connections of 10.10.10.10 not reported\n")
+			}
+		}
+	}
+	_, err = mh.zr.Rules.DeleteNatLbRule(clServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 10.10.10.10\n")
+	}
+
+	txnGen := mh.zr.Rules.ConfigGenBump()
+	txnFw := cmn.FwRuleMod{Rule: cmn.FwRuleArg{SrcIP: "30.30.30.0/24", DstIP: "0.0.0.0/0"}, Opts: cmn.FwOptArg{Allow: true}}
+	txn := cmn.ConfigTxnMod{Generation: txnGen,
+		LbRules: []cmn.LbRuleMod{
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.11", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:2]},
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.12", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:2]},
+		},
+		FwRules: []cmn.FwRuleMod{txnFw},
+	}
+	txnRes, _, err := mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Added) != 3 || txnRes.Generation <= txnGen {
+		t.Errorf("failed to commit config txn for 10.10.10.11/12 (%v:%s)\n", txnRes, err)
+	}
+	if _, ret, err := mh.zr.Rules.ConfigTxnCommit(&txn); err == nil || ret != RuleCfgGenErr {
+		t.Errorf("config txn committed with stale generation\n")
+	}
+	txn.Generation = txnRes.Generation
+	txnRes, _, err = mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Added)+len(txnRes.Updated)+len(txnRes.Deleted) != 0 {
+		t.Errorf("config txn without changes modified config (%v:%s)\n", txnRes, err)
+	}
+	txn = cmn.ConfigTxnMod{Generation: txnRes.Generation, FwRules: []cmn.FwRuleMod{txnFw}}
+	txn.FwRules[0].Opts.Schedule = "0  9 * * 1-5 8h"
+	txnRes, _, err = mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Updated) != 1 {
+		t.Errorf("config txn changing fw rule schedule not applied (%v:%s)\n", txnRes, err)
+	}
+	txn.Generation = txnRes.Generation
+	txn.FwRules[0].Opts.Schedule = "0 9 * * 1-5 8h"
+	txnRes, _, err = mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Updated) != 0 {
+		t.Errorf("config txn with same fw rule schedule modified config (%v:%s)\n", txnRes, err)
+	}
+	txn.Generation = txnRes.Generation
+	txn.FwRules[0].Opts.Expiry = time.Now().Add(time.Hour).Format(time.RFC3339)
+	txnRes, _, err = mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Updated) != 1 {
+		t.Errorf("config txn changing fw rule expiry not applied (%v:%s)\n", txnRes, err)
+	}
+
+	// The last rule fails while being applied and everything is rolled back
+	txnGen = mh.zr.Rules.ConfigGen()
+	txn = cmn.ConfigTxnMod{
+		LbRules: []cmn.LbRuleMod{
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.13", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:2]},
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.11", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:3]},
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.11", ServPort: 0, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:2]},
+		},
+	}
+	if _, _, err := mh.zr.Rules.ConfigTxnCommit(&txn); err == nil {
+		t.Errorf("config txn with overlapping any-port rule for 10.10.10.11 committed\n")
+	}
+	if mh.zr.Rules.GetNatLbRuleByServArgs(txn.LbRules[0].Serv) != nil {
+		t.Errorf("config txn rollback did not delete 10.10.10.13\n")
+	}
+	if r := mh.zr.Rules.GetNatLbRuleByServArgs(txn.LbRules[1].Serv); r == nil {
+		t.Errorf("config txn rollback deleted 10.10.10.11\n")
+	} else if lm, _ := r.natLbRuleMod(); len(lm.Eps) != 2 {
+		t.Errorf("config txn rollback did not restore end-points of 10.10.10.11\n")
+	}
+	if mh.zr.Rules.ConfigGen() != txnGen {
+		t.Errorf("config txn rollback changed generation\n")
+	}
+
+	txn.LbRules[2] = cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.12", ServPort: 2020, Proto: "tcp",
+		Sel: cmn.LbSelRr}, Eps: odEps[:2], SecIPs: []cmn.LbSecIPArg{{SecIP: "10.10.10.14"}}}
+	if _, ret, err := mh.zr.Rules.ConfigTxnCommit(&txn); err == nil || ret != RuleArgsErr ||
+		mh.zr.Rules.GetNatLbRuleByServArgs(txn.LbRules[0].Serv) != nil {
+		t.Errorf("config txn with bad secondary IP for 10.10.10.12 not rejected upfront\n")
+	}
+
+	txn.LbRules = txn.LbRules[:2]
+	txn.LbRules[1].Eps = []cmn.LbEndPointArg{{EpIP: "32.32.32.x", EpPort: 5001}}
+	if _, ret, err := mh.zr.Rules.ConfigTxnCommit(&txn); err == nil || ret != RuleUnknownEpErr ||
+		mh.zr.Rules.GetNatLbRuleByServArgs(txn.LbRules[0].Serv) != nil {
+		t.Errorf("config txn with malformed end-point not rejected upfront\n")
+	}
+
+	// Full set of lb rules without 10.10.10.12, with 10.10.10.11 changed and 10.10.10.13 new.
+	// The lb rule of a firewall snat rule is not a part of the set and should stay
+	snatFw := cmn.FwRuleMod{Rule: cmn.FwRuleArg{SrcIP: "31.31.31.0/24", DstIP: "0.0.0.0/0"},
+		Opts: cmn.FwOptArg{DoSnat: true, ToIP: "11.11.11.11"}}
+	if _, err := mh.zr.Rules.AddFwRule(snatFw.Rule, snatFw.Opts); err != nil {
+		t.Errorf("failed to add snat fw rule for 31.31.31.0/24 (%s)\n", err)
+	}
+	var fullSet []cmn.LbRuleMod
+	lbRules, _ = mh.zr.Rules.GetNatLbRule()
+	for _, lbr := range lbRules {
+		if lbr.Serv.Snat {
+			continue
+		}
+		switch lbr.Serv.ServIP {
+		case "10.10.10.12":
+		case "10.10.10.11":
+			lbr.Eps = odEps[:3]
+			fullSet = append(fullSet, lbr)
+		default:
+			fullSet = append(fullSet, lbr)
+		}
+	}
+	fullSet = append(fullSet, cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.13", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: odEps[:2]})
+	txnGen = mh.zr.Rules.ConfigGen()
+	txnRes, _, err = mh.zr.Rules.ReconcileNatLbRules(fullSet, true)
+	if err != nil || len(txnRes.Added) != 1 || len(txnRes.Updated) != 1 || len(txnRes.Deleted) != 1 ||
+		txnRes.Deleted[0] != "lb:10.10.10.12:2020/tcp" {
+		t.Errorf("wrong dry-run plan for lb rule full set (%v:%s)\n", txnRes, err)
+	}
+	if mh.zr.Rules.ConfigGen() != txnGen || mh.zr.Rules.GetNatLbRuleByServArgs(fullSet[len(fullSet)-1].Serv) != nil {
+		t.Errorf("dry-run of lb rule full set changed config\n")
+	}
+	unchanged := make(map[*ruleEnt]struct{})
+	for _, lbr := range fullSet {
+		if lbr.Serv.ServIP != "10.10.10.11" {
+			if r := mh.zr.Rules.GetNatLbRuleByServArgs(lbr.Serv); r != nil {
+				unchanged[r] = struct{}{}
+			}
+		}
+	}
+	txnRes, _, err = mh.zr.Rules.ReconcileNatLbRules(fullSet, false)
+	if err != nil || len(txnRes.Added) != 1 || len(txnRes.Updated) != 1 || len(txnRes.Deleted) != 1 ||
+		txnRes.Generation <= txnGen {
+		t.Errorf("failed to apply lb rule full set (%v:%s)\n", txnRes, err)
+	}
+	for _, lbr := range fullSet {
+		r := mh.zr.Rules.GetNatLbRuleByServArgs(lbr.Serv)
+		if r == nil {
+			t.Errorf("lb rule %s missing after applying full set\n", lbr.Serv.ServIP)
+		} else if _, found := unchanged[r]; !found && lbr.Serv.ServIP != "10.10.10.11" && lbr.Serv.ServIP != "10.10.10.13" {
+			t.Errorf("unchanged lb rule %s replaced by full set\n", lbr.Serv.ServIP)
+		}
+	}
+	if mh.zr.Rules.GetNatLbRuleByServArgs(cmn.LbServiceArg{ServIP: "10.10.10.12", ServPort: 2020, Proto: "tcp"}) != nil {
+		t.Errorf("lb rule 10.10.10.12 not deleted by full set\n")
+	}
+	if _, err := mh.zr.Rules.DeleteFwRule(snatFw.Rule); err != nil {
+		t.Errorf("snat fw rule for 31.31.31.0/24 not intact after full set (%s)\n", err)
+	}
+
+	// Running config is saved and restored
+	cfgPath := t.TempDir()
+	if err := CfgStoreInit(cfgPath).Save(); err != nil {
+		t.Errorf("failed to save running config (%s)\n", err)
+	}
+	cfgSt, err := cfgStateLoad(cfgPath)
+	if err != nil || cfgSt == nil || cfgSt.Version != cmn.ConfigStateVersion ||
+		cfgSt.Generation != mh.zr.Rules.ConfigGen() {
+		t.Errorf("failed to load saved config (%s)\n", err)
+	} else {
+		var restoreSt cmn.ConfigState
+		for _, lm := range cfgSt.LbRules {
+			if lm.Serv.Snat && lm.Serv.BlockNum&0x1000 != 0 {
+				t.Errorf("lb rule of snat fw rule saved\n")
+			}
+			if lm.Serv.ServIP == "10.10.10.13" {
+				restoreSt.LbRules = append(restoreSt.LbRules, lm)
+			}
+			if lm.Serv.ServIP == "10.10.10.11" && (len(lm.Eps) != 3 || lm.Eps[0].State != "") {
+				t.Errorf("lb rule 10.10.10.11 not saved properly (%v)\n", lm.Eps)
+			}
+		}
+		fwSaved := false
+		for _, fm := range cfgSt.FwRules {
+			if fm.Rule.SrcIP == txnFw.Rule.SrcIP {
+				fwSaved = true
+			}
+		}
+		if len(restoreSt.LbRules) != 1 || !fwSaved {
+			t.Errorf("running config not saved\n")
+		}
+
+		// Restore of what was lost
+		restoreSt.Generation = cfgSt.Generation + 10
+		if _, err := mh.zr.Rules.DeleteNatLbRule(restoreSt.LbRules[0].Serv); err != nil {
+			t.Errorf("failed to delete nat lb rule for 10.10.10.13\n")
+		}
+		cs := &CfgStoreH{boot: &restoreSt}
+		if _, err := cs.Restore(NetAPIInit(false)); err != nil ||
+			mh.zr.Rules.GetNatLbRuleByServArgs(restoreSt.LbRules[0].Serv) == nil ||
+			mh.zr.Rules.ConfigGen() < restoreSt.Generation {
+			t.Errorf("failed to restore saved config (%s)\n", err)
+		}
+	}
+
+	// Config saved by loxicmd is converted
+	legacyPath := t.TempDir()
+	legacyLb := `{"lbAttr":[{"serviceArguments":{"externalIP":"10.10.10.20","port":2020,"protocol":"tcp"},` +
+		`"endpoints":[{"endpointIP":"32.32.32.1","targetPort":5001,"weight":1,"state":"active","counters":"1:60"}]},` +
+		`{"serviceArguments":{"externalIP":"0.0.0.0","protocol":"none","block":4097,"snat":true},` +
+		`"endpoints":[{"endpointIP":"11.11.11.11"}]}]}`
+	os.WriteFile(legacyPath+"/lbconfig.txt", []byte(legacyLb), 0644)
+	cfgSt, err = cfgStateLoad(legacyPath)
+	if err != nil || cfgSt == nil || cfgSt.Version != cmn.ConfigStateVersion || len(cfgSt.LbRules) != 1 ||
+		cfgSt.LbRules[0].Eps[0].State != "" || cfgSt.LbRules[0].Eps[0].Counters != "" {
+		t.Errorf("failed to convert loxicmd config (%v:%s)\n", cfgSt, err)
+	}
+	os.WriteFile(legacyPath+"/"+CfgStoreFile, []byte(`{"version":1000}`), 0644)
+	if _, err := cfgStateLoad(legacyPath); err == nil {
+		t.Errorf("config of unknown version loaded\n")
+	}
+
+	txn = cmn.ConfigTxnMod{
+		LbRulesDel: []cmn.LbRuleMod{
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.11", ServPort: 2020, Proto: "tcp"}},
+			{Serv: cmn.LbServiceArg{ServIP: "10.10.10.13", ServPort: 2020, Proto: "tcp"}},
+		},
+		FwRulesDel: []cmn.FwRuleMod{txnFw},
+	}
+	txnRes, _, err = mh.zr.Rules.ConfigTxnCommit(&txn)
+	if err != nil || len(txnRes.Deleted) != 3 {
+		t.Errorf("failed to delete 10.10.10.11/13 with config txn (%v:%s)\n", txnRes, err)
+	}
+
+	epOpts := epHostOpts{inActTryThr: 1, actTryThr: 2, probeType: HostProbeExec, probeDuration: 10, probePort: 5001}
+	_, err = mh.zr.Rules.AddEPHost(true, "32.32.32.1", "execEP", epOpts)
+	if err == nil {
+		t.Errorf("added exec probe end-point 32.32.32.1 without command\n")
+	}
+
+	epOpts.probeCmd = "test \"$LOXILB_EP_IP:$LOXILB_EP_PORT\" = \"32.32.32.1:5001\""
+	_, err = mh.zr.Rules.AddEPHost(true, "32.32.32.1", "execEP", epOpts)
+	if err != nil {
+		t.Errorf("failed to add exec probe end-point 32.32.32.1\n")
+	} else {
+		epSaved := false
+		for _, em := range (&CfgStoreH{}).state().EndPoints {
+			if em.Name == "execEP" {
+				epSaved = true
+			} else if !mh.zr.Rules.epHostAPIAdded(em.Name) {
+				t.Errorf("end-point %s of lb rule saved\n", em.Name)
+			}
+		}
+		if !epSaved {
+			t.Errorf("exec probe end-point 32.32.32.1 not saved\n")
+		}
+		ep := mh.zr.Rules.epMap["execEP"]
+		// Keep ep-helpers off this end-point. Exec probes run in background
+		// of the ep-helper
+		mh.zr.Rules.epMx.Lock()
+		ep.hID = MaxEndPointCheckers
+		mh.zr.Rules.epMx.Unlock()
+		execCheckNow := func() {
+			mh.zr.Rules.epCheckNow(ep)
+			for ep.execOn.Load() {
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+		ep.inactive = true
+		execCheckNow()
+		if ep.inactive {
+			t.Errorf("exec probe end-point 32.32.32.1 not active\n")
+		}
+		evCh := make(chan cmn.EndPointEvent, 4)
+		if _, err := mh.zr.Rules.EpEventSub(evCh); err != nil {
+			t.Errorf("failed to subscribe to end-point events\n")
+		}
+		okCmd := ep.opts.probeCmd
+		ep.opts.probeCmd = "exit 1"
+		execCheckNow()
+		if !ep.inactive {
+			t.Errorf("exec probe end-point 32.32.32.1 not inactive\n")
+		}
+		select {
+		case ev := <-evCh:
+			if ev.Kind != cmn.EpEventState || ev.Name != "execEP" || ev.CurrState != "nok" {
+				t.Errorf("unexpected end-point event %v\n", ev)
+			}
+		default:
+			t.Errorf("no end-point event for 32.32.32.1 going inactive\n")
+		}
+		mh.zr.Rules.EpEventUnSub(evCh)
+		ep.opts.probeCmd = okCmd
+		execCheckNow()
+		if !ep.inactive {
+			t.Errorf("exec probe end-point 32.32.32.1 active before rise threshold\n")
+		}
+		execCheckNow()
+		if ep.inactive {
+			t.Errorf("exec probe end-point 32.32.32.1 not active after rise threshold\n")
+		}
+		ep.opts.probeCmd = "sleep 3"
+		ep.opts.probeTimeout = 1
+		begin := time.Now()
+		mh.zr.Rules.epCheckNow(ep)
+		if time.Since(begin) > 500*time.Millisecond {
+			t.Errorf("exec probe of end-point 32.32.32.1 blocked the ep-helper\n")
+		}
+		execCheckNow()
+		if !ep.inactive || time.Since(begin) > 2500*time.Millisecond {
+			t.Errorf("exec probe end-point 32.32.32.1 did not time out as per probe timeout\n")
+		}
+	}
+
+	_, err = mh.zr.Rules.DeleteEPHost(true, "execEP", "32.32.32.1", HostProbeExec, 5001)
+	if err != nil {
+		t.Errorf("failed to delete exec probe end-point 32.32.32.1\n")
+	}
+
 	// Session information
 	anTun := cmn.SessTun{TeID: 1, Addr: net.IP{172, 17, 1, 231}} // An TeID, gNBIP
 	cnTun := cmn.SessTun{TeID: 1, Addr: net.IP{172, 17, 1, 50}}  // Cn TeID, MyIP
@@ -402,6 +1393,614 @@ func TestLoxinet(t *testing.T) {
 		t.Errorf("Failed to del fw-3\n")
 	}
 
+	// Firewall rules using ip sets
+	_, err = mh.zr.IPSets.IPSetAdd(cmn.IPSetMod{Name: "blocklist",
+		CIDRs: []string{"45.45.45.0/24", "46.46.46.1/32", "46.46.46.1/32"}})
+	if err != nil {
+		t.Errorf("Failed to add ipset blocklist:%s\n", err)
+	}
+
+	_, err = mh.zr.IPSets.IPSetAdd(cmn.IPSetMod{Name: "badlist", CIDRs: []string{"45.45.45.0/33"}})
+	if err == nil {
+		t.Errorf("Allowed to add ipset with bad cidr\n")
+	}
+
+	fwSet := cmn.FwRuleArg{SrcIP: "blocklist", DstIP: "0.0.0.0/0", Pref: 300}
+	_, err = mh.zr.Rules.AddFwRule(fwSet, cmn.FwOptArg{Drop: true})
+	if err != nil {
+		t.Errorf("Failed to add fw rule with ipset blocklist:%s\n", err)
+	}
+
+	_, err = mh.zr.Rules.AddFwRule(cmn.FwRuleArg{SrcIP: "0.0.0.0/0", DstIP: "nolist", Pref: 300}, cmn.FwOptArg{Drop: true})
+	if err == nil {
+		t.Errorf("Allowed to add fw rule with unknown ipset\n")
+	}
+
+	_, err = mh.zr.IPSets.IPSetDelete("blocklist")
+	if err == nil {
+		t.Errorf("Allowed to delete ipset blocklist in use\n")
+	}
+
+	fwSetFound := false
+	fws, _ := mh.zr.Rules.GetFwRule()
+	for _, fw := range fws {
+		if fw.Rule.SrcIP == "blocklist" && fw.Rule.Pref == 300 {
+			fwSetFound = true
+		}
+	}
+	if !fwSetFound {
+		t.Errorf("fw rule with ipset blocklist not found\n")
+	}
+
+	setRt, _ := fwRuleTuples(fwSet)
+	fwSetRule := mh.zr.Rules.tables[RtFw].eMap[setRt.ruleKey()]
+	if fwSetRule == nil || !strings.Contains(fwSetRule.tuples.String(), "src-set-blocklist") {
+		t.Errorf("fw rule with ipset blocklist not added properly\n")
+	}
+
+	// eBPF dp gets an entry per ipv4 member (pair of members) of the sets
+	setNet := func(cidr string) net.IPNet {
+		_, ipn, _ := net.ParseCIDR(cidr)
+		return *ipn
+	}
+	fwSetW := &FwDpWorkQ{Work: DpCreate, DstIP: setNet("0.0.0.0/0"), SrcSet: "blocklist", Mark: 10,
+		SrcSetIPs: []net.IPNet{setNet("45.45.45.0/24"), setNet("46.46.46.1/32"), setNet("4545::/64")}}
+	if ents := fwSetEntries(fwSetW); len(ents) != 2 || ents["46.46.46.1/32|0.0.0.0/0"] == nil ||
+		ents["46.46.46.1/32|0.0.0.0/0"].SrcSet != "" || ents["46.46.46.1/32|0.0.0.0/0"].Mark != 10 {
+		t.Errorf("fw rule with ipset blocklist not expanded properly for ebpf dp\n")
+	}
+	fwSetW.DstSet = "blocklist"
+	fwSetW.DstSetIPs = fwSetW.SrcSetIPs
+	if ents := fwSetEntries(fwSetW); len(ents) != 4 || ents["45.45.45.0/24|46.46.46.1/32"] == nil {
+		t.Errorf("fw rule with ipsets not expanded properly for ebpf dp\n")
+	}
+
+	if mh.dpEbpf == nil {
+		var bigSet []string
+		for i := 0; i <= IPSetMaxFwsEbpf; i++ {
+			bigSet = append(bigSet, fmt.Sprintf("47.%d.%d.0/24", i/256, i%256))
+		}
+		mh.dpEbpf = new(DpEbpfH)
+		_, err = mh.zr.IPSets.IPSetUpdate(cmn.IPSetMod{Name: "blocklist", CIDRs: bigSet})
+		if err == nil {
+			t.Errorf("Allowed to update ipset blocklist beyond ebpf dp fw entries\n")
+		}
+		mh.dpEbpf = nil
+	}
+
+	_, err = mh.zr.IPSets.IPSetUpdate(cmn.IPSetMod{Name: "blocklist", CIDRs: []string{"47.47.47.0/24"}})
+	if err != nil {
+		t.Errorf("Failed to update ipset blocklist:%s\n", err)
+	}
+	if fwSetRule != nil && mh.zr.Rules.tables[RtFw].eMap[setRt.ruleKey()] != fwSetRule {
+		t.Errorf("fw rule with ipset blocklist replaced by set update\n")
+	}
+
+	if mh.dpUser != nil && fwSetRule != nil {
+		var fwW *FwDpWorkQ
+		for try := 0; try < 5; try++ {
+			time.Sleep(1 * time.Second)
+			fwW = mh.dpUser.DpUserFwGet(int(fwSetRule.ruleNum))
+			if fwW != nil && len(fwW.SrcSetIPs) == 1 {
+				break
+			}
+		}
+		if fwW == nil || len(fwW.SrcSetIPs) != 1 {
+			t.Errorf("ipset update not programmed in userspace dp\n")
+		} else {
+			pkt, _ := parseUserDpPkt(userDpTestTCPSyn(net.IPv4(47, 47, 47, 1), net.IPv4(10, 10, 10, 1), 40001, 2020))
+			if pkt == nil || !userDpFwMatch(fwW, mh.zr.ZoneNum, 0, pkt) {
+				t.Errorf("userspace dp fw rule not matching new member of ipset\n")
+			}
+			pkt, _ = parseUserDpPkt(userDpTestTCPSyn(net.IPv4(45, 45, 45, 1), net.IPv4(10, 10, 10, 1), 40001, 2020))
+			if pkt == nil || userDpFwMatch(fwW, mh.zr.ZoneNum, 0, pkt) {
+				t.Errorf("userspace dp fw rule matching old member of ipset\n")
+			}
+		}
+	}
+
+	_, err = mh.zr.Rules.DeleteFwRule(fwSet)
+	if err != nil {
+		t.Errorf("Failed to del fw rule with ipset blocklist\n")
+	}
+
+	_, err = mh.zr.IPSets.IPSetDelete("blocklist")
+	if err != nil {
+		t.Errorf("Failed to delete ipset blocklist:%s\n", err)
+	}
+
+	// Default policy of a zone
+	_, err = mh.zr.Rules.SetFwDefaultPolicy("reject")
+	if err == nil {
+		t.Errorf("Allowed to set bad fw default policy\n")
+	}
+
+	_, err = NetAPIInit(false).NetFwPolicySet(&cmn.FwPolicyMod{Zone: "nozone", Policy: cmn.FwPolicyDrop})
+	if err == nil {
+		t.Errorf("Allowed to set fw default policy of unknown zone\n")
+	}
+
+	_, err = NetAPIInit(false).NetFwPolicySet(&cmn.FwPolicyMod{Policy: cmn.FwPolicyDrop})
+	if err != nil || mh.zr.Rules.FwDefaultPolicy() != cmn.FwPolicyDrop {
+		t.Errorf("Failed to set fw default policy drop:%v\n", err)
+	}
+
+	fwPolicies, err := NetAPIInit(false).NetFwPolicyGet()
+	if err != nil || len(fwPolicies) != 1 || fwPolicies[0].Zone != RootZone ||
+		fwPolicies[0].Policy != cmn.FwPolicyDrop {
+		t.Errorf("fw default policy get wrong %v\n", fwPolicies)
+	}
+
+	if mh.dpUser != nil {
+		var fwW *FwDpWorkQ
+		for try := 0; try < 5; try++ {
+			time.Sleep(1 * time.Second)
+			fwW = mh.dpUser.DpUserFwGet(RtFwDefaultMark)
+			if fwW != nil {
+				break
+			}
+		}
+		if fwW == nil || fwW.FwType != DpFwDrop {
+			t.Errorf("fw default policy drop not programmed in userspace dp\n")
+		}
+	}
+
+	_, err = mh.zr.Rules.SetFwDefaultPolicy(cmn.FwPolicyAccept)
+	if err != nil || mh.zr.Rules.FwDefaultPolicy() != cmn.FwPolicyAccept {
+		t.Errorf("Failed to set fw default policy accept:%s\n", err)
+	}
+
+	if mh.dpUser != nil {
+		fwW := mh.dpUser.DpUserFwGet(RtFwDefaultMark)
+		for try := 0; try < 5 && fwW != nil; try++ {
+			time.Sleep(1 * time.Second)
+			fwW = mh.dpUser.DpUserFwGet(RtFwDefaultMark)
+		}
+		if fwW != nil {
+			t.Errorf("fw default policy drop not removed from userspace dp\n")
+		}
+	}
+
+	// Timed and scheduled fw rules
+	fwEvCh := make(chan cmn.FwRuleEvent, 8)
+	_, err = mh.zr.Rules.FwEventSub(fwEvCh)
+	if err != nil {
+		t.Errorf("Failed to subscribe fw events:%s\n", err)
+	}
+	fwEvWait := func(kind string) bool {
+		select {
+		case ev := <-fwEvCh:
+			return ev.Kind == kind
+		case <-time.After(time.Second):
+			return false
+		}
+	}
+
+	fwBadOpts := []cmn.FwOptArg{
+		{Drop: true, TTL: 60, Expiry: time.Now().Add(time.Hour).Format(time.RFC3339)},
+		{Drop: true, Expiry: "tomorrow"},
+		{Drop: true, Expiry: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+		{Drop: true, Schedule: "0 9 * * 1-5"},
+		{Drop: true, Schedule: "0 25 * * * 1h"},
+		{Drop: true, Schedule: "0 9 * * * 30s"},
+	}
+	for _, opts := range fwBadOpts {
+		if _, err := mh.zr.Rules.AddFwRule(cmn.FwRuleArg{SrcIP: "48.48.48.0/24", DstIP: "0.0.0.0/0"}, opts); err == nil {
+			t.Errorf("Allowed to add fw rule with bad opts %v\n", opts)
+			mh.zr.Rules.DeleteFwRule(cmn.FwRuleArg{SrcIP: "48.48.48.0/24", DstIP: "0.0.0.0/0"})
+		}
+	}
+
+	fwTTL := cmn.FwRuleArg{SrcIP: "48.48.48.0/24", DstIP: "0.0.0.0/0", Pref: 600}
+	_, err = mh.zr.Rules.AddFwRule(fwTTL, cmn.FwOptArg{Drop: true, TTL: 3600})
+	if err != nil {
+		t.Errorf("Failed to add fw rule with ttl:%s\n", err)
+	}
+
+	fwSched := cmn.FwRuleArg{SrcIP: "49.49.49.0/24", DstIP: "0.0.0.0/0", Pref: 600}
+	_, err = mh.zr.Rules.AddFwRule(fwSched, cmn.FwOptArg{Drop: true, Schedule: "0 9 * * 1-5 8h"})
+	if err != nil {
+		t.Errorf("Failed to add fw rule with schedule:%s\n", err)
+	}
+
+	fwRules, _ = mh.zr.Rules.GetFwRule()
+	for _, fm := range fwRules {
+		if fm.Rule.SrcIP == "48.48.48.0/24" && (fm.Opts.Expiry == "" || fm.Opts.Remaining == 0 ||
+			fm.Opts.Remaining > 3600) {
+			t.Errorf("fw rule with ttl not shown properly:%v\n", fm.Opts)
+		}
+		if fm.Rule.SrcIP == "49.49.49.0/24" && fm.Opts.Schedule != "0 9 * * 1-5 8h" {
+			t.Errorf("fw rule with schedule not shown properly:%v\n", fm.Opts)
+		}
+	}
+
+	// 1st Jan 2024 is a monday
+	mon := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local)
+	fwSchedTests := []struct {
+		spec   string
+		at     time.Duration
+		active bool
+	}{
+		{"30 23 * * 0 25h", 10 * time.Minute, true},
+		{"30 23 * * 0 25h", 24*time.Hour + 29*time.Minute, true},
+		{"30 23 * * 0 25h", 24*time.Hour + 30*time.Minute, false},
+		{"*/15 9-17 * * * 10m", 10*time.Hour + 20*time.Minute, true},
+		{"*/15 9-17 * * * 10m", 10*time.Hour + 29*time.Minute, false},
+		{"45 9 * * * 30m", 10*time.Hour + 10*time.Minute, true},
+		{"0 0 1 * * 168h", 7*24*time.Hour - time.Minute, true},
+		{"0 0 1 * * 168h", 7 * 24 * time.Hour, false},
+		{"0 0 31 12 * 48h", 10 * time.Hour, true},
+		{"0 9 * * 1-5 8h", 5*24*time.Hour + 10*time.Hour, false},
+	}
+	for _, st := range fwSchedTests {
+		sched, err := fwScheduleParse(st.spec)
+		if err != nil || sched.active(mon.Add(st.at)) != st.active {
+			t.Errorf("fw schedule %s active %v wrong at %s\n", st.spec, !st.active, mon.Add(st.at))
+		}
+	}
+
+	schedRt, _ := fwRuleTuples(fwSched)
+	fwSchedRule := mh.zr.Rules.tables[RtFw].eMap[schedRt.ruleKey()]
+	if fwSchedRule != nil {
+		// Keep the zone ticker from syncing the rule to the real time meanwhile
+		mh.mtx.Lock()
+		mh.zr.Rules.FwTimersSync(mon.Add(20 * time.Hour))
+		if !fwSchedRule.fwSchedOff() {
+			t.Errorf("fw rule with schedule active out of window\n")
+		}
+		for len(fwEvCh) > 0 {
+			<-fwEvCh
+		}
+
+		mh.zr.Rules.FwTimersSync(mon.Add(10 * time.Hour))
+		if fwSchedRule.fwSchedOff() || !fwEvWait(cmn.FwEventActive) {
+			t.Errorf("fw rule with schedule not active in window\n")
+		}
+		if mh.dpUser != nil {
+			var fwW *FwDpWorkQ
+			for try := 0; try < 5 && fwW == nil; try++ {
+				time.Sleep(1 * time.Second)
+				fwW = mh.dpUser.DpUserFwGet(int(fwSchedRule.ruleNum))
+			}
+			if fwW == nil {
+				t.Errorf("fw rule with schedule not programmed in userspace dp\n")
+			}
+		}
+
+		mh.zr.Rules.FwTimersSync(mon.Add(5*24*time.Hour + 10*time.Hour))
+		if !fwSchedRule.fwSchedOff() || !fwEvWait(cmn.FwEventInactive) {
+			t.Errorf("fw rule with schedule active on saturday\n")
+		}
+		if mh.dpUser != nil {
+			fwW := mh.dpUser.DpUserFwGet(int(fwSchedRule.ruleNum))
+			for try := 0; try < 5 && fwW != nil; try++ {
+				time.Sleep(1 * time.Second)
+				fwW = mh.dpUser.DpUserFwGet(int(fwSchedRule.ruleNum))
+			}
+			if fwW != nil {
+				t.Errorf("fw rule with schedule not removed from userspace dp\n")
+			}
+		}
+		mh.mtx.Unlock()
+	} else {
+		t.Errorf("fw rule with schedule not found\n")
+	}
+
+	// Expired rules are neither saved nor restored
+	ttlRt, _ := fwRuleTuples(fwTTL)
+	if fwTTLRule := mh.zr.Rules.tables[RtFw].eMap[ttlRt.ruleKey()]; fwTTLRule != nil {
+		fwTTLRule.act.action.(*ruleFwOpts).opt.expiry = time.Now().Add(-time.Second)
+		for _, fm := range (&CfgStoreH{}).state().FwRules {
+			if fm.Rule.SrcIP == fwTTL.SrcIP {
+				t.Errorf("expired fw rule saved\n")
+			}
+		}
+	}
+	fwExpSt := &cmn.ConfigState{FwRules: []cmn.FwRuleMod{{Rule: cmn.FwRuleArg{SrcIP: "47.47.47.0/24", DstIP: "0.0.0.0/0"},
+		Opts: cmn.FwOptArg{Drop: true, Expiry: time.Now().Add(-time.Hour).Format(time.RFC3339)}}}}
+	if _, err := (&CfgStoreH{boot: fwExpSt}).Restore(NetAPIInit(false)); err != nil {
+		t.Errorf("failed to restore expired fw rule quietly (%s)\n", err)
+	}
+	if expRt, _ := fwRuleTuples(fwExpSt.FwRules[0].Rule); mh.zr.Rules.tables[RtFw].eMap[expRt.ruleKey()] != nil {
+		t.Errorf("expired fw rule restored\n")
+	}
+
+	mh.zr.Rules.FwTimersSync(time.Now().Add(2 * time.Hour))
+	if mh.zr.Rules.tables[RtFw].eMap[ttlRt.ruleKey()] != nil || !fwEvWait(cmn.FwEventExpired) {
+		t.Errorf("fw rule with ttl not expired\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteFwRule(fwSched)
+	if err != nil {
+		t.Errorf("Failed to del fw rule with schedule\n")
+	}
+	mh.zr.Rules.FwEventUnSub(fwEvCh)
+
+	// Logging of hits on fw rules marked record
+	fwLogPath := t.TempDir()
+	fwLogBad := []cmn.FwLogConfig{
+		{File: "fw.log"},
+		{File: fwLogPath + "/fw.log", FileMaxBackups: FwLogMaxBackups + 1},
+		{Syslog: "http://127.0.0.1:514"},
+		{Syslog: "udp://"},
+	}
+	for _, fc := range fwLogBad {
+		if _, err := mh.fwLog.ConfigSet(fc); err == nil {
+			t.Errorf("Allowed to set bad fw log config %v\n", fc)
+		}
+	}
+
+	_, err = mh.fwLog.ConfigSet(cmn.FwLogConfig{File: fwLogPath + "/fw.log", RateLimit: 2})
+	if err != nil {
+		t.Errorf("Failed to set fw log config:%s\n", err)
+	}
+	fwLogCh := make(chan cmn.FwLogRecord, 8)
+	mh.fwLog.Sub(fwLogCh)
+
+	fwRec := cmn.FwRuleArg{SrcIP: "50.50.50.0/24", DstIP: "0.0.0.0/0", Pref: 600}
+	_, err = mh.zr.Rules.AddFwRule(fwRec, cmn.FwOptArg{Drop: true, Record: true})
+	if err != nil {
+		t.Errorf("Failed to add fw rule with record:%s\n", err)
+	}
+	recRt, _ := fwRuleTuples(fwRec)
+	fwRecRule := mh.zr.Rules.tables[RtFw].eMap[recRt.ruleKey()]
+	if mh.dpUser != nil && fwRecRule != nil {
+		for try := 0; try < 5 && mh.dpUser.DpUserFwGet(int(fwRecRule.ruleNum)) == nil; try++ {
+			time.Sleep(1 * time.Second)
+		}
+		for sport := uint16(40001); sport <= 40004; sport++ {
+			mh.dpUser.DpUserPktIn(12, userDpTestTCPSyn(net.IPv4(50, 50, 50, 1), net.IPv4(10, 10, 10, 1), sport, 2020))
+		}
+		select {
+		case rec := <-fwLogCh:
+			if uint64(rec.RuleID) != fwRecRule.ruleNum || rec.Pref != 600 || rec.Action != "drop" ||
+				rec.SrcIP != "50.50.50.1" || rec.DstPort != 2020 || rec.Proto != "tcp" || rec.Port != "hs0" {
+				t.Errorf("fw log record not proper:%v\n", rec)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("fw log record not received\n")
+		}
+		time.Sleep(500 * time.Millisecond)
+		if fc := mh.fwLog.ConfigGet(); fc.Logged+fc.Dropped != 4 || fc.Logged > 2 {
+			t.Errorf("fw log rate limit not applied:%v\n", fc)
+		}
+		buf, err := os.ReadFile(fwLogPath + "/fw.log")
+		if err != nil || !strings.Contains(string(buf), "\"sourceIP\":\"50.50.50.1\"") {
+			t.Errorf("fw log file not written:%s\n", err)
+		}
+	}
+
+	// Sampling of hits
+	if mh.dpUser != nil && fwRecRule != nil {
+		_, err = mh.fwLog.ConfigSet(cmn.FwLogConfig{File: fwLogPath + "/fw.log", Sample: 2})
+		if err != nil {
+			t.Errorf("Failed to set fw log config:%s\n", err)
+		}
+		for len(fwLogCh) > 0 {
+			<-fwLogCh
+		}
+		for sport := uint16(40011); sport <= 40012; sport++ {
+			mh.dpUser.DpUserPktIn(12, userDpTestTCPSyn(net.IPv4(50, 50, 50, 2), net.IPv4(10, 10, 10, 1), sport, 2020))
+		}
+		select {
+		case rec := <-fwLogCh:
+			if uint64(rec.RuleID) != fwRecRule.ruleNum || rec.Rule != fwRecRule.tuples.String() || rec.Pref != 600 ||
+				rec.Action != "drop" || rec.SrcIP != "50.50.50.2" || rec.SrcPort != 40012 || rec.Proto != "tcp" {
+				t.Errorf("fw log record of sampled hit not proper:%v\n", rec)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("fw log record of sampled hit not received\n")
+		}
+		if fc := mh.fwLog.ConfigGet(); fc.Sampled != 1 {
+			t.Errorf("fw log sampled hits not counted:%v\n", fc)
+		}
+	}
+
+	// Packets recorded by eBPF dp carry no mark and are matched to the rule
+	if fwRecRule != nil {
+		pkt, _ := parseUserDpPkt(userDpTestTCPSyn(net.IPv4(50, 50, 50, 3), net.IPv4(10, 10, 10, 1), 40021, 2020))
+		if mark, lr, found := mh.fwLog.ruleMatch(pkt); !found || mark != int(fwRecRule.ruleNum) || lr.pref != 600 {
+			t.Errorf("recorded packet not matched to fw rule with record\n")
+		}
+		pkt, _ = parseUserDpPkt(userDpTestTCPSyn(net.IPv4(51, 51, 51, 3), net.IPv4(10, 10, 10, 1), 40021, 2020))
+		if _, _, found := mh.fwLog.ruleMatch(pkt); found {
+			t.Errorf("recorded packet matched to other fw rule with record\n")
+		}
+	}
+	mh.fwLog.UnSub(fwLogCh)
+
+	_, err = mh.zr.Rules.DeleteFwRule(fwRec)
+	if err != nil {
+		t.Errorf("Failed to del fw rule with record\n")
+	}
+	if fwRecRule != nil {
+		if _, found := mh.fwLog.ruleGet(int(fwRecRule.ruleNum)); found {
+			t.Errorf("fw log rule index not updated on delete\n")
+		}
+	}
+	if _, err := mh.fwLog.ConfigSet(cmn.FwLogConfig{}); err != nil || mh.fwLog.configured() {
+		t.Errorf("Failed to clear fw log config:%s\n", err)
+	}
+
+	lf, err := fwLogFileOpen(fwLogPath+"/rot.log", 1, 2)
+	if err != nil {
+		t.Errorf("Failed to open fw log file:%s\n", err)
+	} else {
+		lf.maxSize = 64
+		for i := 0; i < 8; i++ {
+			lf.write([]byte(strings.Repeat("x", 40) + "\n"))
+		}
+		lf.close()
+		for i, name := range []string{"rot.log", "rot.log.1", "rot.log.2"} {
+			if _, err := os.Stat(fwLogPath + "/" + name); err != nil {
+				t.Errorf("fw log file %d not rotated:%s\n", i, err)
+			}
+		}
+		if _, err := os.Stat(fwLogPath + "/rot.log.3"); err == nil {
+			t.Errorf("fw log file rotated beyond max backups\n")
+		}
+	}
+
+	// IP pools and VIP allocation
+	ipPool := cmn.IPPoolMod{Name: "pool1", CIDRs: []string{"123.123.123.0/30", "3ffe:cafe::/64"},
+		Reserved: []string{"123.123.123.1"}}
+	_, err = mh.zr.Ipam.IPPoolAdd(ipPool)
+	if err != nil {
+		t.Errorf("Failed to add ippool pool1:%s\n", err)
+	}
+
+	_, err = mh.zr.Ipam.IPPoolAdd(cmn.IPPoolMod{Name: "pool2", CIDRs: []string{"123.123.123.0/24"}})
+	if err == nil {
+		t.Errorf("Allowed to add overlapping ippool pool2\n")
+	}
+
+	_, err = mh.zr.Ipam.IPPoolAdd(cmn.IPPoolMod{Name: "pool2", CIDRs: []string{"3ffe:beef::/64", "3ffe:feed::/64"}})
+	if err == nil {
+		t.Errorf("Allowed to add ippool pool2 with two IPv6 subnets\n")
+	}
+
+	vip, _, err := mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc1", Family: cmn.VIPFamilyDual})
+	if err != nil || len(vip.IPs) != 2 || vip.IPs[0] != "123.123.123.2" || vip.IPs[1] != "3ffe:cafe::1" {
+		t.Errorf("Failed to alloc dual-stack vip for svc1:%v:%s\n", vip.IPs, err)
+	}
+
+	vip, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc1", Family: cmn.VIPFamilyIPv4})
+	if err != nil || len(vip.IPs) != 2 || vip.IPs[0] != "123.123.123.2" {
+		t.Errorf("Realloc for svc1 did not return held vips:%v:%s\n", vip.IPs, err)
+	}
+
+	_, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc2", Family: cmn.VIPFamilyIPv4})
+	if err == nil {
+		t.Errorf("Allocated IPv4 vip from exhausted pool1\n")
+	}
+
+	vip, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc2", IPs: []string{"123.123.123.1"}})
+	if err != nil || len(vip.IPs) != 1 || vip.IPs[0] != "123.123.123.1" {
+		t.Errorf("Failed to alloc reserved vip for svc2:%v:%s\n", vip.IPs, err)
+	}
+
+	_, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc3", IPs: []string{"123.123.123.2"}})
+	if err == nil {
+		t.Errorf("Allocated vip of svc1 to svc3\n")
+	}
+
+	vip, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool1", Service: "svc3", Family: cmn.VIPFamilyIPv6})
+	if err != nil || len(vip.IPs) != 1 || vip.IPs[0] != "3ffe:cafe::2" {
+		t.Errorf("Failed to alloc IPv6 vip for svc3:%v:%s\n", vip.IPs, err)
+	}
+
+	_, err = mh.zr.Ipam.IPPoolDelete("pool1")
+	if err == nil {
+		t.Errorf("Allowed to delete ippool pool1 in use\n")
+	}
+
+	for _, svc := range []string{"svc1", "svc2", "svc3"} {
+		_, err = mh.zr.Ipam.VIPRelease(cmn.VIPAllocMod{Pool: "pool1", Service: svc})
+		if err != nil {
+			t.Errorf("Failed to release vips of %s:%s\n", svc, err)
+		}
+	}
+
+	_, err = mh.zr.Ipam.VIPRelease(cmn.VIPAllocMod{Pool: "pool1", Service: "svc1"})
+	if err == nil {
+		t.Errorf("Allowed to release vips of svc1 twice\n")
+	}
+
+	_, err = mh.zr.Ipam.IPPoolDelete("pool1")
+	if err != nil {
+		t.Errorf("Failed to delete ippool pool1:%s\n", err)
+	}
+
+	// VIPs of existing lb rules are taken by a new pool
+	ipamServ := cmn.LbServiceArg{ServIP: "123.123.124.1", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr, Name: "ipamlb"}
+	_, err = mh.zr.Rules.AddNatLbRule(ipamServ, nil, lbEps[:1])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule for 123.123.124.1\n")
+	}
+
+	_, err = mh.zr.Ipam.IPPoolAdd(cmn.IPPoolMod{Name: "pool3", CIDRs: []string{"123.123.124.0/29"}})
+	if err != nil {
+		t.Errorf("Failed to add ippool pool3:%s\n", err)
+	}
+	if owner, _ := mh.zr.Ipam.PoolMap["pool3"].Pool.GetOwner("123.123.124.1"); owner != "ipamlb" {
+		t.Errorf("vip of lb rule not taken by pool3 (%s)\n", owner)
+	}
+
+	vip, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool3", Service: "svc4"})
+	if err != nil || len(vip.IPs) != 1 || vip.IPs[0] != "123.123.124.2" {
+		t.Errorf("Failed to alloc vip for svc4:%v:%s\n", vip.IPs, err)
+	}
+
+	// Allocations are saved and restored
+	ipamPath := t.TempDir()
+	if err := CfgStoreInit(ipamPath).Save(); err != nil {
+		t.Errorf("failed to save running config (%s)\n", err)
+	}
+	cfgSt, err = cfgStateLoad(ipamPath)
+	if err != nil || cfgSt == nil || len(cfgSt.IPPools) != 1 || len(cfgSt.IPPools[0].Allocs) != 2 {
+		t.Errorf("ippool pool3 not saved (%v:%s)\n", cfgSt, err)
+	} else {
+		mh.zr.Ipam.PoolMap["pool3"].Pool.RetrieveOwner("ipamlb")
+		mh.zr.Ipam.PoolMap["pool3"].Pool.RetrieveOwner("svc4")
+		if _, err := mh.zr.Ipam.IPPoolDelete("pool3"); err != nil {
+			t.Errorf("Failed to delete ippool pool3:%s\n", err)
+		}
+		cs := &CfgStoreH{boot: &cmn.ConfigState{IPPools: cfgSt.IPPools}}
+		if _, err := cs.Restore(NetAPIInit(false)); err != nil {
+			t.Errorf("failed to restore ippool pool3 (%s)\n", err)
+		}
+		pool3 := mh.zr.Ipam.PoolMap["pool3"]
+		if pool3 == nil {
+			t.Errorf("ippool pool3 not restored\n")
+		} else if owner, _ := pool3.Pool.GetOwner("123.123.124.2"); owner != "svc4" {
+			t.Errorf("vip of svc4 not restored (%s)\n", owner)
+		}
+	}
+
+	// Allocations of cluster peers are synced
+	xsyncAlloc := func(op, svc, ip string) int {
+		buf, _ := json.Marshal(cmn.VIPAllocMod{Pool: "pool3", Service: svc, IPs: []string{ip}})
+		return mh.zr.Ipam.XSyncRecv(&DpCtInfo{Proto: IpamXSyncProto, CState: op, PKey: buf})
+	}
+	if xsyncAlloc(ipamSyncAlloc, "svc5", "123.123.124.3") != 0 {
+		t.Errorf("failed to sync vip of svc5\n")
+	}
+	vip, _, err = mh.zr.Ipam.VIPAlloc(cmn.VIPAllocMod{Pool: "pool3", Service: "svc6"})
+	if err != nil || len(vip.IPs) != 1 || vip.IPs[0] != "123.123.124.4" {
+		t.Errorf("vip synced from peer allocated again:%v:%s\n", vip.IPs, err)
+	}
+	xsyncAlloc(ipamSyncAlloc, "svc0", "123.123.124.2")
+	if owner, _ := mh.zr.Ipam.PoolMap["pool3"].Pool.GetOwner("123.123.124.2"); owner != "svc0" {
+		t.Errorf("conflicting vip of svc4 not moved to svc0 (%s)\n", owner)
+	}
+	xsyncAlloc(ipamSyncAlloc, "svc9", "123.123.124.2")
+	if owner, _ := mh.zr.Ipam.PoolMap["pool3"].Pool.GetOwner("123.123.124.2"); owner != "svc0" {
+		t.Errorf("conflicting vip of svc0 moved to svc9 (%s)\n", owner)
+	}
+	for svc, ip := range map[string]string{"svc0": "123.123.124.2", "svc5": "123.123.124.3"} {
+		if xsyncAlloc(ipamSyncRelease, svc, ip) != 0 {
+			t.Errorf("failed to sync release of %s\n", svc)
+		}
+		if _, owned := mh.zr.Ipam.PoolMap["pool3"].Pool.GetOwner(ip); owned {
+			t.Errorf("vip %s of %s not released by sync\n", ip, svc)
+		}
+	}
+
+	for _, svc := range []string{"ipamlb", "svc6"} {
+		_, err = mh.zr.Ipam.VIPRelease(cmn.VIPAllocMod{Pool: "pool3", Service: svc})
+		if err != nil {
+			t.Errorf("Failed to release vips of %s:%s\n", svc, err)
+		}
+	}
+	_, err = mh.zr.Ipam.IPPoolDelete("pool3")
+	if err != nil {
+		t.Errorf("Failed to delete ippool pool3:%s\n", err)
+	}
+	_, err = mh.zr.Rules.DeleteNatLbRule(ipamServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule for 123.123.124.1\n")
+	}
+
 	fmt.Printf("#### Route-List ####\n")
 	mh.zr.Rt.Rts2String(&mh)
 
@@ -412,3 +2011,22 @@ func TestLoxinet(t *testing.T) {
 	mh.zr.Rt.Trie4.Trie2String(mh.zr.Rt)
 
 }
+
+// userDpTestTCPSyn - build a tcp syn packet to be fed to userspace dp
+func userDpTestTCPSyn(sip, dip net.IP, sport, dport uint16) []byte {
+	pkt := make([]byte, 54)
+	copy(pkt[0:6], []byte{0x1, 0x2, 0x3, 0x4, 0x5, 0x6})
+	copy(pkt[6:12], []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x2})
+	binary.BigEndian.PutUint16(pkt[12:], 0x0800)
+	pkt[14] = 0x45
+	binary.BigEndian.PutUint16(pkt[16:], 40)
+	pkt[22] = 64
+	pkt[23] = 6
+	copy(pkt[26:30], sip.To4())
+	copy(pkt[30:34], dip.To4())
+	binary.BigEndian.PutUint16(pkt[34:], sport)
+	binary.BigEndian.PutUint16(pkt[36:], dport)
+	pkt[46] = 0x50
+	pkt[47] = 0x02
+	return pkt
+}
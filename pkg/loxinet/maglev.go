@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// constants
+const (
+	// MaglevTblSize - size of maglev lookup table. It needs to be a prime
+	// and much larger than the number of end-points of a rule
+	MaglevTblSize = 16381
+	// MaglevNoEP - lookup table slot without any end-point
+	MaglevNoEP = 0xffff
+)
+
+type maglevEP struct {
+	idx    int
+	name   string
+	weight int
+	offset uint64
+	skip   uint64
+	next   uint64
+}
+
+// maglevHash - hash used for calculating maglev permutations
+func maglevHash(name string, seed byte) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{seed})
+	h.Write([]byte(name))
+	return h.Sum64()
+}
+
+// MaglevTable - Build a maglev consistent hash lookup table for the given
+// nat end-points. Each slot of the table holds the index of an end-point in eps.
+// Inactive end-points are left out and end-points with higher weights get
+// proportionally more slots. Since the table only depends on the end-point
+// addresses and weights, all nodes build the same table for the same set
+func MaglevTable(eps []NatEP) []uint16 {
+	var mEps []*maglevEP
+
+	for i, ep := range eps {
+		if ep.InActive {
+			continue
+		}
+		name := fmt.Sprintf("%s:%d", ep.XIP.String(), ep.XPort)
+		mEp := &maglevEP{idx: i, name: name, weight: int(ep.Weight)}
+		mEp.offset = maglevHash(name, 0) % MaglevTblSize
+		mEp.skip = maglevHash(name, 1)%(MaglevTblSize-1) + 1
+		mEps = append(mEps, mEp)
+	}
+
+	if len(mEps) == 0 {
+		return nil
+	}
+
+	// Same end-point set needs to result in the same table irrespective
+	// of the order in which end-points were configured
+	sort.SliceStable(mEps, func(i, j int) bool {
+		return mEps[i].name < mEps[j].name
+	})
+
+	// Zero weights mean all end-points have equal share
+	allZero := true
+	for _, mEp := range mEps {
+		if mEp.weight != 0 {
+			allZero = false
+			break
+		}
+	}
+	if allZero {
+		for _, mEp := range mEps {
+			mEp.weight = 1
+		}
+	}
+
+	tbl := make([]uint16, MaglevTblSize)
+	for i := range tbl {
+		tbl[i] = MaglevNoEP
+	}
+
+	filled := 0
+	for filled < MaglevTblSize {
+		for _, mEp := range mEps {
+			for w := 0; w < mEp.weight && filled < MaglevTblSize; w++ {
+				slot := (mEp.offset + mEp.next*mEp.skip) % MaglevTblSize
+				for tbl[slot] != MaglevNoEP {
+					mEp.next++
+					slot = (mEp.offset + mEp.next*mEp.skip) % MaglevTblSize
+				}
+				tbl[slot] = uint16(mEp.idx)
+				mEp.next++
+				filled++
+			}
+		}
+	}
+
+	return tbl
+}
+
+// MaglevSlotEPs - Fill n end-point slots as per a maglev lookup table for dps
+// which select an end-point by a flow hash over a fixed number of slots (eBPF
+// dp). Slots are sampled evenly off the table, so a change in the end-point
+// set mostly moves only the slots of end-points which changed. Shares of the
+// end-points are as close to their weights as n slots allow
+func MaglevSlotEPs(eps []NatEP, tbl []uint16, n int) []NatEP {
+	slots := make([]NatEP, 0, n)
+	for s := 0; s < n; s++ {
+		slots = append(slots, eps[tbl[s*len(tbl)/n]])
+	}
+	return slots
+}
@@ -23,13 +23,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"math/rand"
 	"net"
+	"net/http"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/loxilb-io/loxilb/api/loxinlp"
@@ -51,6 +56,7 @@ const (
 	RuleTupleErr
 	RuleArgsErr
 	RuleEpNotExistErr
+	RuleCfgGenErr
 )
 
 type ruleTMatch uint
@@ -85,14 +91,46 @@ const (
 	DflHostProbeTimeout        = 60        // Default probe timeout for end-point host
 	InitHostProbeTimeout       = 15        // Initial probe timeout for end-point host
 	MaxHostProbeTime           = 24 * 3600 // Max possible host health check duration
+	ExecHostProbeTimeout       = 5         // Default timeout for exec type probe of end-point host
+	DflHostProbeWait           = 2         // Default timeout of each probe of end-point host
+	MaxHostProbeWait           = 30        // Max timeout of each probe of end-point host
 	LbDefaultInactiveTimeout   = 4 * 60    // Default inactive timeout for established sessions
 	LbDefaultInactiveNSTimeout = 20        // Default inactive timeout for non-session oriented protocols
 	LbMaxInactiveTimeout       = 24 * 3600 // Maximum inactive timeout for established sessions
+	LbMaxDrainTimeout          = 24 * 3600 // Maximum drain timeout of lb end-points
 	MaxEndPointCheckers        = 4         // Maximum helpers to check endpoint health
 	EndPointCheckerDuration    = 2         // Duration at which ep-helpers will run
 	MaxEndPointSweeps          = 20        // Maximum end-point sweeps per round
 	VIPSweepDuration           = 30        // Duration of periodic VIP maintenance
 	DefaultPersistTimeOut      = 10800     // Default persistent LB session timeout
+	OdWindow                   = 10        // Window of conntrack outcomes for outlier detection
+	OdMinConns                 = 5         // Min connections in a window to consider an end-point outlier
+	OdSynSentAge               = 3         // Age of a connection stuck in sync-sent to be taken as failed
+	DflOdEjectTime             = 30        // Default base ejection time of an outlier end-point
+	MaxOdEjectTime             = 3600      // Max ejection time of an outlier end-point
+	DflOdMaxEjectPct           = 50        // Default max percent of end-points ejected at a time
+	MaxSlowStartTime           = 3600      // Max slow-start window of an end-point
+	DflSlowStartFloor          = 10        // Default weight (in percent) of an end-point as slow-start begins
+)
+
+// lb port range constants
+const (
+	MaxLbPortRange     = 4096 // Max ports in the port range of a lb rule
+	MaxLbPortRangeEbpf = 256  // Max ports in the port range of a lb rule with eBPF dp
+)
+
+// lb source range constants
+const (
+	MaxLbSrcRanges     = 64    // Max source ranges of a lb rule
+	MaxLbSrcRangeFws   = 512   // Max fw rules dropping sources outside the source ranges of a lb rule
+	LbSrcRangeDropPref = 65533 // Preference (reserved) of fw rules dropping other sources of a lb rule
+)
+
+// possible slow-start weight curves
+const (
+	SlowStartLinear    = "linear"
+	SlowStartQuadratic = "quadratic"
+	SlowStartSqrt      = "sqrt"
 )
 
 type ruleTType uint
@@ -143,6 +181,8 @@ type ruleTuples struct {
 	vlanID   rule16Tuple
 	l3Src    ruleIPTuple
 	l3Dst    ruleIPTuple
+	l3SrcSet ruleStringTuple
+	l3DstSet ruleStringTuple
 	l4Prot   rule8Tuple
 	l4Src    rule16Tuple
 	l4Dst    rule16Tuple
@@ -156,6 +196,7 @@ type ruleTuples struct {
 	inL4Dst  rule16Tuple
 	pref     uint16
 	path     string
+	owner    string
 }
 
 type ruleTActType uint
@@ -180,16 +221,33 @@ const (
 	HostProbeConnectSCTP = "sctp"
 	HostProbeHTTP        = "http"
 	HostProbeHTTPS       = "https"
+	HostProbeGRPC        = "grpc"
+	HostProbeGRPCS       = "grpcs"
+	HostProbeExec        = "exec"
 	HostProbeNone        = "none"
 )
 
+type httpProbeOpts struct {
+	method    string
+	host      string
+	headers   []string
+	respCodes []int
+	respRegex string
+}
+
 type epHostOpts struct {
 	inActTryThr       int
+	actTryThr         int
 	probeType         string
 	probeReq          string
 	probeResp         string
+	probeHTTP         httpProbeOpts
+	probeRespRe       *regexp.Regexp
+	probeCmd          string
 	probeDuration     uint32
 	currProbeDuration uint32
+	probeTimeout      uint32
+	probeJitter       uint32
 	probePort         uint16
 	probeActivated    bool
 }
@@ -206,6 +264,11 @@ type epHost struct {
 	maxDelay     time.Duration
 	hID          uint8
 	inActTries   int
+	actTries     int
+	jitter       time.Duration
+	execOn       atomic.Bool
+	apiAdded     bool
+	httpTr       *http.Transport
 	opts         epHostOpts
 }
 
@@ -221,6 +284,16 @@ type ruleNatEp struct {
 	stat          ruleStat
 	foldEndPoints []ruleNatEp
 	foldRuleKey   string
+	odEjected     bool
+	odEjectCnt    int
+	odUntil       time.Time
+	ssStart       time.Time
+	ssWeight      uint8
+	drain         bool
+	drainDel      bool
+	drainUntil    time.Time
+	drainConns    int
+	connCapped    bool
 }
 
 type ruleNatSIP struct {
@@ -240,6 +313,9 @@ type ruleFwOpt struct {
 	record   bool
 	snatIP   string
 	snatPort uint16
+	expiry   time.Time
+	sched    *fwSchedule
+	schedOff bool
 }
 
 type ruleFwOpts struct {
@@ -265,8 +341,38 @@ type ruleProbe struct {
 	prbPort    uint16
 	prbReq     string
 	prbResp    string
+	prbHTTP    httpProbeOpts
 	prbTimeo   uint32
 	prbRetries int
+	prbActTry  int
+	prbJitter  uint32
+	prbIntv    uint32
+}
+
+type ruleOutlier struct {
+	failRatio   uint8
+	ejectTime   uint32
+	maxEjectPct uint8
+	sT          time.Time
+	ctSeen      map[string]odCtSeen
+}
+
+// odCtSeen - what outlier detection knows of a conntrack entry from earlier windows
+type odCtSeen struct {
+	failed bool
+	synTs  time.Time
+}
+
+type ruleSlowStart struct {
+	window uint32
+	floor  uint8
+	curve  string
+}
+
+type ruleConnLimit struct {
+	maxConns  uint32
+	maxConnEp uint32
+	connRate  uint32
 }
 
 type ruleEnt struct {
@@ -276,6 +382,14 @@ type ruleEnt struct {
 	tuples   ruleTuples
 	ci       string
 	hChk     ruleProbe
+	od       ruleOutlier
+	ss       ruleSlowStart
+	drainTO  uint32
+	srcRngs  []string
+	srcFws   []cmn.FwRuleMod
+	setFws   map[string]*FwDpWorkQ
+	limits   ruleConnLimit
+	rejConns uint64
 	managed  bool
 	bgp      bool
 	addrRslv bool
@@ -312,6 +426,9 @@ const (
 const (
 	RtMaximumFw4s = (8 * 1024)
 	RtMaximumLbs  = (2 * 1024)
+	// RtFwDefaultMark - marks of fw rules start from 1, mark 0 is used by
+	// the default policy entry of a zone
+	RtFwDefaultMark = 0
 )
 
 // RuleCfg - tunable parameters related to inactive rules
@@ -339,6 +456,13 @@ type RuleH struct {
 	rootCAPool *x509.CertPool
 	tlsCert    tls.Certificate
 	vipST      time.Time
+	epEvMx     sync.RWMutex
+	epEvSubs   map[chan cmn.EndPointEvent]struct{}
+	fwEvMx     sync.RWMutex
+	fwEvSubs   map[chan cmn.FwRuleEvent]struct{}
+	cfgGen     uint64
+	fwPolicy   string
+	fwPolSync  DpStatusT
 }
 
 // RulesInit - initialize the Rules subsystem
@@ -351,6 +475,8 @@ func RulesInit(zone *Zone) *RuleH {
 
 	nRh.vipMap = make(map[string]int)
 	nRh.epMap = make(map[string]*epHost)
+	nRh.epEvSubs = make(map[chan cmn.EndPointEvent]struct{})
+	nRh.fwEvSubs = make(map[chan cmn.FwRuleEvent]struct{})
 	nRh.tables[RtFw].tableMatch = RmMax - 1
 	nRh.tables[RtFw].tableType = RtMf
 	nRh.tables[RtFw].eMap = make(map[string]*ruleEnt)
@@ -499,6 +625,8 @@ func (r *ruleTuples) ruleKey() string {
 	ks += fmt.Sprintf("%d", r.vlanID.val&r.vlanID.valid)
 	ks += fmt.Sprintf("%s", r.l3Dst.addr.String())
 	ks += fmt.Sprintf("%s", r.l3Src.addr.String())
+	ks += fmt.Sprintf("%s", r.l3DstSet.val)
+	ks += fmt.Sprintf("%s", r.l3SrcSet.val)
 	ks += fmt.Sprintf("%d", r.l4Prot.val&r.l4Prot.valid)
 
 	if r.l4Src.valid == 0xffff {
@@ -534,9 +662,41 @@ func (r *ruleTuples) ruleKey() string {
 	ks += fmt.Sprintf("%d", r.inL4Src.val&r.inL4Src.valid)
 	ks += fmt.Sprintf("%d", r.inL4Dst.val&r.inL4Dst.valid)
 	ks += fmt.Sprintf("%d", r.pref)
+	if r.owner != "" {
+		ks += r.owner
+	}
 	return ks
 }
 
+// l4DstPorts - Get the min and max l4 destination ports of rule tuples.
+// A port range is kept as {max, min} in l4Dst like in case of firewall rules
+func (r *ruleTuples) l4DstPorts() (uint16, uint16) {
+	if r.l4Dst.valid == 0xffff || r.l4Dst.valid == 0 {
+		return r.l4Dst.val, r.l4Dst.val
+	}
+	return r.l4Dst.valid, r.l4Dst.val
+}
+
+// lbServPorts - Get the min and max service ports of a lb service
+func lbServPorts(serv cmn.LbServiceArg) (uint16, uint16) {
+	if serv.ServPortMask != 0 {
+		return serv.ServPort & serv.ServPortMask, serv.ServPort | ^serv.ServPortMask
+	}
+	if serv.ServPortMax > serv.ServPort {
+		return serv.ServPort, serv.ServPortMax
+	}
+	return serv.ServPort, serv.ServPort
+}
+
+// lbServPortTuple - Get the l4 destination tuple of a lb service
+func lbServPortTuple(serv cmn.LbServiceArg) rule16Tuple {
+	pMin, pMax := lbServPorts(serv)
+	if pMax > pMin {
+		return rule16Tuple{pMax, pMin}
+	}
+	return rule16Tuple{pMin, 0xffff}
+}
+
 func checkValidMACTuple(mt ruleMacTuple) bool {
 	if mt.valid[0] != 0 ||
 		mt.valid[1] != 0 ||
@@ -592,12 +752,24 @@ func (r *ruleTuples) String() string {
 		ks += fmt.Sprintf("src-%s,", r.l3Src.addr.String())
 	}
 
+	if r.l3DstSet.val != "" {
+		ks += fmt.Sprintf("dst-set-%s,", r.l3DstSet.val)
+	}
+
+	if r.l3SrcSet.val != "" {
+		ks += fmt.Sprintf("src-set-%s,", r.l3SrcSet.val)
+	}
+
 	if r.l4Prot.valid != 0 {
 		ks += fmt.Sprintf("proto-%d,", r.l4Prot.val&r.l4Prot.valid)
 	}
 
 	if r.l4Dst.valid != 0 {
-		ks += fmt.Sprintf("dport-%d,", r.l4Dst.val&r.l4Dst.valid)
+		if pMin, pMax := r.l4DstPorts(); pMax > pMin {
+			ks += fmt.Sprintf("dport-%d:%d,", pMin, pMax)
+		} else {
+			ks += fmt.Sprintf("dport-%d,", r.l4Dst.val&r.l4Dst.valid)
+		}
 	}
 
 	if r.l4Src.valid != 0 {
@@ -728,7 +900,10 @@ func (R *RuleH) Rules2Json() ([]byte, error) {
 		} else {
 			return nil, errors.New("malformed service proto")
 		}
-		t.ServPort = data.tuples.l4Dst.val
+		t.ServPort, t.ServPortMax = data.tuples.l4DstPorts()
+		if t.ServPortMax == t.ServPort {
+			t.ServPortMax = 0
+		}
 		t.Sel = data.act.action.(*ruleNatActs).sel
 		t.Mode = data.act.action.(*ruleNatActs).mode
 
@@ -762,74 +937,122 @@ func (R *RuleH) GetNatLbRule() ([]cmn.LbRuleMod, error) {
 	var res []cmn.LbRuleMod
 
 	for _, data := range R.tables[RtLB].eMap {
-		var ret cmn.LbRuleMod
-		// Make Service Arguments
-		ret.Serv.ServIP = data.tuples.l3Dst.addr.IP.String()
-		if data.tuples.l4Prot.val == 6 {
-			ret.Serv.Proto = "tcp"
-		} else if data.tuples.l4Prot.val == 17 {
-			ret.Serv.Proto = "udp"
-		} else if data.tuples.l4Prot.val == 1 {
-			ret.Serv.Proto = "icmp"
-		} else if data.tuples.l4Prot.val == 132 {
-			ret.Serv.Proto = "sctp"
-		} else if data.tuples.l4Prot.val == 0 {
-			ret.Serv.Proto = "none"
-		} else {
-			return []cmn.LbRuleMod{}, errors.New("malformed service proto")
-		}
-		ret.Serv.ServPort = data.tuples.l4Dst.val
-		ret.Serv.Sel = data.act.action.(*ruleNatActs).sel
-		ret.Serv.Mode = data.act.action.(*ruleNatActs).mode
-		ret.Serv.Monitor = data.hChk.actChk
-		ret.Serv.InactiveTimeout = data.iTO
-		ret.Serv.Bgp = data.bgp
-		ret.Serv.BlockNum = data.tuples.pref
-		ret.Serv.Managed = data.managed
-		ret.Serv.Security = data.secMode
-		ret.Serv.ProbeType = data.hChk.prbType
-		ret.Serv.ProbePort = data.hChk.prbPort
-		ret.Serv.ProbeReq = data.hChk.prbReq
-		ret.Serv.ProbeResp = data.hChk.prbResp
-		ret.Serv.Name = data.name
-		ret.Serv.HostUrl = data.tuples.path
-		if data.act.actType == RtActSnat {
-			ret.Serv.Snat = true
-		}
-
-		for _, sip := range data.secIP {
-			ret.SecIPs = append(ret.SecIPs, cmn.LbSecIPArg{SecIP: sip.sIP.String()})
+		data.DP(DpStatsGetImm)
+
+		ret, err := data.natLbRuleMod()
+		if err != nil {
+			return []cmn.LbRuleMod{}, err
 		}
+		if len(data.srcRngs) > 0 {
+			packets, bytes := R.srcRangeDrops(data)
+			ret.Serv.SrcRangeDrops = fmt.Sprintf("%v:%v", packets, bytes)
+		}
+		ret.Serv.RejectedConns = data.rejConns
 
-		data.DP(DpStatsGetImm)
+		// Make LB rule
+		res = append(res, ret)
+	}
 
-		// Make Endpoints
-		tmpEp := data.act.action.(*ruleNatActs).endPoints
-		for _, ep := range tmpEp {
-			state := "active"
-			if ep.noService {
-				state = "inactive"
-			}
+	return res, nil
+}
 
-			if ep.inActive {
-				continue
-			}
+// natLbRuleMod - Get the arguments of a lb rule as a cmn.LbRuleMod
+func (r *ruleEnt) natLbRuleMod() (cmn.LbRuleMod, error) {
+	var ret cmn.LbRuleMod
 
-			counterStr := fmt.Sprintf("%v:%v", ep.stat.packets, ep.stat.bytes)
+	// Make Service Arguments
+	ret.Serv.ServIP = r.tuples.l3Dst.addr.IP.String()
+	if r.tuples.l4Prot.val == 6 {
+		ret.Serv.Proto = "tcp"
+	} else if r.tuples.l4Prot.val == 17 {
+		ret.Serv.Proto = "udp"
+	} else if r.tuples.l4Prot.val == 1 {
+		ret.Serv.Proto = "icmp"
+	} else if r.tuples.l4Prot.val == 132 {
+		ret.Serv.Proto = "sctp"
+	} else if r.tuples.l4Prot.val == 0 {
+		ret.Serv.Proto = "none"
+	} else {
+		return ret, errors.New("malformed service proto")
+	}
+	ret.Serv.ServPort, ret.Serv.ServPortMax = r.tuples.l4DstPorts()
+	if ret.Serv.ServPortMax == ret.Serv.ServPort {
+		ret.Serv.ServPortMax = 0
+	}
+	ret.Serv.Sel = r.act.action.(*ruleNatActs).sel
+	ret.Serv.Mode = r.act.action.(*ruleNatActs).mode
+	ret.Serv.Monitor = r.hChk.actChk
+	ret.Serv.InactiveTimeout = r.iTO
+	ret.Serv.Bgp = r.bgp
+	ret.Serv.BlockNum = r.tuples.pref
+	ret.Serv.Managed = r.managed
+	ret.Serv.Security = r.secMode
+	ret.Serv.ProbeType = r.hChk.prbType
+	ret.Serv.ProbePort = r.hChk.prbPort
+	ret.Serv.ProbeReq = r.hChk.prbReq
+	ret.Serv.ProbeResp = r.hChk.prbResp
+	ret.Serv.ProbeMethod = r.hChk.prbHTTP.method
+	ret.Serv.ProbeHost = r.hChk.prbHTTP.host
+	ret.Serv.ProbeHeaders = r.hChk.prbHTTP.headers
+	ret.Serv.ProbeRespCodes = r.hChk.prbHTTP.respCodes
+	ret.Serv.ProbeRespRegex = r.hChk.prbHTTP.respRegex
+	ret.Serv.ProbeTimeout = r.hChk.prbTimeo
+	ret.Serv.ProbeRetries = r.hChk.prbRetries
+	ret.Serv.ProbeActRetries = r.hChk.prbActTry
+	ret.Serv.ProbeJitter = r.hChk.prbJitter
+	ret.Serv.ProbeInterval = r.hChk.prbIntv
+	ret.Serv.OdFailRatio = r.od.failRatio
+	ret.Serv.OdEjectTime = r.od.ejectTime
+	ret.Serv.OdMaxEjectPct = r.od.maxEjectPct
+	ret.Serv.SlowStart = r.ss.window
+	ret.Serv.SlowStartFloor = r.ss.floor
+	ret.Serv.SlowStartCurve = r.ss.curve
+	ret.Serv.DrainTimeout = r.drainTO
+	ret.Serv.PersistTimeout = r.pTO
+	ret.Serv.SrcRanges = r.srcRngs
+	ret.Serv.MaxConns = r.limits.maxConns
+	ret.Serv.MaxConnsPerEp = r.limits.maxConnEp
+	ret.Serv.NewConnRate = r.limits.connRate
+	ret.Serv.Name = r.name
+	ret.Serv.HostUrl = r.tuples.path
+	if r.act.actType == RtActSnat {
+		ret.Serv.Snat = true
+	}
 
-			ret.Eps = append(ret.Eps, cmn.LbEndPointArg{
-				EpIP:     ep.xIP.String(),
-				EpPort:   ep.xPort,
-				Weight:   ep.weight,
-				State:    state,
-				Counters: counterStr,
-			})
+	for _, sip := range r.secIP {
+		ret.SecIPs = append(ret.SecIPs, cmn.LbSecIPArg{SecIP: sip.sIP.String()})
+	}
+
+	// Make Endpoints
+	tmpEp := r.act.action.(*ruleNatActs).endPoints
+	for _, ep := range tmpEp {
+		state := "active"
+		if ep.noService {
+			state = "inactive"
+		} else if ep.drain {
+			state = "draining"
+		} else if ep.odEjected {
+			state = "ejected"
 		}
-		// Make LB rule
-		res = append(res, ret)
+
+		if ep.inActive {
+			continue
+		}
+
+		counterStr := fmt.Sprintf("%v:%v", ep.stat.packets, ep.stat.bytes)
+
+		ret.Eps = append(ret.Eps, cmn.LbEndPointArg{
+			EpIP:       ep.xIP.String(),
+			EpPort:     ep.xPort,
+			Weight:     ep.weight,
+			CurrWeight: ep.currWeight(),
+			State:      state,
+			Counters:   counterStr,
+			ActConns:   ep.drainConns,
+		})
 	}
 
-	return res, nil
+	return ret, nil
 }
 
 // validateXlateEPWeights - validate and adjust weights if necessary
@@ -852,35 +1075,43 @@ func validateXlateEPWeights(servEndPoints []cmn.LbEndPointArg) (int, error) {
 	return 0, nil
 }
 
+// dflEPProbe - Get the default probe type and port used to key a lb end-point
+func dflEPProbe(l4Prot uint8, xPort uint16) (string, uint16) {
+	switch l4Prot {
+	case 6:
+		return HostProbeConnectTCP, xPort
+	case 17:
+		return HostProbeConnectUDP, xPort
+	case 132:
+		return HostProbeConnectSCTP, xPort
+	default:
+		return HostProbePing, 0
+	}
+}
+
 func (R *RuleH) modNatEpHost(r *ruleEnt, endpoints []ruleNatEp, doAddOp bool, liveCheckEn bool) {
 	var hopts epHostOpts
-	pType := ""
-	pPort := uint16(0)
+	var pType string
+	var pPort uint16
 	if r.hChk.prbRetries == 0 {
 		hopts.inActTryThr = DflLbaInactiveTries
 	} else {
 		hopts.inActTryThr = r.hChk.prbRetries
 	}
-	if r.hChk.prbTimeo == 0 {
-		hopts.probeDuration = DflHostProbeTimeout
-	} else {
+	// Probe timeout is taken as the probe interval if the latter is not set
+	// and each probe then uses the default timeout
+	if r.hChk.prbIntv != 0 {
+		hopts.probeDuration = r.hChk.prbIntv
+		hopts.probeTimeout = r.hChk.prbTimeo
+	} else if r.hChk.prbTimeo != 0 {
 		hopts.probeDuration = r.hChk.prbTimeo
+	} else {
+		hopts.probeDuration = DflHostProbeTimeout
 	}
+	hopts.actTryThr = r.hChk.prbActTry
+	hopts.probeJitter = r.hChk.prbJitter
 	for _, nep := range endpoints {
-		if r.tuples.l4Prot.val == 6 {
-			pType = HostProbeConnectTCP
-			pPort = nep.xPort
-		} else if r.tuples.l4Prot.val == 17 {
-			pType = HostProbeConnectUDP
-			pPort = nep.xPort
-		} else if r.tuples.l4Prot.val == 1 {
-			pType = HostProbePing
-		} else if r.tuples.l4Prot.val == 132 {
-			pType = HostProbeConnectSCTP
-			pPort = nep.xPort
-		} else {
-			pType = HostProbePing
-		}
+		pType, pPort = dflEPProbe(r.tuples.l4Prot.val, nep.xPort)
 
 		if r.hChk.prbType != "" {
 			// If probetype is specified as a part of rule,
@@ -889,6 +1120,7 @@ func (R *RuleH) modNatEpHost(r *ruleEnt, endpoints []ruleNatEp, doAddOp bool, li
 			hopts.probePort = r.hChk.prbPort
 			hopts.probeReq = r.hChk.prbReq
 			hopts.probeResp = r.hChk.prbResp
+			hopts.probeHTTP = r.hChk.prbHTTP
 		} else {
 			hopts.probeType = pType
 			hopts.probePort = pPort
@@ -949,7 +1181,7 @@ func (R *RuleH) GetNatLbRuleByServArgs(serv cmn.LbServiceArg) *ruleEnt {
 
 	l4prot := rule8Tuple{ipProto, 0xff}
 	l3dst := ruleIPTuple{*sNetAddr}
-	l4dst := rule16Tuple{serv.ServPort, 0xffff}
+	l4dst := lbServPortTuple(serv)
 	rt := ruleTuples{l3Dst: l3dst, l4Prot: l4prot, l4Dst: l4dst, pref: serv.BlockNum, path: serv.HostUrl}
 	return R.tables[RtLB].eMap[rt.ruleKey()]
 }
@@ -977,7 +1209,7 @@ func (R *RuleH) GetNatLbRuleSecIPs(serv cmn.LbServiceArg) []string {
 
 	l4prot := rule8Tuple{ipProto, 0xff}
 	l3dst := ruleIPTuple{*sNetAddr}
-	l4dst := rule16Tuple{serv.ServPort, 0xffff}
+	l4dst := lbServPortTuple(serv)
 	rt := ruleTuples{l3Dst: l3dst, l4Prot: l4prot, l4Dst: l4dst, pref: serv.BlockNum, path: serv.HostUrl}
 	if R.tables[RtLB].eMap[rt.ruleKey()] != nil {
 		for _, ip := range R.tables[RtLB].eMap[rt.ruleKey()].secIP {
@@ -1132,6 +1364,7 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 						np.noService = true
 						rChg = true
 						tk.LogIt(tk.LogDebug, "nat lb-rule service-down ep - %s:%s\n", sType, n.xIP.String())
+						R.epSetNotify(rule, np, sType)
 					}
 				} else {
 					if n.noService {
@@ -1139,6 +1372,8 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 						np.inActTries = 0
 						rChg = true
 						tk.LogIt(tk.LogDebug, "nat lb-rule service-up ep - %s:%s\n", sType, n.xIP.String())
+						rule.slowStartBegin(np)
+						R.epSetNotify(rule, np, sType)
 					}
 				}
 			}
@@ -1149,6 +1384,32 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 	return rChg
 }
 
+// epSetNotify - Notify subscribers that an end-point was added to or removed
+// from the effective end-point set of a lb rule
+func (R *RuleH) epSetNotify(rule *ruleEnt, np *ruleNatEp, sType string) {
+	if !R.epEventHasSubs() {
+		return
+	}
+
+	ev := cmn.EndPointEvent{Time: time.Now(), Kind: cmn.EpEventLbSet,
+		HostName: np.xIP.String(), Name: makeEPKey(np.xIP.String(), sType, np.xPort),
+		ProbeType: sType, ProbePort: np.xPort, Rules: []string{rule.eventName()}}
+	if np.noService {
+		ev.CurrState = "nok"
+	} else {
+		ev.CurrState = "ok"
+	}
+	R.epMx.RLock()
+	if ep := R.epMap[ev.Name]; ep != nil {
+		ev.ProbeType = ep.opts.probeType
+		ev.ProbePort = ep.opts.probePort
+		ev.LastDelay = ep.avgDelay.String()
+	}
+	R.epMx.RUnlock()
+
+	R.epEventNotify(ev)
+}
+
 // foldRecursiveEPs - Check if this rule's key matches endpoint of another rule.
 // If so, replace that rule's endpoints to this rule's endpoints
 func (R *RuleH) foldRecursiveEPs(r *ruleEnt) {
@@ -1282,7 +1543,7 @@ func (R *RuleH) addVIPSys(r *ruleEnt) {
 	}
 }
 
-func getLBArms(oldEps []ruleNatEp, newEps []ruleNatEp, oper cmn.LBOp) (bool, []ruleNatEp) {
+func getLBArms(oldEps []ruleNatEp, newEps []ruleNatEp, oper cmn.LBOp, drainTO uint32) (bool, []ruleNatEp) {
 	var retEps []ruleNatEp
 	ruleChg := false
 	found := false
@@ -1297,6 +1558,10 @@ func getLBArms(oldEps []ruleNatEp, newEps []ruleNatEp, oper cmn.LBOp) (bool, []r
 					ruleChg = true
 					e.inActive = false
 				}
+				if eEp.drain && oper != cmn.LBOPDetach {
+					ruleChg = true
+					e.drainStop()
+				}
 				if e.weight != nEp.weight {
 					ruleChg = true
 					e.weight = nEp.weight
@@ -1318,6 +1583,14 @@ func getLBArms(oldEps []ruleNatEp, newEps []ruleNatEp, oper cmn.LBOp) (bool, []r
 			e := &oldEps[i]
 			if !e.chkVal {
 				retEps = append(retEps, *e)
+			} else if drainTO != 0 {
+				// Detached end-points are removed once drained
+				if !e.drain {
+					e.drainStart(drainTO)
+				}
+				e.drainDel = true
+				e.chkVal = false
+				retEps = append(retEps, *e)
 			}
 		}
 		return true, retEps
@@ -1338,18 +1611,42 @@ func getLBArms(oldEps []ruleNatEp, newEps []ruleNatEp, oper cmn.LBOp) (bool, []r
 	for i, eEp := range retEps {
 		e := &retEps[i]
 		if !eEp.chkVal && oper == cmn.LBOPAdd {
-			ruleChg = true
-			e.inActive = true
+			if drainTO != 0 && !e.inActive {
+				if !e.drain {
+					ruleChg = true
+					e.drainStart(drainTO)
+				}
+			} else {
+				ruleChg = true
+				e.inActive = true
+			}
 		}
 		e.chkVal = false
 	}
 	return ruleChg, retEps
 }
 
-// AddNatLbRule - Add a service LB nat rule. The service details are passed in serv argument,
-// and end-point information is passed in the slice servEndPoints. On success,
-// it will return 0 and nil error, else appropriate return code and error string will be set
-func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, servEndPoints []cmn.LbEndPointArg) (int, error) {
+// natLbArgs - args of a lb rule as validated by natLbRuleArgs
+type natLbArgs struct {
+	serv          cmn.LbServiceArg
+	sNetAddr      *net.IPNet
+	ipProto       uint8
+	pMin          uint16
+	pMax          uint16
+	httpOpts      httpProbeOpts
+	od            ruleOutlier
+	limits        ruleConnLimit
+	srcRngs       []string
+	ss            ruleSlowStart
+	nSecIP        []ruleNatSIP
+	natActs       ruleNatActs
+	activateProbe bool
+}
+
+// natLbRuleArgs - Validate the args of a lb rule and get them in the form used
+// by lb rules. It neither looks at nor changes the rule tables, so it can be
+// used to check rules before any of them is applied
+func natLbRuleArgs(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, servEndPoints []cmn.LbEndPointArg) (*natLbArgs, int, error) {
 	var natActs ruleNatActs
 	var nSecIP []ruleNatSIP
 	var ipProto uint8
@@ -1363,12 +1660,12 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 	}
 	_, sNetAddr, err := net.ParseCIDR(service)
 	if err != nil {
-		return RuleUnknownServiceErr, errors.New("malformed-service error")
+		return nil, RuleUnknownServiceErr, errors.New("malformed-service error")
 	}
 
 	// Validate inactivity timeout
 	if serv.InactiveTimeout > LbMaxInactiveTimeout {
-		return RuleArgsErr, errors.New("service-args error")
+		return nil, RuleArgsErr, errors.New("service-args error")
 	} else if serv.InactiveTimeout == 0 {
 		serv.InactiveTimeout = LbDefaultInactiveTimeout
 		if serv.Proto != "tcp" && serv.Proto != "sctp" {
@@ -1377,25 +1674,37 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 	}
 
 	// Validate liveness probetype and port
+	httpOpts := httpProbeOpts{method: serv.ProbeMethod, host: serv.ProbeHost,
+		headers: serv.ProbeHeaders, respCodes: serv.ProbeRespCodes, respRegex: serv.ProbeRespRegex}
 	if serv.ProbeType != "" {
 		if serv.ProbeType != HostProbeConnectSCTP &&
 			serv.ProbeType != HostProbeConnectTCP &&
 			serv.ProbeType != HostProbeConnectUDP &&
 			serv.ProbeType != HostProbePing &&
+			serv.ProbeType != HostProbeHTTP &&
+			serv.ProbeType != HostProbeHTTPS &&
+			serv.ProbeType != HostProbeGRPC &&
+			serv.ProbeType != HostProbeGRPCS &&
 			serv.ProbeType != HostProbeNone {
-			return RuleArgsErr, errors.New("malformed-service-ptype error")
+			return nil, RuleArgsErr, errors.New("malformed-service-ptype error")
 		}
 
 		if (serv.ProbeType == HostProbeConnectSCTP ||
 			serv.ProbeType == HostProbeConnectTCP ||
-			serv.ProbeType == HostProbeConnectUDP) &&
+			serv.ProbeType == HostProbeConnectUDP ||
+			serv.ProbeType == HostProbeGRPC ||
+			serv.ProbeType == HostProbeGRPCS) &&
 			(serv.ProbePort == 0) {
-			return RuleArgsErr, errors.New("malformed-service-pport error")
+			return nil, RuleArgsErr, errors.New("malformed-service-pport error")
 		}
 
 		if (serv.ProbeType == HostProbeNone || serv.ProbeType == HostProbePing) &&
 			(serv.ProbePort != 0) {
-			return RuleArgsErr, errors.New("malformed-service-pport error")
+			return nil, RuleArgsErr, errors.New("malformed-service-pport error")
+		}
+
+		if _, err := validateHTTPProbeOpts(serv.ProbeType, httpOpts); err != nil {
+			return nil, RuleArgsErr, errors.New("malformed-service-phttp error")
 		}
 
 		// Override monitor flag to true if certain conditions meet
@@ -1403,17 +1712,117 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 			serv.Monitor = true
 		}
 	} else if serv.ProbePort != 0 {
-		return RuleArgsErr, errors.New("malformed-service-pport error")
+		return nil, RuleArgsErr, errors.New("malformed-service-pport error")
+	} else if !reflect.DeepEqual(httpOpts, httpProbeOpts{}) {
+		return nil, RuleArgsErr, errors.New("malformed-service-phttp error")
+	}
+
+	if serv.ProbeRetries > MaxDflLbaInactiveTries || serv.ProbeActRetries > MaxDflLbaInactiveTries ||
+		serv.ProbeTimeout > MaxHostProbeTime || serv.ProbeJitter > MaxHostProbeTime ||
+		serv.ProbeInterval > MaxHostProbeTime {
+		return nil, RuleArgsErr, errors.New("malformed-service-pargs error")
+	}
+	if serv.ProbeInterval != 0 && (serv.ProbeTimeout > MaxHostProbeWait || serv.ProbeTimeout >= serv.ProbeInterval) {
+		return nil, RuleArgsErr, errors.New("malformed-service-ptimeout error")
+	}
+
+	// Validate outlier detection args
+	od := ruleOutlier{failRatio: serv.OdFailRatio, ejectTime: serv.OdEjectTime, maxEjectPct: serv.OdMaxEjectPct}
+	if od.failRatio > 100 || od.maxEjectPct > 100 || od.ejectTime > MaxOdEjectTime {
+		return nil, RuleArgsErr, errors.New("malformed-service-odargs error")
+	}
+	if od.failRatio != 0 {
+		if od.ejectTime == 0 {
+			od.ejectTime = DflOdEjectTime
+		}
+		if od.maxEjectPct == 0 {
+			od.maxEjectPct = DflOdMaxEjectPct
+		}
+	} else if od.ejectTime != 0 || od.maxEjectPct != 0 {
+		return nil, RuleArgsErr, errors.New("malformed-service-odargs error")
+	}
+
+	if serv.DrainTimeout > LbMaxDrainTimeout {
+		return nil, RuleArgsErr, errors.New("malformed-service-drain error")
+	}
+
+	// Validate connection limits
+	limits := ruleConnLimit{maxConns: serv.MaxConns, maxConnEp: serv.MaxConnsPerEp, connRate: serv.NewConnRate}
+	if serv.Snat && limits != (ruleConnLimit{}) {
+		return nil, RuleArgsErr, errors.New("malformed-service-limits error")
+	}
+	// New connection rate is enforced only by userspace dp as of now. Connection
+	// caps are enforced for other dps by the control plane (see connCapSync)
+	if mh.dpUser == nil && limits.connRate != 0 {
+		return nil, RuleArgsErr, errors.New("malformed-service-limits error: rate not supported by dp")
+	}
+
+	// Validate source ranges
+	var srcRngs []string
+	if len(serv.SrcRanges) > MaxLbSrcRanges || (serv.Snat && len(serv.SrcRanges) > 0) {
+		return nil, RuleArgsErr, errors.New("malformed-service-srcranges error")
+	}
+	for _, sr := range serv.SrcRanges {
+		_, srNet, err := net.ParseCIDR(sr)
+		if err != nil || tk.IsNetIPv4(srNet.IP.String()) != tk.IsNetIPv4(serv.ServIP) {
+			return nil, RuleArgsErr, errors.New("malformed-service-srcranges error")
+		}
+		srcRngs = append(srcRngs, srNet.String())
+	}
+	sort.Strings(srcRngs)
+	if len(srcRngs) > 0 && len(srcRangeExclude(srcRngs, tk.IsNetIPv4(serv.ServIP))) > MaxLbSrcRangeFws {
+		return nil, RuleArgsErr, errors.New("malformed-service-srcranges error: too fragmented")
+	}
+
+	// Validate slow-start args
+	ss := ruleSlowStart{window: serv.SlowStart, floor: serv.SlowStartFloor, curve: serv.SlowStartCurve}
+	if ss.window > MaxSlowStartTime || ss.floor > 100 {
+		return nil, RuleArgsErr, errors.New("malformed-service-ssargs error")
+	}
+	if ss.window != 0 {
+		// Only weighted selection modes make use of the ramped up weights
+		if serv.Sel != cmn.LbSelPrio && serv.Sel != cmn.LbSelMaglev {
+			return nil, RuleArgsErr, errors.New("malformed-service-ssargs error")
+		}
+		if ss.floor == 0 {
+			ss.floor = DflSlowStartFloor
+		}
+		if ss.curve == "" {
+			ss.curve = SlowStartLinear
+		} else if ss.curve != SlowStartLinear && ss.curve != SlowStartQuadratic && ss.curve != SlowStartSqrt {
+			return nil, RuleArgsErr, errors.New("malformed-service-ssargs error")
+		}
+	} else if ss.floor != 0 || ss.curve != "" {
+		return nil, RuleArgsErr, errors.New("malformed-service-ssargs error")
 	}
 
 	// Currently support a maximum of MAX_NAT_EPS
 	if len(servEndPoints) <= 0 || len(servEndPoints) > MaxNatEndPoints {
-		return RuleEpCountErr, errors.New("endpoints-range error")
+		return nil, RuleEpCountErr, errors.New("endpoints-range error")
 	}
 
 	// For ICMP service, non-zero port can't be specified
 	if serv.Proto == "icmp" && serv.ServPort != 0 {
-		return RuleUnknownServiceErr, errors.New("malformed-service error")
+		return nil, RuleUnknownServiceErr, errors.New("malformed-service error")
+	}
+
+	// Validate port range
+	if (serv.ServPortMax != 0 && serv.ServPortMask != 0) ||
+		(serv.ServPortMax != 0 && serv.ServPortMax < serv.ServPort) ||
+		(^serv.ServPortMask)&(^serv.ServPortMask+1) != 0 {
+		return nil, RuleArgsErr, errors.New("malformed-service-portrange error")
+	}
+	pMin, pMax := lbServPorts(serv)
+	if pMax > pMin {
+		// Each port of a range takes up an entry in the nat map of eBPF dp
+		maxPorts := MaxLbPortRange
+		if mh.dpEbpf != nil {
+			maxPorts = MaxLbPortRangeEbpf
+		}
+		if int(pMax-pMin) >= maxPorts || serv.Proto == "icmp" || serv.Proto == "none" ||
+			serv.Snat || serv.Mode == cmn.LBModeFullProxy {
+			return nil, RuleArgsErr, errors.New("malformed-service-portrange error")
+		}
 	}
 
 	if serv.Proto == "tcp" {
@@ -1427,15 +1836,15 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 	} else if serv.Proto == "none" {
 		ipProto = 0
 	} else {
-		return RuleUnknownServiceErr, errors.New("malformed-proto error")
+		return nil, RuleUnknownServiceErr, errors.New("malformed-proto error")
 	}
 
 	if serv.Proto != "sctp" && len(servSecIPs) > 0 {
-		return RuleArgsErr, errors.New("secondaryIP-args error")
+		return nil, RuleArgsErr, errors.New("secondaryIP-args error")
 	}
 
 	if len(servSecIPs) > 3 {
-		return RuleArgsErr, errors.New("secondaryIP-args len error")
+		return nil, RuleArgsErr, errors.New("secondaryIP-args len error")
 	}
 
 	activateProbe := false
@@ -1443,10 +1852,10 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 	for _, k := range servSecIPs {
 		pNetAddr := net.ParseIP(k.SecIP)
 		if pNetAddr == nil {
-			return RuleUnknownServiceErr, errors.New("malformed-secIP error")
+			return nil, RuleUnknownServiceErr, errors.New("malformed-secIP error")
 		}
 		if tk.IsNetIPv4(serv.ServIP) && tk.IsNetIPv6(k.SecIP) {
-			return RuleUnknownServiceErr, errors.New("malformed-secIP nat46 error")
+			return nil, RuleUnknownServiceErr, errors.New("malformed-secIP nat46 error")
 		}
 		sip := ruleNatSIP{pNetAddr}
 		nSecIP = append(nSecIP, sip)
@@ -1460,7 +1869,7 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 
 	if serv.Mode == cmn.LBModeHostOneArm && !sNetAddr.IP.IsUnspecified() {
 		tk.LogIt(tk.LogInfo, "nat lb-rule %s-%v-%s hostarm needs unspec VIP\n", serv.ServIP, serv.ServPort, serv.Proto)
-		return RuleArgsErr, errors.New("hostarm-args error")
+		return nil, RuleArgsErr, errors.New("hostarm-args error")
 	}
 
 	natActs.sel = serv.Sel
@@ -1474,19 +1883,23 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 		pNetAddr := net.ParseIP(k.EpIP)
 		xNetAddr := net.IPv4(0, 0, 0, 0)
 		if pNetAddr == nil {
-			return RuleUnknownEpErr, errors.New("malformed-lbep error")
+			return nil, RuleUnknownEpErr, errors.New("malformed-lbep error")
 		}
 		if tk.IsNetIPv4(serv.ServIP) && tk.IsNetIPv6(k.EpIP) {
-			return RuleUnknownServiceErr, errors.New("malformed-service nat46 error")
+			return nil, RuleUnknownServiceErr, errors.New("malformed-service nat46 error")
 		}
 		if serv.Proto == "icmp" && k.EpPort != 0 {
-			return RuleUnknownServiceErr, errors.New("malformed-service error")
+			return nil, RuleUnknownServiceErr, errors.New("malformed-service error")
 		}
 
-		if natActs.mode == cmn.LBModeDSR && k.EpPort != serv.ServPort {
-			return RuleUnknownServiceErr, errors.New("malformed-service dsr-port error")
+		if natActs.mode == cmn.LBModeDSR && k.EpPort != serv.ServPort && (pMax == pMin || k.EpPort != 0) {
+			return nil, RuleUnknownServiceErr, errors.New("malformed-service dsr-port error")
 		}
-		ep := ruleNatEp{pNetAddr, xNetAddr, k.EpPort, k.Weight, 0, false, false, false, ruleStat{0, 0}, nil, ""}
+		// End-points of a port range are mapped with the offset of the service port
+		if pMax > pMin && k.EpPort != 0 && int(k.EpPort)+int(pMax-pMin) > 0xffff {
+			return nil, RuleUnknownEpErr, errors.New("malformed-lbep-portrange error")
+		}
+		ep := ruleNatEp{xIP: pNetAddr, rIP: xNetAddr, xPort: k.EpPort, weight: k.Weight}
 		natActs.endPoints = append(natActs.endPoints, ep)
 	}
 
@@ -1496,24 +1909,73 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 		return a < b
 	})
 
+	return &natLbArgs{serv: serv, sNetAddr: sNetAddr, ipProto: ipProto, pMin: pMin, pMax: pMax,
+		httpOpts: httpOpts, od: od, limits: limits, srcRngs: srcRngs, ss: ss, nSecIP: nSecIP, natActs: natActs,
+		activateProbe: activateProbe}, 0, nil
+}
+
+// AddNatLbRule - Add a service LB nat rule. The service details are passed in serv argument,
+// and end-point information is passed in the slice servEndPoints. On success,
+// it will return 0 and nil error, else appropriate return code and error string will be set
+func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, servEndPoints []cmn.LbEndPointArg) (int, error) {
+	args, ret, err := natLbRuleArgs(serv, servSecIPs, servEndPoints)
+	if err != nil {
+		return ret, err
+	}
+	serv = args.serv
+	sNetAddr, ipProto, pMin, pMax := args.sNetAddr, args.ipProto, args.pMin, args.pMax
+	httpOpts, od, limits, srcRngs, ss := args.httpOpts, args.od, args.limits, args.srcRngs, args.ss
+	nSecIP, natActs, activateProbe := args.nSecIP, args.natActs, args.activateProbe
+
 	l4prot := rule8Tuple{ipProto, 0xff}
 	l3dst := ruleIPTuple{*sNetAddr}
-	l4dst := rule16Tuple{serv.ServPort, 0xffff}
+	l4dst := lbServPortTuple(serv)
 	rt := ruleTuples{l3Dst: l3dst, l4Prot: l4prot, l4Dst: l4dst, pref: serv.BlockNum, path: serv.HostUrl}
 
 	eRule := R.tables[RtLB].eMap[rt.ruleKey()]
 
+	prb := ruleProbe{prbType: serv.ProbeType, prbPort: serv.ProbePort, prbReq: serv.ProbeReq,
+		prbResp: serv.ProbeResp, prbHTTP: httpOpts}
+	if !serv.Snat && serv.Oper != cmn.LBOPDetach {
+		if err := R.epHostProbeCheck(eRule, prb, ipProto, natActs.endPoints); err != nil {
+			tk.LogIt(tk.LogError, "nat lb-rule %s-%v-%s: %s\n", serv.ServIP, serv.ServPort, serv.Proto, err)
+			return RuleArgsErr, err
+		}
+	}
+
 	if eRule != nil {
 		if !reflect.DeepEqual(eRule.secIP, nSecIP) {
 			return RuleUnknownServiceErr, errors.New("secIP modify error")
 		}
+		// Keep note of active end-points to find out the ones which need slow-start
+		actEps := make(map[string]struct{})
+		for _, ep := range eRule.act.action.(*ruleNatActs).endPoints {
+			if !ep.inActive {
+				actEps[fmt.Sprintf("%s:%d", ep.xIP.String(), ep.xPort)] = struct{}{}
+			}
+		}
+
 		// If a NAT rule already exists, we try not reschuffle the order of the end-points.
 		// We will try to append the new end-points at the end, while marking any other end-points
 		// not in the new list as inactive
-		ruleChg, retEps := getLBArms(eRule.act.action.(*ruleNatActs).endPoints, natActs.endPoints, serv.Oper)
+		// End-points which hold an end-point host reference as of now
+		var refEps []ruleNatEp
+		for _, ep := range eRule.act.action.(*ruleNatActs).endPoints {
+			if !ep.inActive {
+				refEps = append(refEps, ep)
+			}
+		}
 
-		if eRule.hChk.prbType != serv.ProbeType || eRule.hChk.prbPort != serv.ProbePort ||
-			eRule.hChk.prbReq != serv.ProbeReq || eRule.hChk.prbResp != serv.ProbeResp ||
+		ruleChg, retEps := getLBArms(eRule.act.action.(*ruleNatActs).endPoints, natActs.endPoints, serv.Oper, serv.DrainTimeout)
+
+		prbChg := !eRule.hChk.sameProbe(prb)
+		if prbChg ||
+			eRule.hChk.prbRetries != serv.ProbeRetries || eRule.hChk.prbTimeo != serv.ProbeTimeout ||
+			eRule.hChk.prbActTry != serv.ProbeActRetries || eRule.hChk.prbJitter != serv.ProbeJitter ||
+			eRule.hChk.prbIntv != serv.ProbeInterval ||
+			eRule.od.failRatio != od.failRatio || eRule.od.ejectTime != od.ejectTime ||
+			eRule.od.maxEjectPct != od.maxEjectPct || eRule.ss != ss || eRule.drainTO != serv.DrainTimeout ||
+			!reflect.DeepEqual(eRule.srcRngs, srcRngs) || eRule.limits != limits ||
 			eRule.pTO != serv.PersistTimeout || eRule.act.action.(*ruleNatActs).sel != natActs.sel ||
 			eRule.act.action.(*ruleNatActs).mode != natActs.mode {
 			ruleChg = true
@@ -1528,11 +1990,29 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 			return R.DeleteNatLbRule(serv)
 		}
 
+		if len(retEps) > MaxNatEndPoints {
+			return RuleEpCountErr, errors.New("endpoints-range error")
+		}
+
 		if eRule.act.action.(*ruleNatActs).mode == cmn.LBModeFullProxy && natActs.mode != cmn.LBModeFullProxy ||
 			eRule.act.action.(*ruleNatActs).mode != cmn.LBModeFullProxy && natActs.mode == cmn.LBModeFullProxy {
 			return RuleExistsErr, errors.New("lbrule-exist error: cant modify fullproxy rule mode")
 		}
 
+		if !reflect.DeepEqual(eRule.srcRngs, srcRngs) {
+			oRngs := eRule.srcRngs
+			R.srcRangeFwDel(eRule)
+			eRule.srcRngs = srcRngs
+			if err := R.srcRangeFwAdd(eRule); err != nil {
+				tk.LogIt(tk.LogError, "nat lb-rule - %s srcranges error: %s\n", eRule.tuples.String(), err)
+				eRule.srcRngs = oRngs
+				if err := R.srcRangeFwAdd(eRule); err != nil {
+					tk.LogIt(tk.LogError, "nat lb-rule - %s srcranges restore error: %s\n", eRule.tuples.String(), err)
+				}
+				return RuleArgsErr, errors.New("rule-srcranges error")
+			}
+		}
+
 		if eRule.act.action.(*ruleNatActs).mode == cmn.LBModeFullProxy {
 			eRule.DP(DpRemove)
 		}
@@ -1542,8 +2022,31 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 		eRule.hChk.prbPort = serv.ProbePort
 		eRule.hChk.prbReq = serv.ProbeReq
 		eRule.hChk.prbResp = serv.ProbeResp
+		eRule.hChk.prbHTTP = httpOpts
 		eRule.hChk.prbRetries = serv.ProbeRetries
 		eRule.hChk.prbTimeo = serv.ProbeTimeout
+		eRule.hChk.prbActTry = serv.ProbeActRetries
+		eRule.hChk.prbJitter = serv.ProbeJitter
+		eRule.hChk.prbIntv = serv.ProbeInterval
+		if od.failRatio == 0 {
+			R.outlierReset(retEps)
+		}
+		od.sT = eRule.od.sT
+		if od.failRatio != 0 {
+			od.ctSeen = eRule.od.ctSeen
+		}
+		eRule.od = od
+		eRule.ss = ss
+		eRule.drainTO = serv.DrainTimeout
+		eRule.limits = limits
+		for i := range retEps {
+			ep := &retEps[i]
+			if _, found := actEps[fmt.Sprintf("%s:%d", ep.xIP.String(), ep.xPort)]; !found && !ep.inActive {
+				eRule.slowStartBegin(ep)
+			} else if ss.window == 0 {
+				ep.ssStart = time.Time{}
+			}
+		}
 		eRule.pTO = serv.PersistTimeout
 		eRule.act.action.(*ruleNatActs).sel = natActs.sel
 		eRule.act.action.(*ruleNatActs).endPoints = retEps
@@ -1552,7 +2055,17 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 		// eRule.managed = serv.Managed
 
 		if !serv.Snat {
-			R.modNatEpHost(eRule, retEps, true, activateProbe)
+			if prbChg {
+				// End-point hosts used only by this rule are made again with
+				// the new probe settings
+				R.modNatEpHost(eRule, refEps, false, activateProbe)
+				R.modNatEpHost(eRule, retEps, true, activateProbe)
+			} else {
+				// Take references for active end-points before dropping the old
+				// ones so that end-point hosts still in use are not deleted in between
+				R.modNatEpHost(eRule, retEps, true, activateProbe)
+				R.modNatEpHost(eRule, refEps, false, activateProbe)
+			}
 			R.electEPSrc(eRule)
 		}
 
@@ -1567,6 +2080,27 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 		return RuleNotExistsErr, errors.New("lbrule not-exists error")
 	}
 
+	// Port ranges of rules for the same service can't overlap. Port 0 stands
+	// for any port and so overlaps every other port of the service
+	oMin, oMax := pMin, pMax
+	if oMax == 0 {
+		oMax = 0xffff
+	}
+	for _, tr := range R.tables[RtLB].eMap {
+		if !tr.tuples.l3Dst.addr.IP.Equal(rt.l3Dst.addr.IP) || tr.tuples.l4Prot.val != ipProto ||
+			tr.tuples.pref != rt.pref || tr.tuples.path != rt.path {
+			continue
+		}
+		tMin, tMax := tr.tuples.l4DstPorts()
+		if tMax == 0 {
+			tMax = 0xffff
+		}
+		if oMin <= tMax && tMin <= oMax {
+			tk.LogIt(tk.LogError, "nat lb-rule %s overlaps %s\n", rt.String(), tr.tuples.String())
+			return RuleExistsErr, errors.New("lbrule-portrange-overlap error")
+		}
+	}
+
 	r := new(ruleEnt)
 	r.tuples = rt
 	r.zone = R.zone
@@ -1590,9 +2124,23 @@ func (R *RuleH) AddNatLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg,
 	r.hChk.prbPort = serv.ProbePort
 	r.hChk.prbReq = serv.ProbeReq
 	r.hChk.prbResp = serv.ProbeResp
+	r.hChk.prbHTTP = httpOpts
 	r.hChk.prbRetries = serv.ProbeRetries
 	r.hChk.prbTimeo = serv.ProbeTimeout
+	r.hChk.prbActTry = serv.ProbeActRetries
+	r.hChk.prbJitter = serv.ProbeJitter
+	r.hChk.prbIntv = serv.ProbeInterval
 	r.hChk.actChk = serv.Monitor
+	r.od = od
+	r.od.sT = time.Now()
+	r.ss = ss
+	r.drainTO = serv.DrainTimeout
+	r.limits = limits
+	r.srcRngs = srcRngs
+	if err := R.srcRangeFwAdd(r); err != nil {
+		tk.LogIt(tk.LogError, "nat lb-rule - %s srcranges error: %s\n", r.tuples.String(), err)
+		return RuleArgsErr, errors.New("rule-srcranges error")
+	}
 
 	r.act.action = &natActs
 	r.ruleNum, err = R.tables[RtLB].Mark.GetCounter()
@@ -1709,10 +2257,22 @@ func (R *RuleH) DeleteNatLbRule(serv cmn.LbServiceArg) (int, error) {
 
 	l4prot := rule8Tuple{ipProto, 0xff}
 	l3dst := ruleIPTuple{*sNetAddr}
-	l4dst := rule16Tuple{serv.ServPort, 0xffff}
+	l4dst := lbServPortTuple(serv)
 	rt := ruleTuples{l3Dst: l3dst, l4Prot: l4prot, l4Dst: l4dst, pref: serv.BlockNum, path: serv.HostUrl}
 
 	rule := R.tables[RtLB].eMap[rt.ruleKey()]
+	if rule == nil && serv.ServPortMax == 0 && serv.ServPortMask == 0 {
+		// A port range rule can also be referred to by its first port
+		for _, tr := range R.tables[RtLB].eMap {
+			if tMin, tMax := tr.tuples.l4DstPorts(); tMax > tMin && tMin == serv.ServPort &&
+				tr.tuples.l3Dst.addr.IP.Equal(rt.l3Dst.addr.IP) && tr.tuples.l4Prot.val == ipProto &&
+				tr.tuples.pref == rt.pref && tr.tuples.path == rt.path {
+				rule = tr
+				rt = tr.tuples
+				break
+			}
+		}
+	}
 	if rule == nil {
 		return RuleNotExistsErr, errors.New("no-rule error")
 	}
@@ -1735,6 +2295,7 @@ func (R *RuleH) DeleteNatLbRule(serv cmn.LbServiceArg) (int, error) {
 	}
 
 	R.deleteVIPSys(rule)
+	R.srcRangeFwDel(rule)
 
 	tk.LogIt(tk.LogDebug, "nat lb-rule deleted %s-%s\n", rule.tuples.String(), rule.act.String())
 
@@ -1743,119 +2304,337 @@ func (R *RuleH) DeleteNatLbRule(serv cmn.LbServiceArg) (int, error) {
 	return 0, nil
 }
 
-// GetFwRule - get all Fwrules and pack them into a cmn.FwRuleMod slice
-func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
-	var res []cmn.FwRuleMod
+// srcRangeExclude - Get the prefixes which together cover the address space of
+// a family except the given source ranges
+func srcRangeExclude(srcRngs []string, v4 bool) []*net.IPNet {
+	var rngs []*net.IPNet
+	var excl []*net.IPNet
 
-	for _, data := range R.tables[RtFw].eMap {
-		var ret cmn.FwRuleMod
-		// Make Fw Arguments
-		ret.Rule.DstIP = data.tuples.l3Dst.addr.String()
-		ret.Rule.SrcIP = data.tuples.l3Src.addr.String()
-		if data.tuples.l4Dst.valid == 0xffff {
-			ret.Rule.DstPortMin = data.tuples.l4Dst.val
-		} else {
-			ret.Rule.DstPortMin = data.tuples.l4Dst.valid
+	for _, sr := range srcRngs {
+		_, srNet, err := net.ParseCIDR(sr)
+		if err != nil || (srNet.IP.To4() != nil) != v4 {
+			continue
 		}
-		ret.Rule.DstPortMax = data.tuples.l4Dst.val
-		if data.tuples.l4Src.valid == 0xffff {
-			ret.Rule.SrcPortMin = data.tuples.l4Src.val
-		} else {
-			ret.Rule.SrcPortMin = data.tuples.l4Src.valid
-		}
-
-		ret.Rule.SrcPortMax = data.tuples.l4Src.val
-		ret.Rule.Proto = data.tuples.l4Prot.val
-		ret.Rule.InPort = data.tuples.port.val
-		ret.Rule.Pref = data.tuples.pref
-
-		// Make Fw Opts
-		fwOpts := data.act.action.(*ruleFwOpts)
-		if fwOpts.op == RtActFwd {
-			ret.Opts.Allow = true
-		} else if fwOpts.op == RtActDrop {
-			ret.Opts.Drop = true
-		} else if fwOpts.op == RtActRedirect {
-			ret.Opts.Rdr = true
-			ret.Opts.RdrPort = fwOpts.opt.rdrPort
-		} else if fwOpts.op == RtActTrap {
-			ret.Opts.Trap = true
-		} else if fwOpts.op == RtActSnat {
-			ret.Opts.DoSnat = true
-			ret.Opts.ToIP = fwOpts.opt.snatIP
-			ret.Opts.ToPort = uint16(fwOpts.opt.snatPort)
-		}
-		if fwOpts.op != RtActSnat {
-			ret.Opts.Mark = fwOpts.opt.fwMark
-		}
-		ret.Opts.Record = fwOpts.opt.record
+		rngs = append(rngs, srNet)
+	}
 
-		data.Fw2DP(DpStatsGetImm)
-		ret.Opts.Counter = fmt.Sprintf("%v:%v", data.stat.packets, data.stat.bytes)
+	_, all, _ := net.ParseCIDR("::/0")
+	if v4 {
+		_, all, _ = net.ParseCIDR("0.0.0.0/0")
+	}
 
-		// Make FwRule
-		res = append(res, ret)
+	var split func(p *net.IPNet)
+	split = func(p *net.IPNet) {
+		pLen, bits := p.Mask.Size()
+		overlap := false
+		for _, rng := range rngs {
+			rLen, _ := rng.Mask.Size()
+			if rLen <= pLen && rng.Contains(p.IP) {
+				return
+			}
+			if p.Contains(rng.IP) {
+				overlap = true
+			}
+		}
+		if !overlap {
+			excl = append(excl, p)
+			return
+		}
+		lo := &net.IPNet{IP: append(net.IP(nil), p.IP...), Mask: net.CIDRMask(pLen+1, bits)}
+		hi := &net.IPNet{IP: append(net.IP(nil), p.IP...), Mask: net.CIDRMask(pLen+1, bits)}
+		hi.IP[pLen/8] |= 0x80 >> (pLen % 8)
+		split(lo)
+		split(hi)
 	}
+	split(all)
 
-	return res, nil
+	return excl
 }
 
-// AddFwRule - Add a firewall rule. The rule details are passed in fwRule argument
-// it will return 0 and nil error, else appropriate return code and error string will be set
-func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, error) {
-	var fwOpts ruleFwOpts
-	var l4src rule16Tuple
-	var l4dst rule16Tuple
-	var l4prot rule8Tuple
+// srcRangeFwRules - Get the firewall rules which enforce source ranges of a lb rule.
+// Traffic to a service VIP from outside its source ranges is dropped by rules
+// covering the rest of the address space. Sources in the ranges match none of
+// these rules and fall through to the user firewall rules, so the ranges only
+// narrow what the firewall already allows. These rules use the reserved preference,
+// so they are hidden from and can't be changed by users. They are keyed on the
+// lb rule (see srcRangeFwOwner) and so each lb rule has its own set even if
+// another one has the same VIP and port with a different block. The firewall
+// can't tell such blocks apart, so a source has to be in the ranges of each of
+// them to get through. Every excluded prefix of every VIP takes up a firewall
+// entry out of RtMaximumFw4s, up to MaxLbSrcRangeFws per lb rule
+func (r *ruleEnt) srcRangeFwRules() []cmn.FwRuleMod {
+	var fwRules []cmn.FwRuleMod
+
+	if len(r.srcRngs) == 0 {
+		return nil
+	}
 
-	// Validate rule args
-	_, dNetAddr, err := net.ParseCIDR(fwRule.DstIP)
-	if err != nil {
-		return RuleTupleErr, errors.New("malformed-rule error")
+	vips := []net.IP{r.tuples.l3Dst.addr.IP}
+	for _, sip := range r.secIP {
+		vips = append(vips, sip.sIP)
 	}
 
-	_, sNetAddr, err := net.ParseCIDR(fwRule.SrcIP)
-	if err != nil {
-		return RuleTupleErr, errors.New("malformed-rule error")
+	for _, vip := range vips {
+		v4 := tk.IsNetIPv4(vip.String())
+		dst := vip.String() + "/32"
+		if !v4 {
+			dst = vip.String() + "/128"
+		}
+		pMin, pMax := r.tuples.l4DstPorts()
+		fwArg := cmn.FwRuleArg{DstIP: dst, DstPortMin: pMin, DstPortMax: pMax, Proto: r.tuples.l4Prot.val,
+			Pref: LbSrcRangeDropPref}
+		for _, excl := range srcRangeExclude(r.srcRngs, v4) {
+			fwArg.SrcIP = excl.String()
+			fwRules = append(fwRules, cmn.FwRuleMod{Rule: fwArg, Opts: cmn.FwOptArg{Drop: true}})
+		}
 	}
 
-	l3dst := ruleIPTuple{*dNetAddr}
-	l3src := ruleIPTuple{*sNetAddr}
+	return fwRules
+}
 
-	if fwRule.Proto == 0 {
-		l4prot = rule8Tuple{0, 0}
-	} else {
-		l4prot = rule8Tuple{fwRule.Proto, 0xff}
-	}
+// srcRangeFwOwner - Get the owner key of the firewall rules enforcing source
+// ranges of a lb rule
+func (r *ruleEnt) srcRangeFwOwner() string {
+	return "lb:" + r.tuples.ruleKey()
+}
 
-	if fwRule.SrcPortMax == fwRule.SrcPortMin {
-		if fwRule.SrcPortMin == 0 {
-			l4src = rule16Tuple{0, 0}
-		} else {
-			l4src = rule16Tuple{fwRule.SrcPortMin, 0xffff}
+// srcRangeFwAdd - Add the firewall rules enforcing source ranges of a lb rule
+func (R *RuleH) srcRangeFwAdd(r *ruleEnt) error {
+	for _, fw := range r.srcRangeFwRules() {
+		_, err := R.addFwRule(fw.Rule, fw.Opts, r.srcRangeFwOwner())
+		if err != nil {
+			R.srcRangeFwDel(r)
+			return err
 		}
-	} else {
-		l4src = rule16Tuple{fwRule.SrcPortMax, fwRule.SrcPortMin}
+		r.srcFws = append(r.srcFws, fw)
 	}
-	if fwRule.DstPortMax == fwRule.DstPortMin {
-		if fwRule.DstPortMin == 0 {
-			l4dst = rule16Tuple{0, 0}
-		} else {
-			l4dst = rule16Tuple{fwRule.DstPortMin, 0xffff}
-		}
-	} else {
-		l4dst = rule16Tuple{fwRule.DstPortMax, fwRule.DstPortMin}
+	return nil
+}
+
+// srcRangeFwDel - Delete the firewall rules enforcing source ranges of a lb rule
+func (R *RuleH) srcRangeFwDel(r *ruleEnt) {
+	for _, fw := range r.srcFws {
+		R.deleteFwRule(fw.Rule, r.srcRangeFwOwner())
 	}
-	inport := ruleStringTuple{fwRule.InPort}
-	rt := ruleTuples{l3Src: l3src, l3Dst: l3dst, l4Prot: l4prot,
-		l4Src: l4src, l4Dst: l4dst, port: inport, pref: fwRule.Pref}
+	r.srcFws = nil
+}
 
-	eFw := R.tables[RtFw].eMap[rt.ruleKey()]
+// fwLbOwned - Check if a firewall rule was created to enforce source ranges
+// of a lb rule. Such rules come and go along with the lb rule itself
+func (r *ruleEnt) fwLbOwned() bool {
+	return r.tuples.pref == LbSrcRangeDropPref
+}
 
-	if eFw != nil {
-		// If a FW rule already exists
-		return RuleExistsErr, errors.New("fwrule-exists error")
-	}
+// srcRangeDrops - Get the packets and bytes dropped due to source ranges of a lb rule
+func (R *RuleH) srcRangeDrops(r *ruleEnt) (uint64, uint64) {
+	var packets, bytes uint64
+
+	for _, fw := range r.srcFws {
+		if !fw.Opts.Drop {
+			continue
+		}
+		rt, err := fwRuleTuples(fw.Rule)
+		if err != nil {
+			continue
+		}
+		rt.owner = r.srcRangeFwOwner()
+		if fr := R.tables[RtFw].eMap[rt.ruleKey()]; fr != nil {
+			fr.Fw2DP(DpStatsGetImm)
+			packets += fr.stat.packets
+			bytes += fr.stat.bytes
+		}
+	}
+	return packets, bytes
+}
+
+// GetFwRule - get all Fwrules and pack them into a cmn.FwRuleMod slice
+func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
+	var res []cmn.FwRuleMod
+
+	for _, data := range R.tables[RtFw].eMap {
+		if data.fwLbOwned() {
+			continue
+		}
+		ret := data.fwRuleMod()
+
+		data.Fw2DP(DpStatsGetImm)
+		ret.Opts.Counter = fmt.Sprintf("%v:%v", data.stat.packets, data.stat.bytes)
+
+		// Make FwRule
+		res = append(res, ret)
+	}
+
+	return res, nil
+}
+
+// fwRuleMod - Get the arguments of a firewall rule as a cmn.FwRuleMod
+func (r *ruleEnt) fwRuleMod() cmn.FwRuleMod {
+	var ret cmn.FwRuleMod
+
+	// Make Fw Arguments
+	ret.Rule.DstIP = r.tuples.l3Dst.addr.String()
+	if r.tuples.l3DstSet.val != "" {
+		ret.Rule.DstIP = r.tuples.l3DstSet.val
+	}
+	ret.Rule.SrcIP = r.tuples.l3Src.addr.String()
+	if r.tuples.l3SrcSet.val != "" {
+		ret.Rule.SrcIP = r.tuples.l3SrcSet.val
+	}
+	if r.tuples.l4Dst.valid == 0xffff {
+		ret.Rule.DstPortMin = r.tuples.l4Dst.val
+	} else {
+		ret.Rule.DstPortMin = r.tuples.l4Dst.valid
+	}
+	ret.Rule.DstPortMax = r.tuples.l4Dst.val
+	if r.tuples.l4Src.valid == 0xffff {
+		ret.Rule.SrcPortMin = r.tuples.l4Src.val
+	} else {
+		ret.Rule.SrcPortMin = r.tuples.l4Src.valid
+	}
+
+	ret.Rule.SrcPortMax = r.tuples.l4Src.val
+	ret.Rule.Proto = r.tuples.l4Prot.val
+	ret.Rule.InPort = r.tuples.port.val
+	ret.Rule.Pref = r.tuples.pref
+
+	// Make Fw Opts
+	fwOpts := r.act.action.(*ruleFwOpts)
+	if fwOpts.op == RtActFwd {
+		ret.Opts.Allow = true
+	} else if fwOpts.op == RtActDrop {
+		ret.Opts.Drop = true
+	} else if fwOpts.op == RtActRedirect {
+		ret.Opts.Rdr = true
+		ret.Opts.RdrPort = fwOpts.opt.rdrPort
+	} else if fwOpts.op == RtActTrap {
+		ret.Opts.Trap = true
+	} else if fwOpts.op == RtActSnat {
+		ret.Opts.DoSnat = true
+		ret.Opts.ToIP = fwOpts.opt.snatIP
+		ret.Opts.ToPort = uint16(fwOpts.opt.snatPort)
+	}
+	if fwOpts.op != RtActSnat {
+		ret.Opts.Mark = fwOpts.opt.fwMark
+	}
+	ret.Opts.Record = fwOpts.opt.record
+	if !fwOpts.opt.expiry.IsZero() {
+		ret.Opts.Expiry = fwOpts.opt.expiry.Format(time.RFC3339)
+		if left := time.Until(fwOpts.opt.expiry); left > 0 {
+			ret.Opts.Remaining = uint32(left.Seconds())
+		}
+	}
+	if fwOpts.opt.sched != nil {
+		ret.Opts.Schedule = fwOpts.opt.sched.spec
+		ret.Opts.SchedState = cmn.FwSchedActive
+		if fwOpts.opt.schedOff {
+			ret.Opts.SchedState = cmn.FwSchedInactive
+		}
+	}
+
+	return ret
+}
+
+// fwRuleTuples - Get the rule tuples of a firewall rule from its arguments
+func fwRuleTuples(fwRule cmn.FwRuleArg) (ruleTuples, error) {
+	var l4src rule16Tuple
+	var l4dst rule16Tuple
+	var l4prot rule8Tuple
+
+	var l3dst, l3src ruleIPTuple
+	var dstSet, srcSet ruleStringTuple
+
+	// An ip set can be used in place of a CIDR
+	if _, dNetAddr, err := net.ParseCIDR(fwRule.DstIP); err == nil {
+		l3dst = ruleIPTuple{*dNetAddr}
+	} else if ipSetNameValid(fwRule.DstIP) {
+		dstSet = ruleStringTuple{fwRule.DstIP}
+	} else {
+		return ruleTuples{}, errors.New("malformed-rule error")
+	}
+
+	if _, sNetAddr, err := net.ParseCIDR(fwRule.SrcIP); err == nil {
+		l3src = ruleIPTuple{*sNetAddr}
+	} else if ipSetNameValid(fwRule.SrcIP) {
+		srcSet = ruleStringTuple{fwRule.SrcIP}
+	} else {
+		return ruleTuples{}, errors.New("malformed-rule error")
+	}
+
+	if fwRule.Proto == 0 {
+		l4prot = rule8Tuple{0, 0}
+	} else {
+		l4prot = rule8Tuple{fwRule.Proto, 0xff}
+	}
+
+	if fwRule.SrcPortMax == fwRule.SrcPortMin {
+		if fwRule.SrcPortMin == 0 {
+			l4src = rule16Tuple{0, 0}
+		} else {
+			l4src = rule16Tuple{fwRule.SrcPortMin, 0xffff}
+		}
+	} else {
+		l4src = rule16Tuple{fwRule.SrcPortMax, fwRule.SrcPortMin}
+	}
+	if fwRule.DstPortMax == fwRule.DstPortMin {
+		if fwRule.DstPortMin == 0 {
+			l4dst = rule16Tuple{0, 0}
+		} else {
+			l4dst = rule16Tuple{fwRule.DstPortMin, 0xffff}
+		}
+	} else {
+		l4dst = rule16Tuple{fwRule.DstPortMax, fwRule.DstPortMin}
+	}
+
+	inport := ruleStringTuple{fwRule.InPort}
+	rt := ruleTuples{l3Src: l3src, l3Dst: l3dst, l3SrcSet: srcSet, l3DstSet: dstSet,
+		l4Prot: l4prot, l4Src: l4src, l4Dst: l4dst, port: inport, pref: fwRule.Pref}
+
+	return rt, nil
+}
+
+// AddFwRule - Add a firewall rule. The rule details are passed in fwRule argument
+// it will return 0 and nil error, else appropriate return code and error string will be set
+func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, error) {
+	if fwRule.Pref == LbSrcRangeDropPref {
+		return RuleArgsErr, errors.New("fwrule-pref error: reserved")
+	}
+	return R.addFwRule(fwRule, fwOptArgs, "")
+}
+
+// addFwRule - Add a firewall rule including the ones with reserved preferences.
+// owner, if set, keys the rule to what it was made for apart from the same
+// rule made for others
+func (R *RuleH) addFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg, owner string) (int, error) {
+	var fwOpts ruleFwOpts
+
+	// Validate rule args
+	rt, err := fwRuleTuples(fwRule)
+	if err != nil {
+		return RuleTupleErr, err
+	}
+	rt.owner = owner
+
+	eFw := R.tables[RtFw].eMap[rt.ruleKey()]
+
+	if eFw != nil {
+		// If a FW rule already exists
+		return RuleExistsErr, errors.New("fwrule-exists error")
+	}
+
+	for _, set := range []string{rt.l3SrcSet.val, rt.l3DstSet.val} {
+		if set == "" {
+			continue
+		}
+		if _, found := R.zone.IPSets.IPSetFind(set); !found {
+			return RuleArgsErr, errors.New("fwrule-ipset error")
+		}
+	}
+
+	// eBPF dp has an entry per member of the ip sets of a rule
+	if mh.dpEbpf != nil && (rt.l3SrcSet.val != "" || rt.l3DstSet.val != "") &&
+		R.zone.IPSets.ebpfFws(rt.l3SrcSet.val, rt.l3DstSet.val, "", nil) > IPSetMaxFwsEbpf {
+		return RuleArgsErr, errors.New("fwrule-ipset error: too many members for ebpf dp")
+	}
 
 	r := new(ruleEnt)
 	r.tuples = rt
@@ -1894,6 +2673,29 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 		}
 	}
 
+	now := time.Now()
+	if fwOptArgs.TTL != 0 && fwOptArgs.Expiry != "" {
+		return RuleArgsErr, errors.New("malformed-args ttl and expiry error")
+	} else if fwOptArgs.TTL != 0 {
+		fwOpts.opt.expiry = now.Add(time.Duration(fwOptArgs.TTL) * time.Second)
+	} else if fwOptArgs.Expiry != "" {
+		fwOpts.opt.expiry, err = time.Parse(time.RFC3339, fwOptArgs.Expiry)
+		if err != nil {
+			return RuleArgsErr, errors.New("malformed-args expiry error")
+		}
+		if !fwOpts.opt.expiry.After(now) {
+			return RuleArgsErr, errors.New("fwrule-expired error")
+		}
+	}
+
+	if fwOptArgs.Schedule != "" {
+		fwOpts.opt.sched, err = fwScheduleParse(fwOptArgs.Schedule)
+		if err != nil {
+			return RuleArgsErr, err
+		}
+		fwOpts.opt.schedOff = !fwOpts.opt.sched.active(now)
+	}
+
 	r.act.action = &fwOpts
 	r.ruleNum, err = R.tables[RtFw].Mark.GetCounter()
 	if err != nil {
@@ -1931,7 +2733,10 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 
 	R.tables[RtFw].eMap[rt.ruleKey()] = r
 
-	r.Fw2DP(DpCreate)
+	// A scheduled rule goes to the datapath only in its active window
+	if !fwOpts.opt.schedOff {
+		r.Fw2DP(DpCreate)
+	}
 
 	return 0, nil
 }
@@ -1940,50 +2745,20 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 // On success, it will return 0 and nil error, else appropriate return code and
 // error string will be set
 func (R *RuleH) DeleteFwRule(fwRule cmn.FwRuleArg) (int, error) {
-	var l4src rule16Tuple
-	var l4dst rule16Tuple
-	var l4prot rule8Tuple
-
-	// Vaildate rule args
-	_, dNetAddr, err := net.ParseCIDR(fwRule.DstIP)
-	if err != nil {
-		return RuleTupleErr, errors.New("malformed-rule error")
+	if fwRule.Pref == LbSrcRangeDropPref {
+		return RuleArgsErr, errors.New("fwrule-pref error: reserved")
 	}
+	return R.deleteFwRule(fwRule, "")
+}
 
-	_, sNetAddr, err := net.ParseCIDR(fwRule.SrcIP)
+// deleteFwRule - Delete a firewall rule including the ones with reserved preferences
+func (R *RuleH) deleteFwRule(fwRule cmn.FwRuleArg, owner string) (int, error) {
+	// Vaildate rule args
+	rt, err := fwRuleTuples(fwRule)
 	if err != nil {
-		return RuleTupleErr, errors.New("malformed-rule error")
-	}
-
-	l3dst := ruleIPTuple{*dNetAddr}
-	l3src := ruleIPTuple{*sNetAddr}
-
-	if fwRule.Proto == 0 {
-		l4prot = rule8Tuple{0, 0}
-	} else {
-		l4prot = rule8Tuple{fwRule.Proto, 0xff}
-	}
-
-	if fwRule.SrcPortMax == fwRule.SrcPortMin {
-		if fwRule.SrcPortMin == 0 {
-			l4src = rule16Tuple{0, 0}
-		} else {
-			l4src = rule16Tuple{fwRule.SrcPortMin, 0xffff}
-		}
-	} else {
-		l4src = rule16Tuple{fwRule.SrcPortMax, fwRule.SrcPortMin}
-	}
-	if fwRule.DstPortMax == fwRule.DstPortMin {
-		if fwRule.DstPortMin == 0 {
-			l4dst = rule16Tuple{0, 0}
-		} else {
-			l4dst = rule16Tuple{fwRule.DstPortMin, 0xffff}
-		}
-	} else {
-		l4dst = rule16Tuple{fwRule.DstPortMax, fwRule.DstPortMin}
+		return RuleTupleErr, err
 	}
-	inport := ruleStringTuple{fwRule.InPort}
-	rt := ruleTuples{l3Src: l3src, l3Dst: l3dst, l4Prot: l4prot, l4Src: l4src, l4Dst: l4dst, port: inport, pref: fwRule.Pref}
+	rt.owner = owner
 
 	rule := R.tables[RtFw].eMap[rt.ruleKey()]
 	if rule == nil {
@@ -2024,6 +2799,45 @@ func (R *RuleH) DeleteFwRule(fwRule cmn.FwRuleArg) (int, error) {
 	return 0, nil
 }
 
+// SetFwDefaultPolicy - Set the policy of the zone for packets which match no
+// firewall rule. An empty policy is the same as cmn.FwPolicyAccept
+func (R *RuleH) SetFwDefaultPolicy(policy string) (int, error) {
+	if policy == "" {
+		policy = cmn.FwPolicyAccept
+	}
+	if policy != cmn.FwPolicyAccept && policy != cmn.FwPolicyDrop {
+		return RuleArgsErr, errors.New("fw-policy error")
+	}
+	if policy == R.FwDefaultPolicy() {
+		return 0, nil
+	}
+	R.fwPolicy = policy
+
+	nWork := new(FwDpWorkQ)
+	nWork.Work = DpRemove
+	nWork.Status = &R.fwPolSync
+	nWork.ZoneNum = R.zone.ZoneNum
+	nWork.Mark = RtFwDefaultMark
+	if policy == cmn.FwPolicyDrop {
+		nWork.Work = DpCreate
+		nWork.FwType = DpFwDrop
+	}
+	mh.dp.ToDpCh <- nWork
+
+	tk.LogIt(tk.LogInfo, "fw default policy of zone %s set to %s\n", R.zone.Name, policy)
+
+	return 0, nil
+}
+
+// FwDefaultPolicy - Get the policy of the zone for packets which match no
+// firewall rule
+func (R *RuleH) FwDefaultPolicy() string {
+	if R.fwPolicy == "" {
+		return cmn.FwPolicyAccept
+	}
+	return R.fwPolicy
+}
+
 // GetEpHosts - get all end-points and pack them into a cmn.EndPointMod slice
 func (R *RuleH) GetEpHosts() ([]cmn.EndPointMod, error) {
 	var res []cmn.EndPointMod
@@ -2039,10 +2853,19 @@ func (R *RuleH) GetEpHosts() ([]cmn.EndPointMod, error) {
 			ret.ProbeType = data.opts.probeType
 			ret.ProbeDuration = data.opts.probeDuration
 			ret.InActTries = data.opts.inActTryThr
+			ret.ActTries = data.opts.actTryThr
+			ret.ProbeJitter = data.opts.probeJitter
+			ret.ProbeTimeout = data.opts.probeTimeout
 		}
 		ret.ProbeReq = data.opts.probeReq
 		ret.ProbeResp = data.opts.probeResp
 		ret.ProbePort = data.opts.probePort
+		ret.ProbeMethod = data.opts.probeHTTP.method
+		ret.ProbeHost = data.opts.probeHTTP.host
+		ret.ProbeHeaders = data.opts.probeHTTP.headers
+		ret.ProbeRespCodes = data.opts.probeHTTP.respCodes
+		ret.ProbeRespRegex = data.opts.probeHTTP.respRegex
+		ret.ProbeCmd = data.opts.probeCmd
 		if ret.ProbeType == HostProbePing {
 			ret.MinDelay = fmt.Sprintf("%v", data.minDelay)
 			ret.AvgDelay = fmt.Sprintf("%v", data.avgDelay)
@@ -2061,6 +2884,13 @@ func (R *RuleH) GetEpHosts() ([]cmn.EndPointMod, error) {
 	return res, nil
 }
 
+// epHostAPIAdded - Check if an end-point was added over the api. Others are
+// created implicitly by lb rules and go away along with them
+func (R *RuleH) epHostAPIAdded(epKey string) bool {
+	ep := R.epMap[epKey]
+	return ep != nil && ep.apiAdded
+}
+
 // IsEPHostActive - Check if end-point is active
 func (R *RuleH) IsEPHostActive(epKey string) bool {
 	ep := R.epMap[epKey]
@@ -2071,6 +2901,133 @@ func (R *RuleH) IsEPHostActive(epKey string) bool {
 	return !ep.inactive
 }
 
+// EpEventSub - Subscribe a channel to end-point health events
+func (R *RuleH) EpEventSub(ch chan cmn.EndPointEvent) (int, error) {
+	R.epEvMx.Lock()
+	defer R.epEvMx.Unlock()
+
+	if _, found := R.epEvSubs[ch]; found {
+		return RuleExistsErr, errors.New("ep-event-sub exists error")
+	}
+	R.epEvSubs[ch] = struct{}{}
+	return 0, nil
+}
+
+// EpEventUnSub - Unsubscribe a channel from end-point health events
+func (R *RuleH) EpEventUnSub(ch chan cmn.EndPointEvent) (int, error) {
+	R.epEvMx.Lock()
+	defer R.epEvMx.Unlock()
+
+	if _, found := R.epEvSubs[ch]; !found {
+		return RuleNotExistsErr, errors.New("ep-event-sub not found error")
+	}
+	delete(R.epEvSubs, ch)
+	return 0, nil
+}
+
+// epEventNotify - Send an end-point event to all subscribers. A subscriber
+// which can not keep up loses events instead of stalling the probers
+func (R *RuleH) epEventNotify(ev cmn.EndPointEvent) {
+	R.epEvMx.RLock()
+	defer R.epEvMx.RUnlock()
+
+	for ch := range R.epEvSubs {
+		select {
+		case ch <- ev:
+		default:
+			tk.LogIt(tk.LogDebug, "ep-event %s:%s dropped\n", ev.Kind, ev.Name)
+		}
+	}
+}
+
+// FwEventSub - Subscribe a channel to firewall rule events
+func (R *RuleH) FwEventSub(ch chan cmn.FwRuleEvent) (int, error) {
+	R.fwEvMx.Lock()
+	defer R.fwEvMx.Unlock()
+
+	if _, found := R.fwEvSubs[ch]; found {
+		return RuleExistsErr, errors.New("fw-event-sub exists error")
+	}
+	R.fwEvSubs[ch] = struct{}{}
+	return 0, nil
+}
+
+// FwEventUnSub - Unsubscribe a channel from firewall rule events
+func (R *RuleH) FwEventUnSub(ch chan cmn.FwRuleEvent) (int, error) {
+	R.fwEvMx.Lock()
+	defer R.fwEvMx.Unlock()
+
+	if _, found := R.fwEvSubs[ch]; !found {
+		return RuleNotExistsErr, errors.New("fw-event-sub not found error")
+	}
+	delete(R.fwEvSubs, ch)
+	return 0, nil
+}
+
+// fwEventNotify - Send a firewall rule event to all subscribers without
+// waiting on slow ones
+func (R *RuleH) fwEventNotify(ev cmn.FwRuleEvent) {
+	R.fwEvMx.RLock()
+	defer R.fwEvMx.RUnlock()
+
+	for ch := range R.fwEvSubs {
+		select {
+		case ch <- ev:
+		default:
+			tk.LogIt(tk.LogDebug, "fw-event %s:%s dropped\n", ev.Kind, ev.Name)
+		}
+	}
+}
+
+// epEventHasSubs - Check if anyone is interested in end-point events
+func (R *RuleH) epEventHasSubs() bool {
+	R.epEvMx.RLock()
+	defer R.epEvMx.RUnlock()
+	return len(R.epEvSubs) != 0
+}
+
+// epEventRuleNames - Get the names of lb rules using an end-point
+func (R *RuleH) epEventRuleNames(ep *epHost) []string {
+	var names []string
+	for _, rule := range R.tables[RtLB].eMap {
+		na, ok := rule.act.action.(*ruleNatActs)
+		if !ok {
+			continue
+		}
+		for _, n := range na.endPoints {
+			pType, pPort := dflEPProbe(rule.tuples.l4Prot.val, n.xPort)
+			if makeEPKey(n.xIP.String(), pType, pPort) == ep.epKey {
+				names = append(names, rule.eventName())
+				break
+			}
+		}
+	}
+	return names
+}
+
+// epStateNotify - Notify subscribers that an end-point changed its state
+func (R *RuleH) epStateNotify(ep *epHost) {
+	if !R.epEventHasSubs() {
+		return
+	}
+
+	ev := cmn.EndPointEvent{Time: time.Now(), Kind: cmn.EpEventState,
+		HostName: ep.hostName, Name: ep.epKey, ProbeType: ep.opts.probeType,
+		ProbePort: ep.opts.probePort, LastDelay: ep.avgDelay.String()}
+	if ep.inactive {
+		ev.CurrState = "nok"
+	} else {
+		ev.CurrState = "ok"
+	}
+
+	// Probers run without holding any loxinet lock
+	mh.mtx.RLock()
+	ev.Rules = R.epEventRuleNames(ep)
+	mh.mtx.RUnlock()
+
+	R.epEventNotify(ev)
+}
+
 func validateEPHostOpts(hostName string, args epHostOpts) (int, error) {
 	// Validate hostopts
 	if net.ParseIP(hostName) == nil {
@@ -2078,27 +3035,85 @@ func validateEPHostOpts(hostName string, args epHostOpts) (int, error) {
 	}
 
 	if args.inActTryThr > MaxDflLbaInactiveTries ||
-		args.probeDuration > MaxHostProbeTime {
+		args.actTryThr > MaxDflLbaInactiveTries ||
+		args.probeDuration > MaxHostProbeTime ||
+		args.probeJitter > MaxHostProbeTime ||
+		args.probeTimeout > MaxHostProbeWait {
 		return RuleArgsErr, errors.New("host-args error")
 	}
 
+	if args.probeTimeout != 0 && args.probeDuration != 0 && args.probeTimeout >= args.probeDuration {
+		return RuleArgsErr, errors.New("host-args probe timeout error")
+	}
+
 	if args.probeType != HostProbePing &&
 		args.probeType != HostProbeConnectTCP &&
 		args.probeType != HostProbeConnectUDP &&
 		args.probeType != HostProbeConnectSCTP &&
 		args.probeType != HostProbeHTTP &&
 		args.probeType != HostProbeHTTPS &&
+		args.probeType != HostProbeGRPC &&
+		args.probeType != HostProbeGRPCS &&
+		args.probeType != HostProbeExec &&
 		args.probeType != HostProbeNone {
 		return RuleArgsErr, errors.New("host-args unknown probe type")
 	}
 
 	if (args.probeType == HostProbeConnectTCP ||
 		args.probeType == HostProbeConnectUDP ||
-		args.probeType == HostProbeConnectSCTP) &&
+		args.probeType == HostProbeConnectSCTP ||
+		args.probeType == HostProbeGRPC ||
+		args.probeType == HostProbeGRPCS) &&
 		args.probePort == 0 {
 		return RuleArgsErr, errors.New("host-args unknown probe port")
 	}
 
+	if (args.probeType == HostProbeExec && args.probeCmd == "") ||
+		(args.probeType != HostProbeExec && args.probeCmd != "") {
+		return RuleArgsErr, errors.New("host-args unknown probe command")
+	}
+
+	if _, err := validateHTTPProbeOpts(args.probeType, args.probeHTTP); err != nil {
+		return RuleArgsErr, err
+	}
+
+	return 0, nil
+}
+
+// validateHTTPProbeOpts - validate http(s) specific probe options
+func validateHTTPProbeOpts(probeType string, args httpProbeOpts) (int, error) {
+	if probeType != HostProbeHTTP && probeType != HostProbeHTTPS {
+		if !reflect.DeepEqual(args, httpProbeOpts{}) {
+			return RuleArgsErr, errors.New("host-args http opts for non-http probe")
+		}
+		return 0, nil
+	}
+
+	switch args.method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodOptions:
+	default:
+		return RuleArgsErr, errors.New("host-args unknown http method")
+	}
+
+	for _, hdr := range args.headers {
+		kv := strings.SplitN(hdr, ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return RuleArgsErr, errors.New("host-args malformed http header")
+		}
+	}
+
+	for _, code := range args.respCodes {
+		if code < 100 || code > 599 {
+			return RuleArgsErr, errors.New("host-args malformed http status code")
+		}
+	}
+
+	if args.respRegex != "" {
+		if _, err := regexp.Compile(args.respRegex); err != nil {
+			return RuleArgsErr, errors.New("host-args malformed http body regex")
+		}
+	}
+
 	return 0, nil
 }
 
@@ -2106,6 +3121,66 @@ func makeEPKey(hostName string, probeType string, probePort uint16) string {
 	return hostName + "_" + probeType + "_" + strconv.Itoa(int(probePort))
 }
 
+// epHostOptsFromMod - Get end-point host options from its api arguments
+func epHostOptsFromMod(em *cmn.EndPointMod) epHostOpts {
+	return epHostOpts{inActTryThr: em.InActTries, actTryThr: em.ActTries, probeType: em.ProbeType,
+		probeReq: em.ProbeReq, probeResp: em.ProbeResp,
+		probeDuration: em.ProbeDuration, probeTimeout: em.ProbeTimeout, probeJitter: em.ProbeJitter,
+		probePort: em.ProbePort,
+		probeHTTP: httpProbeOpts{method: em.ProbeMethod, host: em.ProbeHost,
+			headers: em.ProbeHeaders, respCodes: em.ProbeRespCodes, respRegex: em.ProbeRespRegex},
+		probeCmd: em.ProbeCmd,
+	}
+}
+
+// sameProbe - Check if rule level probe settings are the same
+func (p *ruleProbe) sameProbe(o ruleProbe) bool {
+	return p.prbType == o.prbType && p.prbPort == o.prbPort && p.prbReq == o.prbReq &&
+		p.prbResp == o.prbResp && reflect.DeepEqual(p.prbHTTP, o.prbHTTP)
+}
+
+// epHostProbeCheck - Check rule level probe settings against end-point hosts
+// of the rule which are in use elsewhere. An end-point host is shared by all
+// rules having the end-point and by the api and has only one set of probe
+// settings, so a rule can't ask for different ones
+func (R *RuleH) epHostProbeCheck(r *ruleEnt, prb ruleProbe, proto uint8, eps []ruleNatEp) error {
+	if prb.prbType == "" {
+		return nil
+	}
+
+	var rEps []ruleNatEp
+	if r != nil {
+		rEps = r.act.action.(*ruleNatActs).endPoints
+	}
+
+	R.epMx.RLock()
+	defer R.epMx.RUnlock()
+
+	for _, nep := range eps {
+		pType, pPort := dflEPProbe(proto, nep.xPort)
+		ep := R.epMap[makeEPKey(nep.xIP.String(), pType, pPort)]
+		if ep == nil {
+			continue
+		}
+		users := ep.ruleCount
+		for _, rep := range rEps {
+			if rep.xIP.Equal(nep.xIP) && rep.xPort == nep.xPort && !rep.inActive {
+				users--
+				break
+			}
+		}
+		if users <= 0 && !ep.apiAdded {
+			continue
+		}
+		eprb := ruleProbe{prbType: ep.opts.probeType, prbPort: ep.opts.probePort, prbReq: ep.opts.probeReq,
+			prbResp: ep.opts.probeResp, prbHTTP: ep.opts.probeHTTP}
+		if !eprb.sameProbe(prb) {
+			return fmt.Errorf("probe-args conflict error: ep %s:%d in use with other probe", nep.xIP.String(), nep.xPort)
+		}
+	}
+	return nil
+}
+
 // AddEPHost - Add an end-point host
 // name, if present will be used as endpoint key
 // It will return 0 and nil error, else appropriate return code and error string will be set
@@ -2125,8 +3200,11 @@ func (R *RuleH) AddEPHost(apiCall bool, hostName string, name string, args epHos
 		tk.LogIt(tk.LogError, "Failed to add EP :%s\n", err)
 		return RuleArgsErr, err
 	}
+	if args.probeHTTP.respRegex != "" {
+		args.probeRespRe = regexp.MustCompile(args.probeHTTP.respRegex)
+	}
 	// Load CA cert into pool
-	if args.probeType == HostProbeHTTPS {
+	if args.probeType == HostProbeHTTPS || args.probeType == HostProbeGRPCS {
 		// Check if there exist a CA certificate particularly for this EP
 		rootCACertile := cmn.CertPath + hostName + "/" + cmn.CACertFileName
 		if exists := utils.FileExists(rootCACertile); exists {
@@ -2148,11 +3226,26 @@ func (R *RuleH) AddEPHost(apiCall bool, hostName string, name string, args epHos
 	ep := R.epMap[epKey]
 	if ep != nil {
 		if apiCall {
+			ep.httpTrReset()
+			ep.apiAdded = true
 			ep.opts = args
 			ep.opts.currProbeDuration = ep.opts.probeDuration
+			ep.setJitter()
 			ep.initProberOn = true
 			return 0, nil
 		}
+		// Rule level probe timers might have been modified
+		if ep.opts.inActTryThr != args.inActTryThr || ep.opts.actTryThr != args.actTryThr ||
+			ep.opts.probeDuration != args.probeDuration || ep.opts.probeJitter != args.probeJitter ||
+			ep.opts.probeTimeout != args.probeTimeout {
+			ep.opts.inActTryThr = args.inActTryThr
+			ep.opts.actTryThr = args.actTryThr
+			ep.opts.probeDuration = args.probeDuration
+			ep.opts.probeTimeout = args.probeTimeout
+			ep.opts.probeJitter = args.probeJitter
+			ep.opts.currProbeDuration = ep.opts.probeDuration
+			ep.setJitter()
+		}
 		ep.ruleCount++
 		return 0, nil
 	}
@@ -2160,9 +3253,11 @@ func (R *RuleH) AddEPHost(apiCall bool, hostName string, name string, args epHos
 	ep = new(epHost)
 	ep.epKey = epKey
 	ep.hostName = hostName
+	ep.apiAdded = apiCall
 	ep.opts = args
 	ep.initProberOn = true
 	ep.opts.currProbeDuration = ep.opts.probeDuration
+	ep.setJitter()
 
 	if apiCall != true {
 		ep.ruleCount = 1
@@ -2207,6 +3302,7 @@ func (R *RuleH) DeleteEPHost(apiCall bool, name string, hostName string, probeTy
 		return RuleEpCountErr, errors.New("LB Rule-referred")
 	}
 
+	ep.httpTrReset()
 	delete(R.epMap, ep.epKey)
 
 	tk.LogIt(tk.LogDebug, "ep-host deleted %v\n", key)
@@ -2214,16 +3310,33 @@ func (R *RuleH) DeleteEPHost(apiCall bool, name string, hostName string, probeTy
 	return 0, nil
 }
 
-func (ep *epHost) transitionEPState(currState bool, inactThr int) {
+// setJitter - pick a random jitter to be added to next probe of the end-point
+func (ep *epHost) setJitter() {
+	ep.jitter = 0
+	if ep.opts.probeJitter > 0 {
+		ep.jitter = time.Duration(rand.Int63n(int64(ep.opts.probeJitter) * int64(time.Second)))
+	}
+}
+
+// transitionEPState - move end-point state as per probe result. An end-point
+// is marked inactive after inactThr consecutive failures and marked active
+// again after actThr consecutive successes
+func (ep *epHost) transitionEPState(currState bool, inactThr int, actThr int) {
 	if currState {
+		ep.inActTries = 0
 		if ep.inactive {
+			ep.actTries++
+			if ep.actTries < actThr {
+				return
+			}
 			ep.inactive = false
-			ep.inActTries = 0
+			ep.actTries = 0
 			ep.opts.currProbeDuration = ep.opts.probeDuration
 			tk.LogIt(tk.LogDebug, "active ep - %s:%s:%d(%v)\n",
 				ep.epKey, ep.opts.probeType, ep.opts.probePort, ep.avgDelay)
 		}
 	} else {
+		ep.actTries = 0
 		if ep.inActTries < inactThr {
 			ep.inActTries++
 			if ep.inActTries >= inactThr {
@@ -2245,13 +3358,69 @@ func (ep *epHost) transitionEPState(currState bool, inactThr int) {
 	}
 }
 
+// probeTimeout - get the timeout of each probe of an end-point. Probes are
+// run one after another by ep-helpers, so it is kept below the probe interval
+// and MaxHostProbeWait to not hold up probes of other end-points
+func (ep *epHost) probeTimeout() time.Duration {
+	timeout := ep.opts.probeTimeout
+	if timeout == 0 {
+		timeout = DflHostProbeWait
+	}
+	if timeout > MaxHostProbeWait {
+		timeout = MaxHostProbeWait
+	}
+	if ep.opts.probeDuration != 0 && timeout >= ep.opts.probeDuration {
+		timeout = (ep.opts.probeDuration + 1) / 2
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// httpTrReset - drop the transport kept for http(s) probes of an end-point
+func (ep *epHost) httpTrReset() {
+	if ep.httpTr != nil {
+		ep.httpTr.CloseIdleConnections()
+		ep.httpTr = nil
+	}
+}
+
+// httpProbeOpts - get options to be used for http(s) probe of an end-point.
+// The transport is kept across probes till the probe options change
+func (ep *epHost) httpProbeOpts(tlsConf *tls.Config) utils.HTTPProbeOpts {
+	if ep.httpTr == nil {
+		ep.httpTr = utils.HTTPProbeTransport(tlsConf, ep.opts.probeHTTP.host)
+	}
+	return utils.HTTPProbeOpts{Method: ep.opts.probeHTTP.method,
+		Host:      ep.opts.probeHTTP.host,
+		Headers:   ep.opts.probeHTTP.headers,
+		Codes:     ep.opts.probeHTTP.respCodes,
+		Resp:      ep.opts.probeResp,
+		RespRegex: ep.opts.probeRespRe,
+		Timeout:   ep.probeTimeout(),
+		Transport: ep.httpTr}
+}
+
 func (R *RuleH) epCheckNow(ep *epHost) {
 	var sType string
 	sHint := ""
 
+	// Previous exec probe of this end-point is still in flight
+	if ep.execOn.Load() {
+		return
+	}
+
+	inactive := ep.inactive
+	async := false
+	defer func() {
+		if !async && inactive != ep.inactive {
+			R.epStateNotify(ep)
+		}
+	}()
+
 	inActTryThr := ep.opts.inActTryThr
+	actTryThr := ep.opts.actTryThr
 	if ep.initProberOn {
 		inActTryThr = 1
+		actTryThr = 1
 		ep.initProberOn = false
 	}
 
@@ -2284,8 +3453,8 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 				sHint = sIP.String()
 			}
 		}
-		sOk := tk.L4ServiceProber(sType, sName, sHint, ep.opts.probeReq, ep.opts.probeResp)
-		ep.transitionEPState(sOk, inActTryThr)
+		sOk := utils.L4ServiceProber(sType, sName, sHint, ep.opts.probeReq, ep.opts.probeResp, ep.probeTimeout())
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
 	} else if ep.opts.probeType == HostProbePing {
 		pinger, err := probing.NewPinger(ep.hostName)
 		if err != nil {
@@ -2296,6 +3465,9 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		pinger.Size = 100
 		pinger.Interval = time.Duration(200000000)
 		pinger.Timeout = time.Duration(500000000)
+		if ep.opts.probeTimeout != 0 {
+			pinger.Timeout = ep.probeTimeout()
+		}
 		pinger.SetPrivileged(true)
 
 		//pinger.OnFinish = func(stats *ping.Statistics) {
@@ -2321,12 +3493,12 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 			ep.avgDelay = stats.AvgRtt
 			ep.minDelay = stats.MinRtt
 			ep.maxDelay = stats.MaxRtt
-			ep.transitionEPState(true, 1)
+			ep.transitionEPState(true, 1, actTryThr)
 		} else {
 			ep.avgDelay = time.Duration(0)
 			ep.minDelay = time.Duration(0)
 			ep.maxDelay = time.Duration(0)
-			ep.transitionEPState(false, 1)
+			ep.transitionEPState(false, 1, actTryThr)
 		}
 		pinger.Stop()
 	} else if ep.opts.probeType == HostProbeHTTP {
@@ -2337,8 +3509,8 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		}
 
 		urlStr := fmt.Sprintf("http://%s:%d/%s", addr.String(), ep.opts.probePort, ep.opts.probeReq)
-		sOk := tk.HTTPProber(urlStr)
-		ep.transitionEPState(sOk, inActTryThr)
+		sOk := utils.HTTPProberWithOpts(urlStr, nil, ep.httpProbeOpts(nil))
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
 	} else if ep.opts.probeType == HostProbeHTTPS {
 		var addr net.IP
 		if addr = net.ParseIP(ep.hostName); addr == nil {
@@ -2347,9 +3519,32 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		}
 
 		urlStr := fmt.Sprintf("https://%s:%d/%s", addr.String(), ep.opts.probePort, ep.opts.probeReq)
-		sOk := utils.HTTPSProber(urlStr, R.tlsCert, R.rootCAPool, ep.opts.probeResp)
+		tlsConf := &tls.Config{Certificates: []tls.Certificate{R.tlsCert}, RootCAs: R.rootCAPool}
+		sOk := utils.HTTPProberWithOpts(urlStr, tlsConf, ep.httpProbeOpts(tlsConf))
 		//tk.LogIt(tk.LogDebug, "[PROBE] https ep - URL[%s:%s] Resp[%s] %v\n", ep.hostName, urlStr, ep.opts.probeResp, sOk)
-		ep.transitionEPState(sOk, inActTryThr)
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
+	} else if ep.opts.probeType == HostProbeGRPC || ep.opts.probeType == HostProbeGRPCS {
+		var tlsConf *tls.Config
+		if ep.opts.probeType == HostProbeGRPCS {
+			tlsConf = &tls.Config{Certificates: []tls.Certificate{R.tlsCert}, RootCAs: R.rootCAPool}
+		}
+		// probeReq carries the optional grpc service name to check
+		sOk := utils.GRPCProber(sName, ep.opts.probeReq, tlsConf, ep.probeTimeout())
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
+	} else if ep.opts.probeType == HostProbeExec {
+		// A user command can take long to finish. So, it is run away from the
+		// ep-helper and only one instance per end-point is kept in flight
+		if !ep.execOn.CompareAndSwap(false, true) {
+			return
+		}
+		env := []string{fmt.Sprintf("LOXILB_EP_IP=%s", ep.hostName),
+			fmt.Sprintf("LOXILB_EP_PORT=%d", ep.opts.probePort)}
+		timeout := ep.probeTimeout()
+		if ep.opts.probeTimeout == 0 {
+			timeout = ExecHostProbeTimeout * time.Second
+		}
+		async = true
+		go R.epExecCheck(ep, ep.opts.probeCmd, env, timeout, inActTryThr, actTryThr)
 	} else {
 		// TODO
 		ep.inactive = false
@@ -2357,6 +3552,25 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 	}
 }
 
+// epExecCheck - run an exec type probe of an end-point and move its state as per result
+func (R *RuleH) epExecCheck(ep *epHost, cmd string, env []string, timeout time.Duration, inActTryThr, actTryThr int) {
+	defer ep.execOn.Store(false)
+
+	mh.execMx.RLock()
+	sOk := utils.ExecProber(cmd, env, timeout)
+	mh.execMx.RUnlock()
+
+	R.epMx.Lock()
+	inactive := ep.inactive
+	ep.transitionEPState(sOk, inActTryThr, actTryThr)
+	chg := inactive != ep.inactive
+	R.epMx.Unlock()
+
+	if chg {
+		R.epStateNotify(ep)
+	}
+}
+
 func epTicker(R *RuleH, helper int) {
 	epc := R.epCs[helper]
 
@@ -2397,7 +3611,7 @@ func epTicker(R *RuleH, helper int) {
 						}
 					} else {
 						if (host.initProberOn && time.Duration(t.Sub(host.sT).Seconds()) >= time.Duration(InitHostProbeTimeout)) ||
-							time.Duration(t.Sub(host.sT).Seconds()) >= time.Duration(host.opts.currProbeDuration) {
+							t.Sub(host.sT) >= time.Duration(host.opts.currProbeDuration)*time.Second+host.jitter {
 							epHosts = append(epHosts, host)
 						}
 					}
@@ -2415,6 +3629,7 @@ func epTicker(R *RuleH, helper int) {
 			for _, eph := range epHosts {
 				R.epCheckNow(eph)
 				eph.sT = time.Now()
+				eph.setJitter()
 				if time.Duration(eph.sT.Sub(begin).Seconds()) >= EndPointCheckerDuration {
 					break
 				}
@@ -2424,11 +3639,449 @@ func epTicker(R *RuleH, helper int) {
 	}
 }
 
+// currWeight - Get the weight of an end-point currently in effect
+func (ep *ruleNatEp) currWeight() uint8 {
+	if !ep.ssStart.IsZero() {
+		return ep.ssWeight
+	}
+	return ep.weight
+}
+
+// slowStartWeight - Get the weight of an end-point at a given time of its
+// slow-start window. It also returns true if the window is over
+func (r *ruleEnt) slowStartWeight(ep *ruleNatEp, now time.Time) (uint8, bool) {
+	window := time.Duration(r.ss.window) * time.Second
+	elapsed := now.Sub(ep.ssStart)
+	if elapsed >= window || ep.weight == 0 {
+		return ep.weight, true
+	}
+
+	f := float64(elapsed) / float64(window)
+	switch r.ss.curve {
+	case SlowStartQuadratic:
+		f = f * f
+	case SlowStartSqrt:
+		f = math.Sqrt(f)
+	}
+	floor := float64(ep.weight) * float64(r.ss.floor) / 100
+	w := uint8(floor + (float64(ep.weight)-floor)*f)
+	if w == 0 {
+		w = 1
+	}
+	return w, false
+}
+
+// slowStartBegin - Start ramping up the weight of a newly active end-point
+func (r *ruleEnt) slowStartBegin(ep *ruleNatEp) {
+	if r.ss.window == 0 {
+		return
+	}
+	ep.ssStart = time.Now()
+	ep.ssWeight, _ = r.slowStartWeight(ep, ep.ssStart)
+	tk.LogIt(tk.LogDebug, "nat lb-rule slow-start ep - %s:%d(w%d)\n", ep.xIP.String(), ep.xPort, ep.ssWeight)
+}
+
+// slowStartSync - Move the weights of end-points in slow-start a step ahead.
+// It returns true if any weight has changed
+func (r *ruleEnt) slowStartSync() bool {
+	rChg := false
+	na, ok := r.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+
+	now := time.Now()
+	for idx := range na.endPoints {
+		np := &na.endPoints[idx]
+		if np.ssStart.IsZero() {
+			continue
+		}
+		w, done := r.slowStartWeight(np, now)
+		if done {
+			np.ssStart = time.Time{}
+		}
+		if w != np.ssWeight || done {
+			np.ssWeight = w
+			rChg = true
+		}
+	}
+	return rChg
+}
+
+// drainStart - Stop new flows to an end-point while letting its existing
+// connections finish within the drain timeout
+func (ep *ruleNatEp) drainStart(drainTO uint32) {
+	ep.drain = true
+	ep.drainUntil = time.Now().Add(time.Duration(drainTO) * time.Second)
+	ep.drainConns = 0
+	tk.LogIt(tk.LogInfo, "nat lb-rule ep draining - %s:%d\n", ep.xIP.String(), ep.xPort)
+}
+
+// drainStop - Bring back an end-point which was being drained
+func (ep *ruleNatEp) drainStop() {
+	ep.drain = false
+	ep.drainDel = false
+	ep.drainConns = 0
+}
+
+// connCapPending - Check if connection caps of a lb rule are to be enforced
+// by the control plane i.e with dps other than userspace dp
+func (r *ruleEnt) connCapPending() bool {
+	if mh.dpUser != nil {
+		return false
+	}
+	na, ok := r.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+	if r.limits.maxConns != 0 || r.limits.maxConnEp != 0 {
+		return true
+	}
+	for _, ep := range na.endPoints {
+		if ep.connCapped {
+			return true
+		}
+	}
+	return false
+}
+
+// connCapSync - Skip end-points of a lb rule which are at their connection cap
+// for new connections, as per active connections of the end-points. All of
+// them are skipped while the rule is at its own cap. It returns true if the
+// end-points were updated
+func (R *RuleH) connCapSync(r *ruleEnt, stats map[string]*epCtStats) bool {
+	na, ok := r.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+
+	total := 0
+	for _, st := range stats {
+		total += st.active
+	}
+	full := r.limits.maxConns != 0 && total >= int(r.limits.maxConns)
+
+	chg := false
+	for i := range na.endPoints {
+		np := &na.endPoints[i]
+		capped := full
+		if !capped && r.limits.maxConnEp != 0 {
+			st := stats[fmt.Sprintf("%s:%d", np.xIP.String(), np.xPort)]
+			capped = st != nil && st.active >= int(r.limits.maxConnEp)
+		}
+		if np.connCapped != capped {
+			np.connCapped = capped
+			chg = true
+		}
+	}
+	return chg
+}
+
+// drainPending - Check if a lb rule has any end-point being drained
+func (r *ruleEnt) drainPending() bool {
+	na, ok := r.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+	for _, ep := range na.endPoints {
+		if ep.drain {
+			return true
+		}
+	}
+	return false
+}
+
+// drainSync - Update active connections of draining end-points of a lb rule and
+// retire the ones which are drained or whose drain timeout is over. Retired
+// end-points release their end-point host. It returns true if the end-points
+// of the rule have changed
+func (R *RuleH) drainSync(r *ruleEnt, stats map[string]*epCtStats) bool {
+	rChg := false
+	na, ok := r.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+
+	now := time.Now()
+	var retEps []ruleNatEp
+	var relEps []ruleNatEp
+	defer func() {
+		if len(relEps) != 0 && r.act.actType != RtActSnat {
+			R.modNatEpHost(r, relEps, false, false)
+		}
+	}()
+	for idx := range na.endPoints {
+		np := &na.endPoints[idx]
+		if np.drain {
+			np.drainConns = 0
+			if st := stats[fmt.Sprintf("%s:%d", np.xIP.String(), np.xPort)]; st != nil {
+				np.drainConns = st.active
+			}
+			if np.drainConns == 0 || now.After(np.drainUntil) {
+				tk.LogIt(tk.LogInfo, "nat lb-rule ep drained - %s:%d(%d)\n", np.xIP.String(), np.xPort, np.drainConns)
+				rChg = true
+				del := np.drainDel
+				np.drainStop()
+				if !np.inActive {
+					relEps = append(relEps, *np)
+				}
+				if del {
+					continue
+				}
+				np.inActive = true
+			}
+		}
+		retEps = append(retEps, *np)
+	}
+
+	if rChg {
+		// Retired end-points are kept around as inactive if nothing else is left
+		if len(retEps) == 0 {
+			for idx := range na.endPoints {
+				na.endPoints[idx].inActive = true
+			}
+			return rChg
+		}
+		na.endPoints = retEps
+	}
+	return rChg
+}
+
+// epCtStats - conntrack outcomes of a lb end-point
+type epCtStats struct {
+	total  int
+	fails  int
+	active int
+}
+
+// ctActEP - Get the end-point selected for a conntrack entry from its action string
+func ctActEP(cAct string) string {
+	if i := strings.Index(cAct, "-"); i >= 0 {
+		cAct = cAct[i+1:]
+	}
+	// Full-nat actions carry the source ip first
+	if i := strings.Index(cAct, ","); i >= 0 {
+		cAct = cAct[i+1:]
+	}
+	// Strip the weight
+	if i := strings.LastIndex(cAct, ":w"); i >= 0 {
+		cAct = cAct[:i]
+	}
+	return cAct
+}
+
+// ctStateFailed - Check if a conntrack state indicates a connection reset or
+// errored out by an end-point
+func ctStateFailed(cState string) bool {
+	switch cState {
+	case "h/e", "err", "abort":
+		return true
+	}
+	return false
+}
+
+// ctStateClosing - Check if a conntrack state indicates a connection being closed
+func ctStateClosing(cState string) bool {
+	switch cState {
+	case "fini", "closed-wait", "shut", "shut-ack":
+		return true
+	}
+	return false
+}
+
+// lbCtGet - Get conntrack entries of given lb rules
+func (R *RuleH) lbCtGet(rules map[uint32]bool) map[uint64][]*DpCtInfo {
+	cts := make(map[uint64][]*DpCtInfo)
+
+	nTable := new(TableDpWorkQ)
+	nTable.Work = DpMapGet
+	nTable.Name = MapNameCt4
+	nTable.Rules = rules
+
+	ret, err := mh.dp.DpWorkOnTableOp(nTable)
+	if err != nil {
+		return cts
+	}
+
+	ctMap, ok := ret.(map[string]*DpCtInfo)
+	if !ok {
+		return cts
+	}
+
+	for _, ct := range ctMap {
+		rid := uint64(ct.RuleID)
+		cts[rid] = append(cts[rid], ct)
+	}
+
+	return cts
+}
+
+// epCtActive - Get per end-point active connections from conntrack entries of a lb rule
+func epCtActive(cts []*DpCtInfo) map[string]*epCtStats {
+	stats := make(map[string]*epCtStats)
+	for _, ct := range cts {
+		epKey := ctActEP(ct.CAct)
+		st := stats[epKey]
+		if st == nil {
+			st = new(epCtStats)
+			stats[epKey] = st
+		}
+		st.total++
+		if !ctStateFailed(ct.CState) && !ctStateClosing(ct.CState) && ct.CState != "closed" {
+			st.active++
+		}
+	}
+	return stats
+}
+
+// outlierWindowStats - Get per end-point connection outcomes of a lb rule in the
+// window since it was last called. A connection is counted when it is first seen
+// and a failure is counted when it moves to a failed state or is left in sync-sent
+// for OdSynSentAge. A connection seen in
+// an earlier window which fails in this one counts towards both
+func (r *ruleEnt) outlierWindowStats(cts []*DpCtInfo) map[string]*epCtStats {
+	now := time.Now()
+	stats := make(map[string]*epCtStats)
+	seen := make(map[string]odCtSeen, len(cts))
+	for _, ct := range cts {
+		key := ct.Key()
+		prev, found := r.od.ctSeen[key]
+		cur := odCtSeen{failed: ctStateFailed(ct.CState)}
+		// Syns to an end-point which doesn't answer at all are left in
+		// sync-sent till they time out. So, these are failed after a while
+		if ct.CState == "sync-sent" {
+			cur.synTs = now
+			if found && !prev.synTs.IsZero() {
+				cur.synTs = prev.synTs
+			}
+			if now.Sub(cur.synTs) >= OdSynSentAge*time.Second {
+				cur.failed = true
+			}
+		}
+		seen[key] = cur
+
+		failed := cur.failed && (!found || !prev.failed)
+		if found && !failed {
+			continue
+		}
+		epKey := ctActEP(ct.CAct)
+		st := stats[epKey]
+		if st == nil {
+			st = new(epCtStats)
+			stats[epKey] = st
+		}
+		st.total++
+		if failed {
+			st.fails++
+		}
+	}
+	r.od.ctSeen = seen
+	return stats
+}
+
+// outlierReset - Bring back all ejected end-points
+func (R *RuleH) outlierReset(eps []ruleNatEp) {
+	for i := range eps {
+		eps[i].odEjected = false
+		eps[i].odEjectCnt = 0
+	}
+}
+
+// outlierDetect - Eject end-points of a lb rule whose failure ratio crossed the
+// configured limit and bring back those whose ejection time is over. Ejection
+// time doubles every time an end-point gets ejected again
+func (R *RuleH) outlierDetect(rule *ruleEnt, stats map[string]*epCtStats) bool {
+	rChg := false
+	now := time.Now()
+	rule.od.sT = now
+
+	na, ok := rule.act.action.(*ruleNatActs)
+	if !ok {
+		return false
+	}
+
+	nEjected := 0
+	nEps := 0
+	for idx := range na.endPoints {
+		np := &na.endPoints[idx]
+		if np.inActive {
+			continue
+		}
+		nEps++
+		if !np.odEjected {
+			continue
+		}
+		if now.After(np.odUntil) {
+			np.odEjected = false
+			rChg = true
+			tk.LogIt(tk.LogInfo, "nat lb-rule outlier ep back - %s:%d\n", np.xIP.String(), np.xPort)
+			continue
+		}
+		nEjected++
+	}
+
+	maxEjected := (nEps * int(rule.od.maxEjectPct)) / 100
+	if maxEjected == 0 && nEps > 1 {
+		maxEjected = 1
+	}
+
+	for idx := range na.endPoints {
+		np := &na.endPoints[idx]
+		if np.inActive || np.odEjected {
+			continue
+		}
+		st := stats[fmt.Sprintf("%s:%d", np.xIP.String(), np.xPort)]
+		if st == nil || st.total < OdMinConns {
+			continue
+		}
+		if (st.fails*100)/st.total < int(rule.od.failRatio) {
+			if np.odEjectCnt > 0 {
+				np.odEjectCnt--
+			}
+			continue
+		}
+		if nEjected >= maxEjected {
+			tk.LogIt(tk.LogDebug, "nat lb-rule outlier ep %s:%d not ejected(max)\n", np.xIP.String(), np.xPort)
+			continue
+		}
+		ejectTime := time.Duration(rule.od.ejectTime) * time.Second
+		for i := 0; i < np.odEjectCnt && ejectTime < MaxOdEjectTime*time.Second; i++ {
+			ejectTime *= 2
+		}
+		if ejectTime > MaxOdEjectTime*time.Second {
+			ejectTime = MaxOdEjectTime * time.Second
+		}
+		np.odEjected = true
+		np.odEjectCnt++
+		np.odUntil = now.Add(ejectTime)
+		nEjected++
+		rChg = true
+		tk.LogIt(tk.LogInfo, "nat lb-rule outlier ep ejected - %s:%d(%d/%d) for %v\n",
+			np.xIP.String(), np.xPort, st.fails, st.total, ejectTime)
+	}
+
+	return rChg
+}
+
 // RulesSync - This is periodic ticker routine which does two main things :
 // 1. Syncs rule statistics counts
 // 2. Check health of lb-rule end-points
 func (R *RuleH) RulesSync() {
+	var lbCts map[uint64][]*DpCtInfo
 	rChg := false
+
+	// Conntrack entries are fetched once only for the rules which need them
+	ctRules := make(map[uint32]bool)
+	for _, rule := range R.tables[RtLB].eMap {
+		if (rule.od.failRatio != 0 && time.Since(rule.od.sT) >= OdWindow*time.Second) || rule.drainPending() ||
+			rule.connCapPending() {
+			ctRules[uint32(rule.ruleNum)] = true
+		}
+	}
+	if len(ctRules) != 0 {
+		lbCts = R.lbCtGet(ctRules)
+	}
+
 	for _, rule := range R.tables[RtLB].eMap {
 		ruleKeys := rule.tuples.String()
 		ruleActs := rule.act.String()
@@ -2448,6 +4101,32 @@ func (R *RuleH) RulesSync() {
 			rule.DP(DpCreate)
 		}
 
+		if rule.od.failRatio != 0 && ctRules[uint32(rule.ruleNum)] {
+			if R.outlierDetect(rule, rule.outlierWindowStats(lbCts[rule.ruleNum])) {
+				tk.LogIt(tk.LogDebug, "nat lb-Rule outliers updated %d:%s\n", rule.ruleNum, ruleKeys)
+				rule.DP(DpCreate)
+			}
+		}
+
+		if rule.drainPending() && ctRules[uint32(rule.ruleNum)] {
+			if R.drainSync(rule, epCtActive(lbCts[rule.ruleNum])) {
+				tk.LogIt(tk.LogDebug, "nat lb-Rule drained eps updated %d:%s\n", rule.ruleNum, ruleKeys)
+				rule.DP(DpCreate)
+			}
+		}
+
+		if rule.connCapPending() && ctRules[uint32(rule.ruleNum)] {
+			if R.connCapSync(rule, epCtActive(lbCts[rule.ruleNum])) {
+				tk.LogIt(tk.LogDebug, "nat lb-Rule capped eps updated %d:%s\n", rule.ruleNum, ruleKeys)
+				rule.DP(DpCreate)
+			}
+		}
+
+		if rule.slowStartSync() {
+			tk.LogIt(tk.LogDebug, "nat lb-Rule slow-start weights updated %d:%s\n", rule.ruleNum, ruleKeys)
+			rule.DP(DpCreate)
+		}
+
 		if !rule.hChk.actChk {
 			continue
 		}
@@ -2472,7 +4151,7 @@ func (R *RuleH) RulesSync() {
 	for _, rule := range R.tables[RtFw].eMap {
 		//ruleKeys := rule.tuples.String()
 		//ruleActs := rule.act.String()
-		if rule.sync != 0 {
+		if rule.sync != 0 && !rule.fwSchedOff() {
 			rule.Fw2DP(DpCreate)
 		}
 		//rule.DP(DpStatsGet)
@@ -2482,8 +4161,69 @@ func (R *RuleH) RulesSync() {
 	}
 }
 
+// fwSchedOff - Check if a scheduled firewall rule is out of its active window
+func (r *ruleEnt) fwSchedOff() bool {
+	fwOpts, ok := r.act.action.(*ruleFwOpts)
+	return ok && fwOpts.opt.schedOff
+}
+
+// fwExpired - Check if a firewall rule with an expiry has outlived it
+func (r *ruleEnt) fwExpired(now time.Time) bool {
+	fwOpts, ok := r.act.action.(*ruleFwOpts)
+	return ok && !fwOpts.opt.expiry.IsZero() && !now.Before(fwOpts.opt.expiry)
+}
+
+// FwTimersSync - Delete firewall rules which have expired and move scheduled
+// firewall rules in or out of the datapath as their active windows open or close
+func (R *RuleH) FwTimersSync(now time.Time) {
+	var expired []*ruleEnt
+
+	for _, rule := range R.tables[RtFw].eMap {
+		fwOpts, ok := rule.act.action.(*ruleFwOpts)
+		if !ok {
+			continue
+		}
+		if rule.fwExpired(now) {
+			expired = append(expired, rule)
+			continue
+		}
+		if fwOpts.opt.sched == nil {
+			continue
+		}
+
+		off := !fwOpts.opt.sched.active(now)
+		if off == fwOpts.opt.schedOff {
+			continue
+		}
+		fwOpts.opt.schedOff = off
+
+		kind := cmn.FwEventActive
+		if off {
+			kind = cmn.FwEventInactive
+			rule.Fw2DP(DpRemove)
+		} else {
+			rule.Fw2DP(DpCreate)
+		}
+		tk.LogIt(tk.LogInfo, "fw-rule %s - %d:%s\n", kind, rule.ruleNum, rule.tuples.String())
+		R.fwEventNotify(cmn.FwRuleEvent{Time: now, Kind: kind, Name: rule.tuples.String(), Rule: rule.fwRuleMod().Rule})
+	}
+
+	for _, rule := range expired {
+		fm := rule.fwRuleMod()
+		name := rule.tuples.String()
+		if _, err := R.DeleteFwRule(fm.Rule); err != nil {
+			tk.LogIt(tk.LogError, "fw-rule expired - %d:%s delete failed (%s)\n", rule.ruleNum, name, err)
+			continue
+		}
+		tk.LogIt(tk.LogInfo, "fw-rule expired - %d:%s\n", rule.ruleNum, name)
+		R.fwEventNotify(cmn.FwRuleEvent{Time: now, Kind: cmn.FwEventExpired, Name: name, Rule: fm.Rule})
+		mh.cfgStore.Changed()
+	}
+}
+
 // RulesTicker - Ticker for all rules
 func (R *RuleH) RulesTicker() {
+	R.FwTimersSync(time.Now())
 	R.RulesSync()
 }
 
@@ -2511,7 +4251,7 @@ func (R *RuleH) RuleDestructAll() {
 			continue
 		}
 
-		lbs.ServPort = r.tuples.l4Dst.val
+		lbs.ServPort, lbs.ServPortMax = r.tuples.l4DstPorts()
 
 		fmt.Printf("Deleting fin %s\n", r.tuples.l3Dst.addr.IP.String())
 
@@ -2538,15 +4278,24 @@ func (R *RuleH) RuleDestructAll() {
 		fwr.Proto = r.tuples.l4Prot.val
 		fwr.InPort = r.tuples.port.val
 
-		R.DeleteFwRule(fwr)
+		R.deleteFwRule(fwr, r.tuples.owner)
 	}
 	return
 }
 
+// eventName - Name of the rule as reported in events
+func (r *ruleEnt) eventName() string {
+	if r.name != "" {
+		return r.name
+	}
+	return r.tuples.String()
+}
+
 // VIP2DP - Sync state of nat-rule for local sock VIP-port rewrite
 func (r *ruleEnt) VIP2DP(work DpWorkT) int {
 	portMap := make(map[int]struct{})
-	if mh.lSockPolicy {
+	// Local socket VIP rewrite is not done for port ranges
+	if pMin, pMax := r.tuples.l4DstPorts(); mh.lSockPolicy && pMin == pMax {
 		switch at := r.act.action.(type) {
 		case *ruleNatActs:
 			for _, ep := range at.endPoints {
@@ -2587,6 +4336,13 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 	}
 	nWork.ServiceIP = r.tuples.l3Dst.addr.IP.Mask(r.tuples.l3Dst.addr.Mask)
 	nWork.L4Port = r.tuples.l4Dst.val
+	nWork.MaxConns = r.limits.maxConns
+	nWork.MaxConnEp = r.limits.maxConnEp
+	nWork.ConnRate = r.limits.connRate
+	if pMin, pMax := r.tuples.l4DstPorts(); pMax > pMin {
+		nWork.L4Port = pMin
+		nWork.L4PortMax = pMax
+	}
 	nWork.Proto = r.tuples.l4Prot.val
 	nWork.Mark = int(r.ruleNum)
 	nWork.BlockNum = r.tuples.pref
@@ -2628,6 +4384,8 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 			nWork.EpSel = EpLeastConn
 		case at.sel == cmn.LbSelN2:
 			nWork.EpSel = EpN2
+		case at.sel == cmn.LbSelMaglev:
+			nWork.EpSel = EpMaglev
 		default:
 			nWork.EpSel = EpRR
 		}
@@ -2646,7 +4404,7 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 					continue
 				}
 				oEp := &at.endPoints[i]
-				sw := (int(ep.weight) * MaxNatEndPoints) / 100
+				sw := (int(ep.currWeight()) * MaxNatEndPoints) / 100
 				if sw == 0 {
 					small[k] = i
 					k++
@@ -2656,7 +4414,10 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 					neps[j].rIP = oEp.rIP
 					neps[j].xPort = oEp.xPort
 					neps[j].inActive = oEp.inActive
-					neps[j].weight = oEp.weight
+					neps[j].odEjected = oEp.odEjected
+					neps[j].drain = oEp.drain
+					neps[j].connCapped = oEp.connCapped
+					neps[j].weight = oEp.currWeight()
 					if sw == 1 {
 						small[k] = i
 						k++
@@ -2676,7 +4437,10 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 					neps[j].rIP = oEp.rIP
 					neps[j].xPort = oEp.xPort
 					neps[j].inActive = oEp.inActive
-					neps[j].weight = oEp.weight
+					neps[j].odEjected = oEp.odEjected
+					neps[j].drain = oEp.drain
+					neps[j].connCapped = oEp.connCapped
+					neps[j].weight = oEp.currWeight()
 					j++
 					v++
 				}
@@ -2688,7 +4452,7 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 				ep.RIP = e.rIP
 				ep.XPort = e.xPort
 				ep.Weight = e.weight
-				if e.inActive || e.noService {
+				if e.inActive || e.noService || e.odEjected || e.drain || e.connCapped {
 					ep.InActive = true
 				}
 				nWork.endPoints = append(nWork.endPoints, ep)
@@ -2703,7 +4467,7 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 						ep.RIP = kf.rIP
 						ep.XPort = kf.xPort
 						ep.Weight = kf.weight
-						if kf.inActive || kf.noService {
+						if kf.inActive || kf.noService || kf.odEjected {
 							ep.InActive = true
 						}
 
@@ -2715,8 +4479,8 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 					ep.XIP = k.xIP
 					ep.RIP = k.rIP
 					ep.XPort = k.xPort
-					ep.Weight = k.weight
-					if k.inActive || k.noService {
+					ep.Weight = k.currWeight()
+					if k.inActive || k.noService || k.odEjected || k.drain || k.connCapped {
 						ep.InActive = true
 					}
 
@@ -2724,6 +4488,15 @@ func (r *ruleEnt) Nat2DP(work DpWorkT) int {
 				}
 			}
 		}
+		if nWork.EpSel == EpMaglev {
+			nWork.MaglevTbl = MaglevTable(nWork.endPoints)
+			// eBPF dp hashes flows over end-point slots filled as per the table
+			if mh.dpEbpf != nil && len(nWork.MaglevTbl) > 0 {
+				nWork.endPoints = MaglevSlotEPs(nWork.endPoints, nWork.MaglevTbl, MaxNatEndPoints)
+				nWork.MaglevTbl = nil
+				nWork.EpSel = EpHash
+			}
+		}
 	default:
 		return -1
 	}
@@ -2771,6 +4544,14 @@ func (r *ruleEnt) Fw2DP(work DpWorkT) int {
 	nWork.ZoneNum = r.zone.ZoneNum
 	nWork.SrcIP = r.tuples.l3Src.addr
 	nWork.DstIP = r.tuples.l3Dst.addr
+	if r.tuples.l3SrcSet.val != "" {
+		nWork.SrcSet = r.tuples.l3SrcSet.val
+		nWork.SrcSetIPs, _ = r.zone.IPSets.IPSetFind(nWork.SrcSet)
+	}
+	if r.tuples.l3DstSet.val != "" {
+		nWork.DstSet = r.tuples.l3DstSet.val
+		nWork.DstSetIPs, _ = r.zone.IPSets.IPSetFind(nWork.DstSet)
+	}
 	if r.tuples.l4Src.valid == 0xffff {
 		nWork.L4SrcMin = r.tuples.l4Src.val
 		nWork.L4SrcMax = r.tuples.l4Src.val
@@ -2825,11 +4606,81 @@ func (r *ruleEnt) Fw2DP(work DpWorkT) int {
 		return -1
 	}
 
+	// Hits reported by datapath only carry the mark of the rule
+	if r.zone == mh.zr {
+		if work == DpCreate && nWork.FwRecord {
+			mh.fwLog.ruleSet(nWork.Mark, fwLogRule{name: r.tuples.String(), pref: nWork.Pref, fwType: nWork.FwType,
+				w: nWork})
+		} else if work == DpRemove {
+			mh.fwLog.ruleUnset(nWork.Mark)
+		}
+	}
+
+	// eBPF dp has no ip set matches
+	if mh.dpEbpf != nil && (nWork.SrcSet != "" || nWork.DstSet != "") {
+		r.fwSets2DP(nWork)
+		return 0
+	}
+
 	mh.dp.ToDpCh <- nWork
 
 	return 0
 }
 
+// fwSetEntries - expand a fw rule using ip sets into an entry per member
+// (or pair of members, when both sets are used). Only IPv4 members are
+// used as eBPF fw entries are IPv4 only
+func fwSetEntries(w *FwDpWorkQ) map[string]*FwDpWorkQ {
+	ents := make(map[string]*FwDpWorkQ)
+
+	srcs := []net.IPNet{w.SrcIP}
+	if w.SrcSet != "" {
+		srcs = ipSetV4Members(w.SrcSetIPs)
+	}
+	dsts := []net.IPNet{w.DstIP}
+	if w.DstSet != "" {
+		dsts = ipSetV4Members(w.DstSetIPs)
+	}
+
+	for _, src := range srcs {
+		for _, dst := range dsts {
+			e := *w
+			e.SrcIP = src
+			e.DstIP = dst
+			e.SrcSet = ""
+			e.SrcSetIPs = nil
+			e.DstSet = ""
+			e.DstSetIPs = nil
+			ents[src.String()+"|"+dst.String()] = &e
+		}
+	}
+	return ents
+}
+
+// fwSets2DP - sync a fw rule using ip sets to eBPF dp. Entries of members
+// no longer in the sets are removed and those of new members are added, so
+// that the rule can be re-synced whenever its sets change
+func (r *ruleEnt) fwSets2DP(w *FwDpWorkQ) {
+	ents := make(map[string]*FwDpWorkQ)
+	if w.Work == DpCreate {
+		ents = fwSetEntries(w)
+	}
+
+	for key, e := range r.setFws {
+		if _, found := ents[key]; !found {
+			d := *e
+			d.Work = DpRemove
+			mh.dp.ToDpCh <- &d
+		}
+	}
+	for key, e := range ents {
+		if _, found := r.setFws[key]; !found {
+			mh.dp.ToDpCh <- e
+		}
+	}
+	r.setFws = ents
+}
+
 // DP - sync state of rule entity to data-path
 func (r *ruleEnt) DP(work DpWorkT) int {
 	isNat := false
@@ -2864,7 +4715,7 @@ func (r *ruleEnt) DP(work DpWorkT) int {
 							packets := uint64(0)
 							nStat := new(StatDpWorkQ)
 							nStat.Work = DpStatsGetImm
-							nStat.Mark = (((uint32(r.ruleNum)) & 0xfff) << 4) | (uint32(numEndPoints) & 0xf)
+							nStat.Mark = NatEpStatMark(uint32(r.ruleNum), numEndPoints)
 							nStat.Name = MapNameNat4
 							nStat.Bytes = &bytes
 							nStat.Packets = &packets
@@ -2878,7 +4729,7 @@ func (r *ruleEnt) DP(work DpWorkT) int {
 					} else {
 						nStat := new(StatDpWorkQ)
 						nStat.Work = work
-						nStat.Mark = (((uint32(r.ruleNum)) & 0xfff) << 4) | (uint32(numEndPoints) & 0xf)
+						nStat.Mark = NatEpStatMark(uint32(r.ruleNum), numEndPoints)
 						nStat.Name = MapNameNat4
 						nStat.Bytes = &nEP.stat.bytes
 						nStat.Packets = &nEP.stat.packets
@@ -2890,6 +4741,19 @@ func (r *ruleEnt) DP(work DpWorkT) int {
 						numEndPoints++
 					}
 				}
+				// Only userspace dp rejects connections by itself
+				if r.limits != (ruleConnLimit{}) && mh.dpUser != nil {
+					nStat := new(StatDpWorkQ)
+					nStat.Work = work
+					nStat.Mark = uint32(r.ruleNum)
+					nStat.Name = MapNameNatRej
+					nStat.Packets = &r.rejConns
+					if work == DpStatsGetImm {
+						DpWorkSingle(mh.dp, nStat)
+					} else {
+						mh.dp.ToDpCh <- nStat
+					}
+				}
 			}
 		} else {
 			nStat := new(StatDpWorkQ)
@@ -80,6 +80,16 @@ func (xs *XSync) DpWorkOnCtAdd(cti DpCtInfo, ret *int) error {
 		return errors.New("Not-Ready")
 	}
 
+	if cti.Proto == IpamXSyncProto {
+		mh.mtx.Lock()
+		*ret = mh.zr.Ipam.XSyncRecv(&cti)
+		mh.mtx.Unlock()
+		if *ret == 0 {
+			mh.cfgStore.Changed()
+		}
+		return nil
+	}
+
 	if cti.Proto == "xsync" {
 		mh.dp.SyncMtx.Lock()
 		defer mh.dp.SyncMtx.Unlock()
@@ -134,6 +144,13 @@ func (xs *XSync) DpWorkOnCtGet(async int, ret *int) error {
 	mh.dp.DpHooks.DpCtGetAsync()
 	*ret = 0
 
+	// Peer needs the ip pool allocations as well
+	go func() {
+		mh.mtx.Lock()
+		mh.zr.Ipam.XSyncAll()
+		mh.mtx.Unlock()
+	}()
+
 	return nil
 }
 
@@ -53,6 +53,8 @@ type Zone struct {
 	Sess    *SessH
 	Pols    *PolH
 	Mirrs   *MirrH
+	Ipam    *IpamH
+	IPSets  *IPSetH
 	Mtx     sync.RWMutex
 }
 
@@ -102,6 +104,8 @@ func (z *ZoneH) ZoneAdd(name string) (int, error) {
 	zone.Sess = SessInit(zone)
 	zone.Pols = PolInit(zone)
 	zone.Mirrs = MirrInit(zone)
+	zone.Ipam = IpamInit(zone)
+	zone.IPSets = IPSetInit(zone)
 
 	z.ZoneMap[name] = zone
 
@@ -23,50 +23,302 @@ import (
 	"crypto/x509"
 	"encoding/binary"
 	"errors"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
 	"golang.org/x/sys/unix"
 	"io"
 	"net"
 	"net/http"
 	"os"
+	"os/exec"
+	"regexp"
+	"strings"
 	"syscall"
 	"time"
 	"unsafe"
 
 	tk "github.com/loxilb-io/loxilib"
 	nlp "github.com/vishvananda/netlink"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// HTTPProbeOpts - Options to be used for a http(s) probe
+type HTTPProbeOpts struct {
+	// Method - http method to use, GET if empty
+	Method string
+	// Host - Host header to send, if any
+	Host string
+	// Headers - Extra headers to send as "Name:Value"
+	Headers []string
+	// Codes - Accepted status codes, only 200 if empty
+	Codes []int
+	// Resp - Exact response body expected, if any
+	Resp string
+	// RespRegex - Regex which response body needs to match, if any
+	RespRegex *regexp.Regexp
+	// Timeout - Probe timeout, 2s if zero
+	Timeout time.Duration
+	// Transport - Transport to reuse across probes, a new one is used if nil
+	Transport *http.Transport
+}
+
+// HTTPProbeRespMax - Max size of the response body of a http(s) probe which is read
+const HTTPProbeRespMax = 64 * 1024
+
+// HTTPProbeTransport - Get a transport for http(s) probes with given tls config.
+// If a Host header is sent, its name is used for SNI and cert verification
+func HTTPProbeTransport(tlsConf *tls.Config, host string) *http.Transport {
+	transport := &http.Transport{IdleConnTimeout: 5 * time.Second}
+	if tlsConf != nil {
+		tlsConf = tlsConf.Clone()
+		if host != "" && tlsConf.ServerName == "" {
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			tlsConf.ServerName = strings.Trim(host, "[]")
+		}
+		transport.TLSClientConfig = tlsConf
+	}
+	return transport
+}
+
 // HTTPSProber - Do a https probe for given url
 // returns true/false depending on whether probing was successful
 func HTTPSProber(urls string, cert tls.Certificate, certPool *x509.CertPool, resp string) bool {
+	tlsConf := &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: certPool}
+	return HTTPProberWithOpts(urls, tlsConf, HTTPProbeOpts{Resp: resp})
+}
+
+// HTTPProberWithOpts - Do a http(s) probe for given url with given options
+// returns true/false depending on whether probing was successful
+func HTTPProberWithOpts(urls string, tlsConf *tls.Config, opts HTTPProbeOpts) bool {
 	var err error
 	var req *http.Request
 	var res *http.Response
 
-	timeout := time.Duration(2 * time.Second)
-	client := http.Client{Timeout: timeout,
-		Transport: &http.Transport{
-			IdleConnTimeout: 5 * time.Second,
-			TLSClientConfig: &tls.Config{Certificates: []tls.Certificate{cert},
-				RootCAs: certPool}},
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = time.Duration(2 * time.Second)
+	}
+	transport := opts.Transport
+	if transport == nil {
+		transport = HTTPProbeTransport(tlsConf, opts.Host)
+		defer transport.CloseIdleConnections()
+	}
+	client := http.Client{Timeout: timeout, Transport: transport}
+
+	method := opts.Method
+	if method == "" {
+		method = http.MethodGet
 	}
-	if req, err = http.NewRequest(http.MethodGet, urls, nil); err != nil {
+	if req, err = http.NewRequest(method, urls, nil); err != nil {
 		tk.LogIt(tk.LogError, "unable to create http request: %s\n", err)
 		return false
 	}
+	for _, hdr := range opts.Headers {
+		kv := strings.SplitN(hdr, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		req.Header.Add(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+	}
+	if opts.Host != "" {
+		req.Host = opts.Host
+	}
 
 	res, err = client.Do(req)
-	if err != nil || res.StatusCode != 200 {
+	if err != nil {
 		tk.LogIt(tk.LogError, "unable to create http request: %s\n", err)
 		return false
 	}
-	defer res.Body.Close()
-	if resp != "" {
-		data, err := io.ReadAll(res.Body)
+	defer func() {
+		// Drain what is left of the body so that the connection can be reused
+		io.Copy(io.Discard, io.LimitReader(res.Body, HTTPProbeRespMax))
+		res.Body.Close()
+	}()
+
+	codeOk := false
+	if len(opts.Codes) == 0 {
+		codeOk = res.StatusCode == http.StatusOK
+	}
+	for _, code := range opts.Codes {
+		if res.StatusCode == code {
+			codeOk = true
+			break
+		}
+	}
+	if !codeOk {
+		tk.LogIt(tk.LogDebug, "http probe %s unexpected status: %d\n", urls, res.StatusCode)
+		return false
+	}
+
+	if opts.Resp != "" || opts.RespRegex != nil {
+		data, err := io.ReadAll(io.LimitReader(res.Body, HTTPProbeRespMax))
 		if err != nil {
 			return false
 		}
-		return string(data) == resp
+		if opts.Resp != "" && string(data) != opts.Resp {
+			return false
+		}
+		if opts.RespRegex != nil && !opts.RespRegex.Match(data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GRPCProber - Do a grpc health-check probe for given address and service name
+// returns true/false depending on whether the service is reported as serving
+func GRPCProber(addr string, service string, tlsConf *tls.Config, timeout time.Duration) bool {
+	var creds credentials.TransportCredentials
+
+	if timeout == 0 {
+		timeout = time.Duration(2 * time.Second)
+	}
+	if tlsConf != nil {
+		creds = credentials.NewTLS(tlsConf)
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "unable to connect grpc probe %s: %s\n", addr, err)
+		return false
+	}
+	defer conn.Close()
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "grpc health-check %s failed: %s\n", addr, err)
+		return false
+	}
+
+	return res.GetStatus() == healthpb.HealthCheckResponse_SERVING
+}
+
+// L4ServiceProber - Do a probe for L4 service end-points which is given up
+// after timeout. sType is "tcp" or "udp" or "sctp", sName is end-point address
+// and port, sHint is source address hint if any, req and resp are the request
+// to be made to server and the response expected (empty for none)
+// returns true/false depending on whether probing was successful
+func L4ServiceProber(sType string, sName string, sHint, req, resp string, timeout time.Duration) bool {
+	if timeout == 0 {
+		timeout = time.Duration(1 * time.Second)
+	}
+
+	if sType == "sctp" {
+		// sctp connect can't be bounded. So, it is waited for only till timeout
+		res := make(chan bool, 1)
+		go func() {
+			res <- tk.L4ServiceProber(sType, sName, sHint, req, resp)
+		}()
+		select {
+		case sOk := <-res:
+			return sOk
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	if sType != "tcp" && sType != "udp" {
+		// Unsupported
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(sName)
+	if err != nil {
+		return false
+	}
+
+	deadline := time.Now().Add(timeout)
+	c, err := net.DialTimeout(sType, sName, timeout)
+	if err != nil {
+		return false
+	}
+	defer c.Close()
+	c.SetDeadline(deadline)
+
+	if req != "" && resp != "" {
+		if _, err := c.Write([]byte(req)); err != nil {
+			return false
+		}
+		aRb := make([]byte, len(resp))
+		if _, err := io.ReadFull(c, aRb); err != nil {
+			return false
+		}
+		return bytes.Equal(aRb, []byte(resp))
+	}
+
+	if sType != "udp" {
+		return true
+	}
+
+	// An udp end-point is taken as down only if it answers with icmp
+	// port unreachable
+	var lc net.ListenConfig
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	rc, err := lc.ListenPacket(ctx, "ip4:1", "0.0.0.0")
+	if err != nil {
+		return true
+	}
+	defer rc.Close()
+	rc.SetDeadline(deadline)
+
+	if _, err := c.Write([]byte("probe")); err != nil {
+		return false
+	}
+	pktData := make([]byte, 1500)
+	if _, err := c.Read(pktData); err == nil {
+		return true
+	}
+	plen, _, err := rc.ReadFrom(pktData)
+	if err != nil {
+		return true
+	}
+	icmpNr, err := icmp.ParseMessage(1, pktData[:plen])
+	if err != nil || icmpNr.Code != 3 || plen < 8+20+8 {
+		return true
+	}
+	iph, err := ipv4.ParseHeader(pktData[8:])
+	if err != nil || plen < 8+iph.Len+4 {
+		return true
+	}
+	lPort := c.RemoteAddr().(*net.UDPAddr).Port
+	if iph.Dst.String() == host && iph.Protocol == 17 &&
+		int(binary.BigEndian.Uint16(pktData[8+iph.Len+2:])) == lPort {
+		return false
+	}
+
+	return true
+}
+
+// ExecProber - Run given command with extra env variables as a probe
+// returns true/false depending on whether command exited with zero within timeout
+func ExecProber(command string, env []string, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Env = append(os.Environ(), env...)
+	// Run the probe in its own process group so that whatever it spawned
+	// is killed along with it on timeout
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = time.Second
+	if err := cmd.Run(); err != nil {
+		tk.LogIt(tk.LogDebug, "exec probe %s failed: %s\n", command, err)
+		return false
 	}
 
 	return true